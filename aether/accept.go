@@ -0,0 +1,104 @@
+// aether/accept.go
+//
+// Content negotiation for URL searches.
+//
+// When Search is given a URL, Aether guesses from the URL alone what
+// kind of document it points at (see SearchPlan.Target) and advertises
+// a matching Accept header, so servers that offer several
+// representations return the one Aether handles best: a feed for feed
+// URLs, JSON for API endpoints, HTML otherwise. WithAcceptHeader
+// replaces the header used for a kind.
+
+package aether
+
+import (
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/Nibir1/Aether/internal/config"
+)
+
+// defaultAcceptByKind is the Accept header sent for each target kind.
+// Every value ends in a low-priority wildcard so a server with a single
+// representation still answers.
+var defaultAcceptByKind = map[SearchDocumentKind]string{
+	SearchDocumentKindHTML: "text/html, application/xhtml+xml;q=0.9, */*;q=0.8",
+	SearchDocumentKindFeed: "application/rss+xml, application/atom+xml, application/feed+json;q=0.9, application/xml;q=0.8, text/xml;q=0.8, */*;q=0.5",
+	SearchDocumentKindJSON: "application/json, */*;q=0.5",
+}
+
+// WithAcceptHeader sets the Accept header Search sends when a URL query
+// is expected to return a document of the given kind. An empty value
+// sends "*/*". Kinds without a header of their own fall back to the
+// HTML one.
+func WithAcceptHeader(kind SearchDocumentKind, value string) Option {
+	return func(c *config.Config) {
+		if c.AcceptByKind == nil {
+			c.AcceptByKind = make(map[string]string)
+		}
+		value = strings.TrimSpace(value)
+		if value == "" {
+			value = "*/*"
+		}
+		c.AcceptByKind[string(kind)] = value
+	}
+}
+
+// acceptFor returns the Accept header for a URL search whose expected
+// document kind is kind.
+func (c *Client) acceptFor(kind SearchDocumentKind) string {
+	if c != nil && c.cfg != nil {
+		if v, ok := c.cfg.AcceptByKind[string(kind)]; ok {
+			return v
+		}
+	}
+	if v, ok := defaultAcceptByKind[kind]; ok {
+		return v
+	}
+	if c != nil && c.cfg != nil {
+		if v, ok := c.cfg.AcceptByKind[string(SearchDocumentKindHTML)]; ok {
+			return v
+		}
+	}
+	return defaultAcceptByKind[SearchDocumentKindHTML]
+}
+
+// feedPathSegments name path segments that conventionally serve feeds.
+var feedPathSegments = map[string]bool{
+	"feed": true, "feeds": true, "rss": true, "atom": true,
+}
+
+// urlTargetKind guesses the kind of document rawURL serves from its
+// extension and path: feeds (.rss, .atom, .xml, /feed, /rss), JSON APIs
+// (.json, /api/, api.* hosts), and HTML for everything else.
+func urlTargetKind(rawURL string) SearchDocumentKind {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return SearchDocumentKindHTML
+	}
+	p := strings.ToLower(u.Path)
+
+	switch path.Ext(p) {
+	case ".rss", ".atom", ".xml":
+		return SearchDocumentKindFeed
+	case ".json":
+		return SearchDocumentKindJSON
+	}
+
+	segments := strings.FieldsFunc(p, func(r rune) bool { return r == '/' })
+	for _, s := range segments {
+		if feedPathSegments[s] {
+			return SearchDocumentKindFeed
+		}
+	}
+	if strings.HasPrefix(strings.ToLower(u.Hostname()), "api.") {
+		return SearchDocumentKindJSON
+	}
+	for _, s := range segments {
+		if s == "api" {
+			return SearchDocumentKindJSON
+		}
+	}
+	return SearchDocumentKindHTML
+}
@@ -19,6 +19,9 @@ package aether
 
 import (
 	"fmt"
+	"maps"
+	"net/http"
+	"strings"
 	"time"
 
 	icache "github.com/Nibir1/Aether/internal/cache"
@@ -42,6 +45,13 @@ const DefaultUserAgent = "AetherBot/1.0 (+https://github.com/Nibir1/Aether)"
 //   - robots.txt-compliant HTTP fetcher
 //   - internal OpenAPI client
 //   - public plugin registry
+//
+// A Client is safe for concurrent use: one instance can serve Search,
+// Fetch, Crawl, normalization and rendering from many goroutines at
+// once, and plugins or content-type mappings may be registered while
+// those calls are in flight. A call that is already running sees the
+// plugins registered when it looked them up; later registrations apply
+// to later calls. Options are fixed once NewClient returns.
 type Client struct {
 	cfg     *config.Config
 	logger  log.Logger
@@ -50,15 +60,28 @@ type Client struct {
 	openapi *iopenapi.Client
 
 	plugins *plugins.Registry // internal plugin registry
+
+	contentKinds *contentKinds // content-type → kind table
+
+	feedPolls *feedPolls // feed validators for conditional polls
+
+	renders *renderCache // nil unless WithRenderCache
+
+	// terminalWidth detects the width Render wraps to when no width is
+	// given; nil means display.DetectTerminalWidth.
+	terminalWidth func() (int, bool)
 }
 
 // Config is the public, inspectable view of effective Aether configuration.
 type Config struct {
 	// Networking
 	UserAgent          string
+	AcceptLanguage     string
 	RequestTimeout     time.Duration
+	PerRequestTimeout  time.Duration
 	MaxConcurrentHosts int
 	MaxRequestsPerHost int
+	PerHostConcurrency map[string]int
 
 	// Logging
 	EnableDebugLogging bool
@@ -90,7 +113,7 @@ type Option func(*config.Config)
 //  2. Apply user-specified Option functions
 //  3. Ensure User-Agent is set
 //  4. Initialize logger
-//  5. Initialize unified composite cache
+//  5. Initialize unified composite cache (or use a shared cache)
 //  6. Initialize HTTP fetcher (robots.txt + caching)
 //  7. Initialize internal OpenAPI client
 //  8. Initialize plugin registry
@@ -108,35 +131,52 @@ func NewClient(opts ...Option) (*Client, error) {
 		internalCfg.UserAgent = DefaultUserAgent
 	}
 
-	logger := log.New(internalCfg.EnableDebugLogging)
+	logger := internalCfg.Logger
+	if logger == nil {
+		logger = log.New(internalCfg.EnableDebugLogging)
+	}
 
 	cli := &Client{
 		cfg:     internalCfg,
 		logger:  logger,
 		plugins: plugins.NewRegistry(),
-	}
-
-	// unified composite cache
-	cli.cache = icache.NewComposite(icache.Config{
-
-		// Memory cache layer
-		MemoryEnabled: internalCfg.EnableMemoryCache,
-		MemoryTTL:     internalCfg.CacheTTL,
-		MemoryMax:     internalCfg.MaxCacheEntries,
 
-		// File cache layer
-		FileEnabled:   internalCfg.EnableFileCache,
-		FileTTL:       internalCfg.CacheTTL,
-		FileDirectory: internalCfg.CacheDirectory,
+		contentKinds: newContentKinds(),
+		feedPolls:    newFeedPolls(),
+	}
 
-		// Redis cache layer
-		RedisEnabled: internalCfg.EnableRedisCache,
-		RedisTTL:     internalCfg.CacheTTL,
-		RedisAddress: internalCfg.RedisAddress,
+	// unified composite cache, unless the caller supplied a cache that
+	// is shared with other clients (WithSharedCache).
+	if internalCfg.SharedCache != nil {
+		cli.cache = internalCfg.SharedCache
+	} else {
+		cli.cache = icache.NewComposite(icache.Config{
+
+			// Memory cache layer
+			MemoryEnabled: internalCfg.EnableMemoryCache,
+			MemoryTTL:     internalCfg.CacheTTL,
+			MemoryMax:     internalCfg.MaxCacheEntries,
+
+			// File cache layer
+			FileEnabled:   internalCfg.EnableFileCache,
+			FileTTL:       internalCfg.CacheTTL,
+			FileDirectory: internalCfg.CacheDirectory,
+
+			// Redis cache layer
+			RedisEnabled: internalCfg.EnableRedisCache,
+			RedisTTL:     internalCfg.CacheTTL,
+			RedisAddress: internalCfg.RedisAddress,
+
+			// TTL time source
+			Clock: internalCfg.Clock,
+
+			// Logging integration
+			Logger: logger,
+		})
+	}
 
-		// Logging integration
-		Logger: logger,
-	})
+	// namespace all keys, whichever cache is in use
+	cli.cache = icache.WithPrefix(cli.cache, internalCfg.CachePrefix)
 
 	// robots.txt-compliant HTTP fetcher
 	cli.fetcher = hclient.New(internalCfg, logger, cli.cache)
@@ -144,6 +184,11 @@ func NewClient(opts ...Option) (*Client, error) {
 	// OpenAPI client
 	cli.openapi = iopenapi.New(internalCfg, logger, cli.fetcher)
 
+	// optional render cache
+	if internalCfg.RenderCacheSize > 0 {
+		cli.renders = newRenderCache(internalCfg)
+	}
+
 	return cli, nil
 }
 
@@ -162,6 +207,26 @@ func WithUserAgent(ua string) Option {
 	}
 }
 
+// WithAcceptLanguage sets the Accept-Language header sent with every
+// outbound request, most preferred language first, for example
+// WithAcceptLanguage("nb-NO", "en;q=0.5"). Headers passed explicitly to a
+// single request take precedence. The response's Content-Language is
+// recorded in document metadata as "content_language".
+//
+// Cached responses are keyed by URL alone, so a client whose cache is
+// shared with clients asking for other languages may be served theirs.
+func WithAcceptLanguage(langs ...string) Option {
+	return func(c *config.Config) {
+		var parts []string
+		for _, l := range langs {
+			if l = strings.TrimSpace(l); l != "" {
+				parts = append(parts, l)
+			}
+		}
+		c.AcceptLanguage = strings.Join(parts, ", ")
+	}
+}
+
 // WithRequestTimeout sets the HTTP timeout duration.
 func WithRequestTimeout(d time.Duration) Option {
 	return func(c *config.Config) {
@@ -171,6 +236,30 @@ func WithRequestTimeout(d time.Duration) Option {
 	}
 }
 
+// WithTransport sets the http.RoundTripper used for every outbound
+// request, e.g. to route traffic through a custom proxy or dialer. A nil
+// transport keeps http.DefaultTransport.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *config.Config) {
+		c.Transport = rt
+	}
+}
+
+// WithPerRequestTimeout gives every individual fetch its own deadline of
+// d, covering robots.txt checks, queueing for a concurrency slot and
+// retries. It applies in addition to the caller's context: whichever
+// deadline comes first wins, so one slow host in a batch or crawl fails
+// after d instead of consuming a long overall budget. FetchToWriter and
+// FetchRange are bounded too; for them d covers reading the body as
+// well, so size it for the whole download.
+func WithPerRequestTimeout(d time.Duration) Option {
+	return func(c *config.Config) {
+		if d > 0 {
+			c.PerRequestTimeout = d
+		}
+	}
+}
+
 // WithConcurrency sets concurrency caps for outbound HTTP requests.
 func WithConcurrency(maxHosts, maxPerHost int) Option {
 	return func(c *config.Config) {
@@ -183,6 +272,27 @@ func WithConcurrency(maxHosts, maxPerHost int) Option {
 	}
 }
 
+// WithPerHostConcurrency overrides the per-host request cap for specific
+// hosts, e.g. 1 for a fragile site and 16 for a server you own. Keys are
+// host names, optionally with a port ("api.example.com:8443"); a key with
+// a port applies only to that port, one without to every port. Hosts not
+// listed use the WithConcurrency default, values below 1 are ignored,
+// and the global cap still bounds the total number of requests.
+func WithPerHostConcurrency(limits map[string]int) Option {
+	return func(c *config.Config) {
+		for host, n := range limits {
+			host = strings.ToLower(strings.TrimSpace(host))
+			if host == "" || n < 1 {
+				continue
+			}
+			if c.PerHostConcurrency == nil {
+				c.PerHostConcurrency = make(map[string]int)
+			}
+			c.PerHostConcurrency[host] = n
+		}
+	}
+}
+
 // WithDebugLogging enables verbose internal logs.
 func WithDebugLogging(enabled bool) Option {
 	return func(c *config.Config) {
@@ -244,8 +354,11 @@ func (c *Client) EffectiveConfig() Config {
 	return Config{
 		UserAgent:          c.cfg.UserAgent,
 		RequestTimeout:     c.cfg.RequestTimeout,
+		AcceptLanguage:     c.cfg.AcceptLanguage,
+		PerRequestTimeout:  c.cfg.PerRequestTimeout,
 		MaxConcurrentHosts: c.cfg.MaxConcurrentHosts,
 		MaxRequestsPerHost: c.cfg.MaxRequestsPerHost,
+		PerHostConcurrency: maps.Clone(c.cfg.PerHostConcurrency),
 		EnableDebugLogging: c.cfg.EnableDebugLogging,
 
 		EnableMemoryCache: c.cfg.EnableMemoryCache,
@@ -65,6 +65,30 @@ func (c *Client) FetchRaw(ctx context.Context, url string) ([]byte, http.Header,
 	return resp.Body, resp.Header, nil
 }
 
+// FetchRawWithStatus is FetchRaw that also returns the HTTP status code.
+//
+// Non-2xx responses are not errors: the body, status and headers are
+// returned as received so callers can tell a 200 from a 404 error page.
+// Responses served from Aether's cache report 200, since only successful
+// responses are cached.
+func (c *Client) FetchRawWithStatus(ctx context.Context, url string) ([]byte, int, http.Header, error) {
+	if c == nil || c.fetcher == nil {
+		return nil, 0, nil, fmt.Errorf("aether: client is not initialized")
+	}
+
+	url = strings.TrimSpace(url)
+	if url == "" {
+		return nil, 0, nil, fmt.Errorf("aether: empty URL passed to FetchRawWithStatus")
+	}
+
+	resp, err := c.fetcher.Fetch(ctx, url, nil)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	return resp.Body, resp.StatusCode, resp.Header, nil
+}
+
 // FetchText performs a robots.txt-compliant GET and returns the body as UTF-8.
 //
 // Ideal for:
@@ -0,0 +1,105 @@
+// aether/aether_fetch_test.go
+package aether
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestFetchRawWithStatus_SurfacesNotFound(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ok" {
+			w.Header().Set("X-Test", "yes")
+			w.Write([]byte(`{"ok":true}`))
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte("missing"))
+	}))
+	defer srv.Close()
+
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	body, status, _, err := cli.FetchRawWithStatus(context.Background(), srv.URL+"/gone")
+	if err != nil {
+		t.Fatalf("FetchRawWithStatus error: %v", err)
+	}
+	if status != http.StatusNotFound {
+		t.Fatalf("status: got %d, want %d", status, http.StatusNotFound)
+	}
+	if string(body) != "missing" {
+		t.Fatalf("body: got %q, want %q", body, "missing")
+	}
+
+	_, status, hdr, err := cli.FetchRawWithStatus(context.Background(), srv.URL+"/ok")
+	if err != nil {
+		t.Fatalf("FetchRawWithStatus error: %v", err)
+	}
+	if status != http.StatusOK || hdr.Get("X-Test") != "yes" {
+		t.Fatalf("ok endpoint: got status %d, X-Test %q", status, hdr.Get("X-Test"))
+	}
+}
+
+func TestWithPerRequestTimeout_SlowURLFailsFast(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/robots.txt":
+			http.NotFound(w, r)
+		case "/slow":
+			select {
+			case <-release:
+			case <-r.Context().Done():
+			}
+		default:
+			w.Write([]byte("fast"))
+		}
+	}))
+	defer srv.Close()
+
+	cli, err := NewClient(WithPerRequestTimeout(100 * time.Millisecond))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	res, err := cli.Batch(ctx, []string{srv.URL + "/slow", srv.URL + "/fast"}, BatchOptions{Concurrency: 2})
+	if err != nil {
+		t.Fatalf("Batch error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("Batch took %v, want the slow URL to time out after ~100ms", elapsed)
+	}
+
+	slow, fast := res.Results[0], res.Results[1]
+	if !errors.Is(slow.Err, context.DeadlineExceeded) {
+		t.Fatalf("slow URL: got %v, want a deadline error", slow.Err)
+	}
+	if fast.Err != nil || string(fast.Body) != "fast" {
+		t.Fatalf("fast URL: got body %q, err %v", fast.Body, fast.Err)
+	}
+
+	// The streaming paths are bounded the same way.
+	start = time.Now()
+	if _, err := cli.FetchRange(ctx, srv.URL+"/slow", 0, 9); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("FetchRange: got %v, want a deadline error", err)
+	}
+	if _, err := cli.FetchToWriter(ctx, srv.URL+"/slow", io.Discard); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("FetchToWriter: got %v, want a deadline error", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("streaming fetches took %v, want each to time out after ~100ms", elapsed)
+	}
+}
@@ -0,0 +1,72 @@
+// aether/batch_test.go
+package aether
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// concurrencyServer records the peak number of requests it served at
+// once.
+func concurrencyServer(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var cur, peak atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		n := cur.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(50 * time.Millisecond)
+		cur.Add(-1)
+		w.Write([]byte("ok"))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &peak
+}
+
+func TestWithPerHostConcurrency_CapsListedHostOnly(t *testing.T) {
+	capped, cappedPeak := concurrencyServer(t)
+	open, openPeak := concurrencyServer(t)
+
+	cli, err := NewClient(
+		WithConcurrency(8, 4),
+		WithPerHostConcurrency(map[string]int{strings.TrimPrefix(capped.URL, "http://"): 1}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	var urls []string
+	for i := 0; i < 4; i++ {
+		urls = append(urls, fmt.Sprintf("%s/c%d", capped.URL, i), fmt.Sprintf("%s/o%d", open.URL, i))
+	}
+	res, err := cli.Batch(context.Background(), urls, BatchOptions{Concurrency: 8})
+	if err != nil {
+		t.Fatalf("Batch error: %v", err)
+	}
+	for _, r := range res.Results {
+		if r.Err != nil {
+			t.Fatalf("fetch %s: %v", r.URL, r.Err)
+		}
+	}
+
+	if got := cappedPeak.Load(); got != 1 {
+		t.Fatalf("capped host peak concurrency: got %d, want 1", got)
+	}
+	if got := openPeak.Load(); got < 2 {
+		t.Fatalf("uncapped host peak concurrency: got %d, want at least 2", got)
+	}
+}
@@ -0,0 +1,138 @@
+// aether/builder.go
+//
+// DocumentBuilder — construct a NormalizedDocument from scratch.
+//
+// Integrations that do not go through a SourcePlugin (a local database,
+// an internal API, hand-written fixtures) can build a document with the
+// fluent builder below and pass it straight to Render, the TOON/BTON
+// serializers or StreamTOON, without touching internal packages.
+//
+//	doc := aether.NewDocumentBuilder().
+//		SetKind(aether.DocumentKindArticle).
+//		SetTitle("Release notes").
+//		AddSection(aether.SectionRoleBody, "Highlights", "Faster crawls.").
+//		SetMetadata("version", "1.4").
+//		Build()
+
+package aether
+
+import (
+	"strings"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+// DocumentKind is the public alias for a normalized document's kind.
+type DocumentKind = model.DocumentKind
+
+// Document kinds understood by the renderers and serializers.
+const (
+	DocumentKindUnknown = model.DocumentKindUnknown
+	DocumentKindArticle = model.DocumentKindArticle
+	DocumentKindHTML    = model.DocumentKindHTML
+	DocumentKindFeed    = model.DocumentKindFeed
+	DocumentKindJSON    = model.DocumentKindJSON
+	DocumentKindText    = model.DocumentKindText
+	DocumentKindBinary  = model.DocumentKindBinary
+	DocumentKindEntity  = model.DocumentKindEntity
+)
+
+// DocumentBuilder assembles a NormalizedDocument step by step. The zero
+// value is not usable; call NewDocumentBuilder. Methods return the
+// builder so calls can be chained.
+type DocumentBuilder struct {
+	doc model.Document
+}
+
+// NewDocumentBuilder returns a builder for an empty document of kind
+// DocumentKindUnknown with non-nil metadata.
+func NewDocumentBuilder() *DocumentBuilder {
+	return &DocumentBuilder{doc: model.Document{
+		Kind:     model.DocumentKindUnknown,
+		Metadata: map[string]string{},
+	}}
+}
+
+// SetKind sets the document kind. An empty kind resets it to
+// DocumentKindUnknown.
+func (b *DocumentBuilder) SetKind(kind DocumentKind) *DocumentBuilder {
+	if kind == "" {
+		kind = model.DocumentKindUnknown
+	}
+	b.doc.Kind = kind
+	return b
+}
+
+// SetSourceURL sets the URL the document was derived from.
+func (b *DocumentBuilder) SetSourceURL(url string) *DocumentBuilder {
+	b.doc.SourceURL = strings.TrimSpace(url)
+	return b
+}
+
+// SetTitle sets the document title.
+func (b *DocumentBuilder) SetTitle(title string) *DocumentBuilder {
+	b.doc.Title = strings.TrimSpace(title)
+	return b
+}
+
+// SetExcerpt sets the short summary shown under the title.
+func (b *DocumentBuilder) SetExcerpt(excerpt string) *DocumentBuilder {
+	b.doc.Excerpt = strings.TrimSpace(excerpt)
+	return b
+}
+
+// SetContent sets the document's main body text.
+func (b *DocumentBuilder) SetContent(content string) *DocumentBuilder {
+	b.doc.Content = content
+	return b
+}
+
+// SetMetadata sets one document-level metadata entry. Blank keys are
+// ignored; an empty value is stored as-is.
+func (b *DocumentBuilder) SetMetadata(key, value string) *DocumentBuilder {
+	if key = strings.TrimSpace(key); key != "" {
+		b.doc.Metadata[key] = value
+	}
+	return b
+}
+
+// AddSection appends a section. An empty role defaults to
+// SectionRoleBody.
+func (b *DocumentBuilder) AddSection(role SectionRole, heading, text string) *DocumentBuilder {
+	return b.AddSectionWithMeta(role, heading, text, nil)
+}
+
+// AddSectionWithMeta appends a section carrying per-section metadata,
+// such as "url" for feed items or "lang" for code sections. The meta map
+// is copied.
+func (b *DocumentBuilder) AddSectionWithMeta(role SectionRole, heading, text string, meta map[string]string) *DocumentBuilder {
+	if role == "" {
+		role = model.SectionRoleBody
+	}
+	var m map[string]string
+	if len(meta) > 0 {
+		m = make(map[string]string, len(meta))
+		for k, v := range meta {
+			m[k] = v
+		}
+	}
+	b.doc.Sections = append(b.doc.Sections, model.Section{
+		Role:    role,
+		Heading: strings.TrimSpace(heading),
+		Text:    text,
+		Meta:    m,
+	})
+	return b
+}
+
+// Build returns the assembled document. Each call returns an independent
+// copy, so the builder can keep being used afterwards.
+func (b *DocumentBuilder) Build() *NormalizedDocument {
+	out := b.doc
+	out.Metadata = make(map[string]string, len(b.doc.Metadata))
+	for k, v := range b.doc.Metadata {
+		out.Metadata[k] = v
+	}
+	out.Sections = append([]model.Section(nil), b.doc.Sections...)
+	return &out
+}
@@ -0,0 +1,57 @@
+// aether/builder_test.go
+package aether
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestDocumentBuilder_BuildAndRender(t *testing.T) {
+	b := NewDocumentBuilder().
+		SetKind(DocumentKindArticle).
+		SetTitle("  Release notes ").
+		SetSourceURL("https://example.com/notes").
+		AddSection(SectionRoleBody, "Highlights", "Faster crawls.").
+		AddSection("", "Fixes", "Fewer panics.").
+		SetMetadata("version", "1.4")
+	doc := b.Build()
+
+	if doc.Title != "Release notes" {
+		t.Fatalf("Title: got %q, want %q", doc.Title, "Release notes")
+	}
+	if len(doc.Sections) != 2 || doc.Sections[1].Role != SectionRoleBody {
+		t.Fatalf("Sections: got %+v", doc.Sections)
+	}
+	if doc.Metadata["version"] != "1.4" {
+		t.Fatalf("Metadata: got %v", doc.Metadata)
+	}
+
+	// Later builder calls must not leak into an already built document.
+	b.SetMetadata("extra", "x").AddSection(SectionRoleBody, "Late", "")
+	if _, ok := doc.Metadata["extra"]; ok || len(doc.Sections) != 2 {
+		t.Fatalf("built document changed after Build: %+v", doc)
+	}
+
+	if empty := NewDocumentBuilder().Build(); empty.Metadata == nil || empty.Kind != DocumentKindUnknown {
+		t.Fatalf("defaults: got %+v", empty)
+	}
+
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	out, err := cli.Render(context.Background(), "markdown", doc)
+	if err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	md := string(out)
+	for _, want := range []string{"Release notes", "Highlights", "Faster crawls.", "Fixes", "Fewer panics."} {
+		if !strings.Contains(md, want) {
+			t.Fatalf("rendered markdown missing %q:\n%s", want, md)
+		}
+	}
+	if strings.Index(md, "Highlights") > strings.Index(md, "Fixes") {
+		t.Fatalf("sections rendered out of order:\n%s", md)
+	}
+}
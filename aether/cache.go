@@ -20,6 +20,8 @@
 package aether
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
 	icache "github.com/Nibir1/Aether/internal/cache"
@@ -83,6 +85,132 @@ func WithRedisCache(addr string, ttl time.Duration) CacheOption {
 	}
 }
 
+//
+// ───────────────────────────────────────────────────────────────
+//                          SHARED CACHES
+// ───────────────────────────────────────────────────────────────
+//
+
+// Cache is the minimal public cache interface. Implementations must be
+// safe for concurrent use. A Cache can be shared by several Clients via
+// WithSharedCache, e.g. one redis-backed cache for a whole server.
+type Cache interface {
+	// Get returns the cached value for key and whether it was found.
+	Get(key string) ([]byte, bool)
+
+	// Set stores value under key. A ttl <= 0 uses the cache's default.
+	Set(key string, value []byte, ttl time.Duration)
+
+	// Delete removes key from the cache, if present.
+	Delete(key string)
+}
+
+// NewMemoryCache returns an in-process LRU cache holding at most
+// maxEntries values with the given default TTL.
+func NewMemoryCache(maxEntries int, ttl time.Duration) Cache {
+	return icache.NewMemory(maxEntries, ttl)
+}
+
+// NewFileCache returns a cache persisting entries as files under dir.
+func NewFileCache(dir string, ttl time.Duration) Cache {
+	return icache.NewFile(dir, ttl)
+}
+
+// NewRedisCache returns a cache backed by the redis server at addr
+// (e.g. "localhost:6379"), suitable for sharing across processes.
+func NewRedisCache(addr string, ttl time.Duration) Cache {
+	return icache.NewRedis(addr, ttl)
+}
+
+// WithSharedCache makes the Client use cache instead of building its own
+// composite cache. The memory/file/redis cache options are then ignored.
+// Clients constructed with the same cache observe each other's entries.
+func WithSharedCache(cache Cache) Option {
+	return func(c *config.Config) {
+		if cache != nil {
+			c.SharedCache = cache
+		}
+	}
+}
+
+//
+// ───────────────────────────────────────────────────────────────
+//                          OFFLINE MODE
+// ───────────────────────────────────────────────────────────────
+//
+
+// WithOfflineMode makes the Client serve every fetch, including OpenAPI
+// calls, from its cache only. A cache miss fails with an ErrorKindOffline
+// error instead of going to the network, and robots.txt is never fetched.
+// Combined with a warmed persistent or shared cache this makes a whole
+// pipeline reproducible without network access.
+func WithOfflineMode(enabled bool) Option {
+	return func(c *config.Config) {
+		c.Offline = enabled
+	}
+}
+
+//
+// ───────────────────────────────────────────────────────────────
+//                       KEY PREFIX & CLEARING
+// ───────────────────────────────────────────────────────────────
+//
+
+// cacheKeyspaces lists the key namespaces Aether writes to. ClearCache
+// limits itself to these when no prefix is configured.
+var cacheKeyspaces = []string{"http:"}
+
+// WithCachePrefix namespaces every cache key written by the Client, in
+// every layer, e.g. WithCachePrefix("aether") stores "aether:http:<url>".
+// A trailing ":" is added when missing. ClearCache then removes only
+// keys under this prefix, which keeps a shared redis safe for other
+// applications.
+func WithCachePrefix(prefix string) Option {
+	return func(c *config.Config) {
+		prefix = strings.TrimSpace(prefix)
+		if prefix == "" {
+			return
+		}
+		if !strings.HasSuffix(prefix, ":") {
+			prefix += ":"
+		}
+		c.CachePrefix = prefix
+	}
+}
+
+// ClearCache removes the Client's entries from every cache layer. Only
+// keys under the configured prefix (or, without one, Aether's own key
+// namespaces) are deleted; redis is cleared with SCAN MATCH, never
+// FLUSHDB. A cache passed to WithSharedCache must implement
+// Clear(prefix string) error to be cleared.
+func (c *Client) ClearCache() error {
+	if c == nil {
+		return fmt.Errorf("aether: nil client")
+	}
+	if c.cache == nil {
+		return nil
+	}
+
+	cl, ok := c.cache.(icache.Clearer)
+	if !ok {
+		return fmt.Errorf("aether: cache does not support clearing")
+	}
+
+	if c.cfg.CachePrefix != "" {
+		if err := cl.Clear(""); err != nil {
+			return fmt.Errorf("aether: clear cache: %w", err)
+		}
+		return nil
+	}
+
+	for _, ks := range cacheKeyspaces {
+		if err := cl.Clear(ks); err != nil {
+			return fmt.Errorf("aether: clear cache: %w", err)
+		}
+	}
+	return nil
+}
+
 //
 // ───────────────────────────────────────────────────────────────
 //                        CACHE INITIALIZATION
@@ -118,6 +246,8 @@ func (c *Client) initCache() {
 		RedisTTL:     c.cfg.CacheTTL,
 		RedisAddress: c.cfg.RedisAddress,
 
+		Clock: c.cfg.Clock,
+
 		Logger: c.logger,
 	}
 
@@ -0,0 +1,213 @@
+// aether/cache_test.go
+package aether
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Nibir1/Aether/internal/clock"
+)
+
+func TestWithSharedCache_ClientsSeeEachOthersFetches(t *testing.T) {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		hits.Add(1)
+		w.Write([]byte("shared body"))
+	}))
+	defer srv.Close()
+
+	shared := NewMemoryCache(16, time.Minute)
+
+	first, err := NewClient(WithSharedCache(shared))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	second, err := NewClient(WithSharedCache(shared))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	url := srv.URL + "/page"
+	if _, err := first.Fetch(context.Background(), url); err != nil {
+		t.Fatalf("first Fetch error: %v", err)
+	}
+
+	res, err := second.Fetch(context.Background(), url)
+	if err != nil {
+		t.Fatalf("second Fetch error: %v", err)
+	}
+	if string(res.Body) != "shared body" {
+		t.Fatalf("Body: got %q, want %q", res.Body, "shared body")
+	}
+	if got := hits.Load(); got != 1 {
+		t.Fatalf("origin hits: got %d, want 1", got)
+	}
+	if got := res.Header.Get("X-Aether-Cache"); got != "HIT" {
+		t.Fatalf("X-Aether-Cache: got %q, want %q", got, "HIT")
+	}
+}
+
+func TestMemoryCache_Delete(t *testing.T) {
+	c := NewMemoryCache(4, time.Minute)
+	c.Set("k", []byte("v"), 0)
+	c.Delete("k")
+	if _, ok := c.Get("k"); ok {
+		t.Fatal("expected key to be deleted")
+	}
+}
+
+func TestWithCachePrefix_KeysPrefixedAndClearScoped(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("body"))
+	}))
+	defer srv.Close()
+
+	shared := NewMemoryCache(16, time.Minute)
+	shared.Set("otherapp:session", []byte("keep"), 0)
+
+	cli, err := NewClient(WithSharedCache(shared), WithCachePrefix("aether"))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	url := srv.URL + "/page"
+	if _, err := cli.Fetch(context.Background(), url); err != nil {
+		t.Fatalf("Fetch error: %v", err)
+	}
+	if _, ok := shared.Get("aether:http:" + url); !ok {
+		t.Fatal("expected entry under aether:http: prefix")
+	}
+	if _, ok := shared.Get("http:" + url); ok {
+		t.Fatal("unexpected unprefixed entry")
+	}
+
+	if err := cli.ClearCache(); err != nil {
+		t.Fatalf("ClearCache error: %v", err)
+	}
+	if _, ok := shared.Get("aether:http:" + url); ok {
+		t.Fatal("expected prefixed entry to be cleared")
+	}
+	if v, ok := shared.Get("otherapp:session"); !ok || string(v) != "keep" {
+		t.Fatalf("foreign key: got %q (found=%v), want %q", v, ok, "keep")
+	}
+}
+
+func TestWithOfflineMode_ServesOnlyFromCache(t *testing.T) {
+	var hits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits.Add(1)
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("warm body"))
+	}))
+	defer srv.Close()
+
+	shared := NewMemoryCache(16, time.Minute)
+	online, err := NewClient(WithSharedCache(shared))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	if _, err := online.Fetch(context.Background(), srv.URL+"/warm"); err != nil {
+		t.Fatalf("warming Fetch error: %v", err)
+	}
+	before := hits.Load()
+
+	offline, err := NewClient(WithSharedCache(shared), WithOfflineMode(true))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	res, err := offline.Fetch(context.Background(), srv.URL+"/warm")
+	if err != nil {
+		t.Fatalf("offline hit: %v", err)
+	}
+	if string(res.Body) != "warm body" {
+		t.Fatalf("Body: got %q, want %q", res.Body, "warm body")
+	}
+
+	_, err = offline.Fetch(context.Background(), srv.URL+"/cold")
+	var aerr *Error
+	if !errors.As(err, &aerr) || aerr.Kind != ErrorKindOffline {
+		t.Fatalf("offline miss: got %v, want an ErrorKindOffline error", err)
+	}
+
+	if got := hits.Load(); got != before {
+		t.Fatalf("offline client reached the server %d times", got-before)
+	}
+}
+
+func TestFetch_CacheControlDrivesTTL(t *testing.T) {
+	var hits sync.Map
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		n, _ := hits.LoadOrStore(r.URL.Path, new(atomic.Int32))
+		n.(*atomic.Int32).Add(1)
+		switch r.URL.Path {
+		case "/max-age":
+			w.Header().Set("Cache-Control", "public, max-age=60")
+		case "/no-store":
+			w.Header().Set("Cache-Control", "no-store")
+		}
+		w.Write([]byte("body"))
+	}))
+	defer srv.Close()
+
+	hitCount := func(path string) int32 {
+		n, ok := hits.Load(path)
+		if !ok {
+			return 0
+		}
+		return n.(*atomic.Int32).Load()
+	}
+
+	clk := clock.NewFake(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	cli, err := NewClient(WithClock(clk), Option(WithMemoryCache(16, time.Hour)))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	fetch := func(path string) {
+		t.Helper()
+		if _, err := cli.Fetch(context.Background(), srv.URL+path); err != nil {
+			t.Fatalf("Fetch %s error: %v", path, err)
+		}
+	}
+
+	// max-age=60: served from the cache for 60s, then refetched.
+	fetch("/max-age")
+	clk.Advance(59 * time.Second)
+	fetch("/max-age")
+	if got := hitCount("/max-age"); got != 1 {
+		t.Fatalf("max-age within 60s: origin hits %d, want 1", got)
+	}
+	clk.Advance(2 * time.Second)
+	fetch("/max-age")
+	if got := hitCount("/max-age"); got != 2 {
+		t.Fatalf("max-age after 60s: origin hits %d, want 2", got)
+	}
+
+	// no-store: never cached.
+	fetch("/no-store")
+	fetch("/no-store")
+	if got := hitCount("/no-store"); got != 2 {
+		t.Fatalf("no-store: origin hits %d, want 2", got)
+	}
+}
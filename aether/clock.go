@@ -0,0 +1,42 @@
+// aether/clock.go
+//
+// Time source injection.
+//
+// Cache TTL expiry, crawl throttling and fetch timestamps all read the
+// time through a Clock. Production clients use the system clock; tests
+// can inject a clock they advance by hand, so expiry and pacing can be
+// exercised without sleeping.
+
+package aether
+
+import (
+	"time"
+
+	"github.com/Nibir1/Aether/internal/clock"
+	"github.com/Nibir1/Aether/internal/config"
+)
+
+// Clock supplies the current time and sleeps. Implementations must be
+// safe for concurrent use.
+type Clock = clock.Clock
+
+// WithClock sets the client's time source. A nil clock keeps the system
+// clock.
+//
+// The clock must be set before the cache is built, so WithClock has no
+// effect on a cache passed in via WithSharedCache.
+func WithClock(clk Clock) Option {
+	return func(c *config.Config) {
+		c.Clock = clk
+	}
+}
+
+// Now returns the current time according to the client's clock. Plugins
+// should use it when stamping documents so their timestamps follow an
+// injected clock.
+func (c *Client) Now() time.Time {
+	if c == nil || c.cfg == nil {
+		return time.Now()
+	}
+	return clock.Or(c.cfg.Clock).Now()
+}
@@ -0,0 +1,34 @@
+// aether/close.go
+//
+// Client shutdown.
+//
+// Long-running services create a Client once and keep it for their
+// lifetime; Close lets them release it cleanly on shutdown. Aether
+// starts no goroutines that outlive a call, the file cache writes each
+// entry synchronously and the Redis layer dials per command, so the
+// resources left to release are the transport's idle keep-alive
+// connections and the parsed feeds kept for conditional polling.
+
+package aether
+
+import (
+	"fmt"
+)
+
+// Close releases the client's idle HTTP connections and in-memory state.
+// Afterwards every call that would make a request (Fetch, Search,
+// FetchRSS, the OpenAPI helpers, ...) fails with an *Error of kind
+// ErrorKindClosed; calls already in flight complete normally. Pure
+// transformations such as NormalizeSearchResult and Render keep working.
+// Close is safe to call more than once and does not close a cache
+// supplied with WithSharedCache.
+func (c *Client) Close() error {
+	if c == nil || c.fetcher == nil {
+		return fmt.Errorf("aether: client is not initialized")
+	}
+	c.fetcher.Close()
+	if c.feedPolls != nil {
+		c.feedPolls.reset()
+	}
+	return nil
+}
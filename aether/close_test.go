@@ -0,0 +1,78 @@
+// aether/close_test.go
+package aether
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClose_ReleasesConnectionsAndRejectsCalls(t *testing.T) {
+	var closedConns atomic.Int32
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("<html><title>ok</title></html>"))
+	}))
+	srv.Config.ConnState = func(_ net.Conn, s http.ConnState) {
+		if s == http.StateClosed {
+			closedConns.Add(1)
+		}
+	}
+	srv.Start()
+	defer srv.Close()
+
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	ctx := context.Background()
+
+	if _, err := cli.Fetch(ctx, srv.URL+"/page"); err != nil {
+		t.Fatalf("Fetch error: %v", err)
+	}
+	if n := closedConns.Load(); n != 0 {
+		t.Fatalf("connections closed before Close: got %d", n)
+	}
+
+	if err := cli.Close(); err != nil {
+		t.Fatalf("Close error: %v", err)
+	}
+	if err := cli.Close(); err != nil {
+		t.Fatalf("second Close error: %v", err)
+	}
+
+	// The keep-alive connection is closed by the client.
+	deadline := time.Now().Add(2 * time.Second)
+	for closedConns.Load() == 0 && time.Now().Before(deadline) {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if closedConns.Load() == 0 {
+		t.Fatal("idle connection not closed by Close")
+	}
+
+	// Even a cached URL is refused after Close.
+	_, err = cli.Fetch(ctx, srv.URL+"/page")
+	var ae *Error
+	if !errors.As(err, &ae) || ae.Kind != ErrorKindClosed {
+		t.Fatalf("Fetch after Close: got %v, want ErrorKindClosed", err)
+	}
+	if _, err := cli.FetchRSS(ctx, srv.URL+"/feed.xml"); !errors.As(err, &ae) || ae.Kind != ErrorKindClosed {
+		t.Fatalf("FetchRSS after Close: got %v, want ErrorKindClosed", err)
+	}
+	if err := cli.RefreshRobots(srv.URL); !errors.As(err, &ae) || ae.Kind != ErrorKindClosed {
+		t.Fatalf("RefreshRobots after Close: got %v, want ErrorKindClosed", err)
+	}
+
+	// Pure transformations still work.
+	if _, err := cli.Render(ctx, "text", &NormalizedDocument{Title: "Still here"}); err != nil {
+		t.Fatalf("Render after Close: %v", err)
+	}
+}
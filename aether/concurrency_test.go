@@ -0,0 +1,134 @@
+// aether/concurrency_test.go
+package aether
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Nibir1/Aether/plugins"
+)
+
+// countingTransform tags documents it has seen.
+type countingTransform struct{ name string }
+
+func (t countingTransform) Name() string        { return t.name }
+func (t countingTransform) Description() string { return "test transform" }
+
+func (t countingTransform) Apply(ctx context.Context, doc *plugins.Document) (*plugins.Document, error) {
+	if doc.Metadata == nil {
+		doc.Metadata = map[string]string{}
+	}
+	doc.Metadata[t.name] = "seen"
+	return doc, nil
+}
+
+// TestClient_ConcurrentUse shares one client between goroutines running
+// Search, Fetch, Crawl and normalization while others register plugins
+// and content-type mappings. Run with -race.
+func TestClient_ConcurrentUse(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/robots.txt":
+			http.NotFound(w, r)
+		case "/", "/a", "/b":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			fmt.Fprintf(w, `<html><head><title>%s</title></head><body><a href="/a">a</a> <a href="/b">b</a></body></html>`, r.URL.Path)
+		default:
+			w.Header().Set("Content-Type", "text/plain")
+			w.Write([]byte("plain " + r.URL.Path))
+		}
+	}))
+	defer srv.Close()
+
+	cli, err := NewClient(
+		Option(WithMemoryCache(64, time.Minute)),
+		Option(WithFileCache(t.TempDir(), time.Minute)),
+		WithConcurrency(4, 2),
+	)
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	if err := cli.RegisterSourcePlugin(textSource{name: "base", title: "Base", content: "base content"}); err != nil {
+		t.Fatalf("RegisterSourcePlugin error: %v", err)
+	}
+
+	ctx := context.Background()
+	const workers = 8
+	var wg sync.WaitGroup
+	errs := make(chan error, workers*8)
+
+	for i := 0; i < workers; i++ {
+		wg.Add(6)
+
+		go func(i int) {
+			defer wg.Done()
+			res, err := cli.Search(ctx, fmt.Sprintf("%s/page%d", srv.URL, i%3))
+			if err != nil {
+				errs <- fmt.Errorf("url search: %w", err)
+				return
+			}
+			cli.NormalizeSearchResult(res)
+		}(i)
+
+		go func(i int) {
+			defer wg.Done()
+			res, err := cli.Search(ctx, fmt.Sprintf("concurrency topic %d", i))
+			if err != nil {
+				errs <- fmt.Errorf("text search: %w", err)
+				return
+			}
+			cli.NormalizeSearchResult(res)
+		}(i)
+
+		go func(i int) {
+			defer wg.Done()
+			if _, err := cli.Fetch(ctx, srv.URL+"/a"); err != nil {
+				errs <- fmt.Errorf("fetch: %w", err)
+			}
+		}(i)
+
+		go func(i int) {
+			defer wg.Done()
+			err := cli.Crawl(ctx, srv.URL+"/", CrawlOptions{
+				MaxDepth:     1,
+				MaxPages:     3,
+				SameHostOnly: true,
+				Visitor: CrawlVisitorFunc(func(ctx context.Context, page *CrawledPage) error {
+					return nil
+				}),
+			})
+			if err != nil {
+				errs <- fmt.Errorf("crawl: %w", err)
+			}
+		}(i)
+
+		go func(i int) {
+			defer wg.Done()
+			name := fmt.Sprintf("source-%d", i)
+			if err := cli.RegisterSourcePlugin(textSource{name: name, title: name, content: "content"}); err != nil {
+				errs <- fmt.Errorf("register source: %w", err)
+			}
+			if err := cli.RegisterTransformPlugin(countingTransform{name: fmt.Sprintf("transform-%d", i)}); err != nil {
+				errs <- fmt.Errorf("register transform: %w", err)
+			}
+		}(i)
+
+		go func(i int) {
+			defer wg.Done()
+			cli.RegisterContentTypeKind(fmt.Sprintf("text/x-custom-%d", i), SearchDocumentKindText)
+			cli.PlanSearch("weather in oslo")
+			cli.ListDisplayFormats()
+		}(i)
+	}
+
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Error(err)
+	}
+}
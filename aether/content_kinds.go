@@ -0,0 +1,110 @@
+// aether/content_kinds.go
+//
+// Content-type → document kind classification.
+//
+// Direct URL fetches (and plugin documents that leave Kind unset but
+// record a "content_type") are classified by MIME type. Each client keeps
+// a table of MIME prefixes; the longest registered prefix matching the
+// media type wins. RegisterContentTypeKind adds or overrides entries, so
+// for example text/markdown can be treated as an article:
+//
+//	cli.RegisterContentTypeKind("text/markdown", aether.SearchDocumentKindArticle)
+//
+// Types matching no prefix fall back to the built-in heuristics: any
+// type mentioning "html" is an HTML page, any mentioning "json" is JSON,
+// and everything else is binary.
+
+package aether
+
+import (
+	"strings"
+	"sync"
+)
+
+// defaultContentTypeKinds seeds every client's table.
+var defaultContentTypeKinds = map[string]SearchDocumentKind{
+	"text/html":             SearchDocumentKindHTML,
+	"application/xhtml+xml": SearchDocumentKindHTML,
+	"application/json":      SearchDocumentKindJSON,
+	"text/":                 SearchDocumentKindText,
+}
+
+// builtinContentKinds classifies for a nil client. It is never modified.
+var builtinContentKinds = newContentKinds()
+
+// contentKinds is a client's MIME prefix table. It is safe for
+// concurrent use.
+type contentKinds struct {
+	mu    sync.RWMutex
+	kinds map[string]SearchDocumentKind
+}
+
+func newContentKinds() *contentKinds {
+	k := &contentKinds{kinds: make(map[string]SearchDocumentKind, len(defaultContentTypeKinds))}
+	for prefix, kind := range defaultContentTypeKinds {
+		k.kinds[prefix] = kind
+	}
+	return k
+}
+
+// lookup returns the kind of the longest prefix matching mediaType.
+func (k *contentKinds) lookup(mediaType string) (SearchDocumentKind, bool) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	best, found := "", false
+	var kind SearchDocumentKind
+	for prefix, v := range k.kinds {
+		if strings.HasPrefix(mediaType, prefix) && (!found || len(prefix) > len(best)) {
+			best, kind, found = prefix, v, true
+		}
+	}
+	return kind, found
+}
+
+// RegisterContentTypeKind maps MIME types starting with mime (for
+// example "text/markdown", or "text/" for a whole family) to kind,
+// replacing any earlier mapping for the same prefix. Matching is
+// case-insensitive and ignores parameters such as charset. The kind
+// determines how the document is normalized: SearchDocumentKindArticle
+// produces an article document, SearchDocumentKindText a text document,
+// and so on.
+func (c *Client) RegisterContentTypeKind(mime string, kind SearchDocumentKind) {
+	if c == nil || c.contentKinds == nil {
+		return
+	}
+	mime = strings.ToLower(strings.TrimSpace(mime))
+	if mime == "" {
+		return
+	}
+	c.contentKinds.mu.Lock()
+	c.contentKinds.kinds[mime] = kind
+	c.contentKinds.mu.Unlock()
+}
+
+// ClassifyContentType returns the document kind for a Content-Type
+// value such as "text/html; charset=utf-8", using the client's
+// registered mappings and then the built-in heuristics.
+func (c *Client) ClassifyContentType(contentType string) SearchDocumentKind {
+	mediaType := strings.ToLower(strings.TrimSpace(contentType))
+	if i := strings.IndexByte(mediaType, ';'); i >= 0 {
+		mediaType = strings.TrimSpace(mediaType[:i])
+	}
+
+	kinds := builtinContentKinds
+	if c != nil && c.contentKinds != nil {
+		kinds = c.contentKinds
+	}
+	if kind, ok := kinds.lookup(mediaType); ok {
+		return kind
+	}
+
+	switch {
+	case strings.Contains(mediaType, "html"):
+		return SearchDocumentKindHTML
+	case strings.Contains(mediaType, "json"):
+		return SearchDocumentKindJSON
+	default:
+		return SearchDocumentKindBinary
+	}
+}
@@ -0,0 +1,64 @@
+// aether/content_kinds_test.go
+package aether
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRegisterContentTypeKind_MarkdownAsArticle(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+		w.Write([]byte("# Notes\n\nSome markdown text."))
+	}))
+	defer srv.Close()
+
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	// Defaults: markdown is plain text, HTML and JSON keep their kinds.
+	for ct, want := range map[string]SearchDocumentKind{
+		"text/markdown":              SearchDocumentKindText,
+		"text/html; charset=utf-8":   SearchDocumentKindHTML,
+		"application/ld+json":        SearchDocumentKindJSON,
+		"application/pdf":            SearchDocumentKindBinary,
+		"TEXT/Markdown; variant=GFM": SearchDocumentKindText,
+	} {
+		if got := cli.ClassifyContentType(ct); got != want {
+			t.Fatalf("%s: got %q, want %q", ct, got, want)
+		}
+	}
+
+	cli.RegisterContentTypeKind("text/markdown", SearchDocumentKindArticle)
+	if got := cli.ClassifyContentType("text/markdown; charset=utf-8"); got != SearchDocumentKindArticle {
+		t.Fatalf("got %q, want %q", got, SearchDocumentKindArticle)
+	}
+	if got := cli.ClassifyContentType("text/plain"); got != SearchDocumentKindText {
+		t.Fatalf("text/plain: got %q, want %q", got, SearchDocumentKindText)
+	}
+
+	res, err := cli.Search(context.Background(), srv.URL+"/notes.md")
+	if err != nil {
+		t.Fatalf("Search error: %v", err)
+	}
+	if res.PrimaryDocument.Kind != SearchDocumentKindArticle {
+		t.Fatalf("search kind: got %q, want %q", res.PrimaryDocument.Kind, SearchDocumentKindArticle)
+	}
+	if doc := cli.NormalizeSearchResult(res); doc.Kind != DocumentKindArticle {
+		t.Fatalf("normalized kind: got %q, want %q", doc.Kind, DocumentKindArticle)
+	}
+
+	// Other clients keep the defaults.
+	other, _ := NewClient()
+	if got := other.ClassifyContentType("text/markdown"); got != SearchDocumentKindText {
+		t.Fatalf("other client: got %q, want %q", got, SearchDocumentKindText)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	icrawl "github.com/Nibir1/Aether/internal/crawl"
@@ -30,6 +31,14 @@ type CrawledPage struct {
 	Content    string
 	Links      []string
 	Metadata   map[string]string
+
+	// Article is the extracted article when CrawlOptions.ExtractArticles
+	// is set and the page is HTML.
+	Article *Article
+
+	// ExtractError reports why extraction failed, if it did. The page is
+	// still delivered.
+	ExtractError error
 }
 
 // CrawlVisitor defines the callback interface for receiving crawled pages.
@@ -53,7 +62,59 @@ type CrawlOptions struct {
 	DisallowedDomains []string
 	FetchDelay        time.Duration
 	Concurrency       int
-	Visitor           CrawlVisitor
+
+	// AllowPaths and DenyPaths scope discovered links by URL path (plus
+	// query string). A pattern is a plain prefix ("/docs/") or a glob in
+	// which "*" matches any characters ("/search?*"). Deny wins over
+	// allow; an empty AllowPaths admits every path. The start URL is
+	// always fetched.
+	AllowPaths []string
+	DenyPaths  []string
+
+	// RespectNofollow skips links marked rel="nofollow" and all links on
+	// pages whose <meta name="robots"> says "nofollow" or "none", as a
+	// polite crawler should. Nil means true; point it at false to crawl
+	// such links anyway.
+	RespectNofollow *bool
+
+	// HeadBeforeGet sends a HEAD before each page fetch and skips URLs
+	// that are not HTML or whose Content-Length exceeds MaxContentLength
+	// (0 = unlimited). Servers that reject HEAD are fetched normally.
+	HeadBeforeGet    bool
+	MaxContentLength int64
+
+	// RetryAfterAttempts and RetryAfterMax control how the crawler reacts
+	// to 429/503 responses carrying Retry-After: the URL is retried after
+	// the requested delay, at most RetryAfterAttempts times (0 = 2,
+	// negative disables) and only for delays up to RetryAfterMax
+	// (0 = one minute). Retried pages carry "retry_count" metadata.
+	RetryAfterAttempts int
+	RetryAfterMax      time.Duration
+
+	// Priority optionally ranks discovered URLs for focused crawling
+	// (e.g. prefer "/docs/" pages). Higher values are crawled first;
+	// equal priorities keep discovery order. Nil means breadth-first.
+	Priority func(url string, depth int) int
+
+	// DedupByContent skips the Visitor for pages whose visible text
+	// exactly matches a page already visited (print versions, mirrored
+	// paths, ...). Their links are still followed, and each skipped URL
+	// is reported with the URL it duplicates by CrawlStats.Duplicates.
+	DedupByContent bool
+
+	// ExtractArticles runs article extraction on every HTML page using a
+	// pool of ExtractWorkers goroutines (default: number of CPUs), so
+	// parsing overlaps fetching. Pages reach the Visitor in fetch order
+	// unless UnorderedVisits is set.
+	ExtractArticles bool
+	ExtractWorkers  int
+	UnorderedVisits bool
+
+	// Stats, when non-nil, is attached to the crawl so its per-host
+	// request counts and delays can be read while it runs.
+	Stats *CrawlStats
+
+	Visitor CrawlVisitor
 }
 
 //
@@ -70,6 +131,104 @@ func (c *Client) Crawl(ctx context.Context, startURL string, opts CrawlOptions)
 	if strings.TrimSpace(startURL) == "" {
 		return fmt.Errorf("aether: empty startURL in Crawl")
 	}
+	return c.crawl(ctx, []string{startURL}, opts)
+}
+
+// CrawlMulti runs a single crawl seeded with all startURLs at depth 0.
+//
+// The seeds share one visited set, frontier and politeness state, so a
+// page linked from several seeds is fetched and visited once, and
+// MaxPages and MaxDepth apply to the crawl as a whole. With SameHostOnly
+// set, discovered links are followed when their host matches the host
+// of any seed; seeding several hosts therefore widens the crawl to all
+// of them rather than disabling the restriction.
+func (c *Client) CrawlMulti(ctx context.Context, startURLs []string, opts CrawlOptions) error {
+	if err := c.checkSeeds("CrawlMulti", startURLs); err != nil {
+		return err
+	}
+	return c.crawl(ctx, startURLs, opts)
+}
+
+// CrawlMultiStream runs the same crawl as CrawlMulti and emits each
+// visited page on the first channel, in visit order. opts.Visitor is
+// ignored.
+//
+// The page channel is unbuffered, so the crawl advances as the caller
+// reads; a caller that stops reading early should cancel ctx. Once the
+// crawl ends both channels are closed, after the crawl's error, if any,
+// has been sent on the (buffered) error channel.
+func (c *Client) CrawlMultiStream(ctx context.Context, startURLs []string, opts CrawlOptions) (<-chan *CrawledPage, <-chan error) {
+	pages := make(chan *CrawledPage)
+	errs := make(chan error, 1)
+
+	if err := c.checkSeeds("CrawlMultiStream", startURLs); err != nil {
+		errs <- err
+		close(pages)
+		close(errs)
+		return pages, errs
+	}
+
+	opts.Visitor = CrawlVisitorFunc(func(ctx context.Context, p *CrawledPage) error {
+		select {
+		case pages <- p:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+
+	go func() {
+		defer close(errs)
+		defer close(pages)
+		if err := c.crawl(ctx, startURLs, opts); err != nil {
+			errs <- err
+		}
+	}()
+	return pages, errs
+}
+
+// CrawlMultiCollect runs the same crawl as CrawlMulti and returns every
+// visited page in visit order. opts.Visitor is ignored. When the crawl
+// fails, the pages visited before the failure are returned with the
+// error. Use MaxPages to bound memory on large sites.
+func (c *Client) CrawlMultiCollect(ctx context.Context, startURLs []string, opts CrawlOptions) ([]*CrawledPage, error) {
+	if err := c.checkSeeds("CrawlMultiCollect", startURLs); err != nil {
+		return nil, err
+	}
+
+	var (
+		mu    sync.Mutex
+		pages []*CrawledPage
+	)
+	opts.Visitor = CrawlVisitorFunc(func(ctx context.Context, p *CrawledPage) error {
+		mu.Lock()
+		pages = append(pages, p)
+		mu.Unlock()
+		return nil
+	})
+
+	err := c.crawl(ctx, startURLs, opts)
+	return pages, err
+}
+
+// checkSeeds validates the client and seed list of a multi-seed crawl.
+func (c *Client) checkSeeds(op string, startURLs []string) error {
+	if c == nil {
+		return fmt.Errorf("aether: nil client in %s", op)
+	}
+	if len(startURLs) == 0 {
+		return fmt.Errorf("aether: no start URLs in %s", op)
+	}
+	for i, u := range startURLs {
+		if strings.TrimSpace(u) == "" {
+			return fmt.Errorf("aether: empty start URL at index %d in %s", i, op)
+		}
+	}
+	return nil
+}
+
+// crawl converts opts and runs the engine over the given seeds.
+func (c *Client) crawl(ctx context.Context, startURLs []string, opts CrawlOptions) error {
 	if opts.Visitor == nil {
 		return fmt.Errorf("aether: CrawlOptions.Visitor must not be nil")
 	}
@@ -81,26 +240,57 @@ func (c *Client) Crawl(ctx context.Context, startURL string, opts CrawlOptions)
 
 	// Convert public → internal options
 	intOpts := icrawl.Options{
-		MaxDepth:          opts.MaxDepth,
-		MaxPages:          opts.MaxPages,
-		SameHostOnly:      opts.SameHostOnly,
-		AllowedDomains:    opts.AllowedDomains,
-		DisallowedDomains: opts.DisallowedDomains,
-		FetchDelay:        opts.FetchDelay,
-		Concurrency:       opts.Concurrency,
+		MaxDepth:           opts.MaxDepth,
+		MaxPages:           opts.MaxPages,
+		SameHostOnly:       opts.SameHostOnly,
+		AllowedDomains:     opts.AllowedDomains,
+		DisallowedDomains:  opts.DisallowedDomains,
+		AllowPaths:         opts.AllowPaths,
+		DenyPaths:          opts.DenyPaths,
+		IgnoreNofollow:     opts.RespectNofollow != nil && !*opts.RespectNofollow,
+		HeadBeforeGet:      opts.HeadBeforeGet,
+		MaxContentLength:   opts.MaxContentLength,
+		RetryAfterAttempts: opts.RetryAfterAttempts,
+		RetryAfterMax:      opts.RetryAfterMax,
+		Clock:              c.cfg.Clock,
+		FetchDelay:         opts.FetchDelay,
+		Concurrency:        opts.Concurrency,
+		Priority:           opts.Priority,
+		DedupByContent:     opts.DedupByContent,
+		ExtractWorkers:     opts.ExtractWorkers,
+		UnorderedVisits:    opts.UnorderedVisits,
 		Visitor: &crawlVisitorAdapter{
 			pub: opts.Visitor,
 		},
 	}
 
+	if opts.ExtractArticles {
+		intOpts.Extract = c.crawlExtract
+	}
+
 	// Create crawler engine (robots-compliant)
 	engine, err := icrawl.NewCrawler(c.fetcher, intOpts)
 	if err != nil {
 		return err
 	}
+	opts.Stats.attach(engine)
 
 	// Execute crawl
-	return engine.Run(ctx, startURL)
+	return engine.RunMulti(ctx, startURLs)
+}
+
+// crawlExtract is the crawl engine's ExtractFunc: it runs article
+// extraction on HTML pages and stores the *Article in page.Extracted.
+func (c *Client) crawlExtract(ctx context.Context, p *icrawl.Page) error {
+	if !strings.Contains(strings.ToLower(p.Metadata["content_type"]), "html") {
+		return nil
+	}
+	art, err := c.ExtractArticleFromHTML([]byte(p.Content), p.URL)
+	if err != nil {
+		return err
+	}
+	p.Extracted = art
+	return nil
 }
 
 //
@@ -127,6 +317,11 @@ func (a *crawlVisitorAdapter) VisitPage(ctx context.Context, p *icrawl.Page) err
 		Content:    p.Content,
 		Links:      p.Links,
 		Metadata:   p.Metadata,
+
+		ExtractError: p.ExtractErr,
+	}
+	if art, ok := p.Extracted.(*Article); ok {
+		pub.Article = art
 	}
 
 	return a.pub.VisitCrawledPage(ctx, pub)
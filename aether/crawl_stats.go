@@ -0,0 +1,86 @@
+// aether/crawl_stats.go
+//
+// Live politeness statistics for a running crawl.
+//
+// A long crawl spends much of its time waiting on per-host delays
+// (FetchDelay, Retry-After deferrals). CrawlStats lets the caller watch
+// that state while the crawl runs: attach one via CrawlOptions.Stats and
+// call Hosts from any goroutine. Duplicates lists the pages skipped by
+// content deduplication.
+
+package aether
+
+import (
+	"sync"
+	"time"
+
+	icrawl "github.com/Nibir1/Aether/internal/crawl"
+)
+
+// CrawlHostStats is the politeness state of one host in a crawl.
+type CrawlHostStats struct {
+	// Requests counts the requests made to the host, including HEAD
+	// probes when HeadBeforeGet is set.
+	Requests int
+
+	// NextAllowed is the earliest time the crawler will send the next
+	// request to the host; zero when it is not being held back.
+	NextAllowed time.Time
+}
+
+// CrawlStats exposes the per-host state of the crawl it is attached to.
+// The zero value is ready to use; a CrawlStats should be attached to one
+// crawl at a time. After the crawl returns, Hosts keeps reporting its
+// final state.
+type CrawlStats struct {
+	mu     sync.Mutex
+	engine *icrawl.Crawler
+}
+
+// Hosts returns a consistent snapshot of every host the crawl has
+// contacted, keyed by host (including any port). It returns nil before
+// the crawl starts and is safe to call concurrently with the crawl.
+func (s *CrawlStats) Hosts() map[string]CrawlHostStats {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	engine := s.engine
+	s.mu.Unlock()
+	if engine == nil {
+		return nil
+	}
+
+	snap := engine.HostStats()
+	out := make(map[string]CrawlHostStats, len(snap))
+	for host, st := range snap {
+		out[host] = CrawlHostStats{Requests: st.Requests, NextAllowed: st.NextAllowed}
+	}
+	return out
+}
+
+// Duplicates maps every page skipped by CrawlOptions.DedupByContent to
+// the URL of the first page with the same content. It returns nil before
+// the crawl starts and is safe to call concurrently with the crawl.
+func (s *CrawlStats) Duplicates() map[string]string {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	engine := s.engine
+	s.mu.Unlock()
+	if engine == nil {
+		return nil
+	}
+	return engine.Duplicates()
+}
+
+// attach points s at the engine of a starting crawl.
+func (s *CrawlStats) attach(engine *icrawl.Crawler) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	s.engine = engine
+	s.mu.Unlock()
+}
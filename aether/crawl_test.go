@@ -0,0 +1,266 @@
+// aether/crawl_test.go
+package aether
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCrawl_ExtractArticles(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		links := ""
+		if r.URL.Path == "/" {
+			links = `<a href="/p1">1</a><a href="/p2">2</a><a href="/p3">3</a>`
+		}
+		fmt.Fprintf(w, `<html><head><title>Page %s</title></head><body>%s
+<article><h1>Heading %s</h1><p>%s</p></article></body></html>`,
+			r.URL.Path, links, r.URL.Path, strings.Repeat("Some readable article text. ", 20))
+	}))
+	defer srv.Close()
+
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	var order []string
+	err = cli.Crawl(context.Background(), srv.URL+"/", CrawlOptions{
+		MaxDepth:        1,
+		ExtractArticles: true,
+		ExtractWorkers:  2,
+		Visitor: CrawlVisitorFunc(func(ctx context.Context, p *CrawledPage) error {
+			if p.ExtractError != nil {
+				t.Errorf("%s: ExtractError %v", p.URL, p.ExtractError)
+			}
+			if p.Article == nil || !strings.Contains(p.Article.Content, "readable article text") {
+				t.Errorf("%s: missing extracted article", p.URL)
+			}
+			order = append(order, strings.TrimPrefix(p.URL, srv.URL))
+			return nil
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Crawl error: %v", err)
+	}
+
+	if got, want := strings.Join(order, ","), "/,/p1,/p2,/p3"; got != want {
+		t.Fatalf("visit order: got %q, want %q", got, want)
+	}
+}
+
+func TestCrawl_RespectsNofollow(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		if r.URL.Path == "/" {
+			w.Write([]byte(`<html><body>
+<a href="/followed">followed</a>
+<a href="/sponsored" rel="sponsored nofollow">ad</a>
+<a href="/also-followed" rel="noopener">ok</a>
+<a href="/skipped" rel="NOFOLLOW">skip</a>
+</body></html>`))
+			return
+		}
+		w.Write([]byte("<html><body>leaf</body></html>"))
+	}))
+	defer srv.Close()
+
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	crawl := func(respect *bool) string {
+		var visited []string
+		err := cli.Crawl(context.Background(), srv.URL+"/", CrawlOptions{
+			MaxDepth:        1,
+			RespectNofollow: respect,
+			Visitor: CrawlVisitorFunc(func(ctx context.Context, p *CrawledPage) error {
+				visited = append(visited, strings.TrimPrefix(p.URL, srv.URL))
+				return nil
+			}),
+		})
+		if err != nil {
+			t.Fatalf("Crawl error: %v", err)
+		}
+		return strings.Join(visited, ",")
+	}
+
+	yes, no := true, false
+	if got, want := crawl(nil), "/,/followed,/also-followed"; got != want {
+		t.Fatalf("default crawl: got %q, want %q", got, want)
+	}
+	if got, want := crawl(&yes), "/,/followed,/also-followed"; got != want {
+		t.Fatalf("RespectNofollow=true crawl: got %q, want %q", got, want)
+	}
+	if got, want := crawl(&no), "/,/followed,/sponsored,/also-followed,/skipped"; got != want {
+		t.Fatalf("RespectNofollow=false crawl: got %q, want %q", got, want)
+	}
+}
+
+func TestCrawlMulti_SharedVisitedSet(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		links := ""
+		switch r.URL.Path {
+		case "/a":
+			links = `<a href="/shared">s</a><a href="/only-a">a</a>`
+		case "/b":
+			links = `<a href="/shared">s</a><a href="/a">back</a>`
+		}
+		fmt.Fprintf(w, `<html><body><p>Page %s</p>%s</body></html>`, r.URL.Path, links)
+	}))
+	defer srv.Close()
+
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	seen := map[string]int{}
+	var order []string
+	err = cli.CrawlMulti(context.Background(), []string{srv.URL + "/a", srv.URL + "/b"}, CrawlOptions{
+		MaxDepth:     1,
+		SameHostOnly: true,
+		Visitor: CrawlVisitorFunc(func(ctx context.Context, p *CrawledPage) error {
+			path := strings.TrimPrefix(p.URL, srv.URL)
+			seen[path]++
+			order = append(order, fmt.Sprintf("%s@%d", path, p.Depth))
+			return nil
+		}),
+	})
+	if err != nil {
+		t.Fatalf("CrawlMulti error: %v", err)
+	}
+
+	if got, want := strings.Join(order, ","), "/a@0,/b@0,/shared@1,/only-a@1"; got != want {
+		t.Fatalf("visit order: got %q, want %q", got, want)
+	}
+	for path, n := range seen {
+		if n != 1 {
+			t.Fatalf("%s visited %d times, want once", path, n)
+		}
+	}
+}
+
+func TestCrawlMulti_StreamAndCollect(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		links := ""
+		switch r.URL.Path {
+		case "/a", "/b":
+			links = `<a href="/shared">s</a>`
+		}
+		fmt.Fprintf(w, `<html><body><p>Page %s</p>%s</body></html>`, r.URL.Path, links)
+	}))
+	defer srv.Close()
+
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	ctx := context.Background()
+	seeds := []string{srv.URL + "/a", srv.URL + "/b"}
+	opts := CrawlOptions{MaxDepth: 1, SameHostOnly: true}
+	want := "/a,/b,/shared"
+
+	pages, errs := cli.CrawlMultiStream(ctx, seeds, opts)
+	var streamed []string
+	for p := range pages {
+		streamed = append(streamed, strings.TrimPrefix(p.URL, srv.URL))
+	}
+	if err := <-errs; err != nil {
+		t.Fatalf("CrawlMultiStream error: %v", err)
+	}
+	if got := strings.Join(streamed, ","); got != want {
+		t.Fatalf("streamed pages: got %q, want %q", got, want)
+	}
+
+	collected, err := cli.CrawlMultiCollect(ctx, seeds, opts)
+	if err != nil {
+		t.Fatalf("CrawlMultiCollect error: %v", err)
+	}
+	var paths []string
+	for _, p := range collected {
+		paths = append(paths, strings.TrimPrefix(p.URL, srv.URL))
+	}
+	if got := strings.Join(paths, ","); got != want {
+		t.Fatalf("collected pages: got %q, want %q", got, want)
+	}
+
+	if _, errs := cli.CrawlMultiStream(ctx, nil, opts); <-errs == nil {
+		t.Fatal("CrawlMultiStream without seeds: want an error")
+	}
+}
+
+func TestCrawl_HostStats(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		links := ""
+		if r.URL.Path == "/" {
+			links = `<a href="/one">1</a><a href="/two">2</a>`
+		}
+		fmt.Fprintf(w, `<html><body><p>Page %s</p>%s</body></html>`, r.URL.Path, links)
+	}))
+	defer srv.Close()
+
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	stats := &CrawlStats{}
+	if got := stats.Hosts(); got != nil {
+		t.Fatalf("Hosts before crawl: got %v, want nil", got)
+	}
+
+	err = cli.Crawl(context.Background(), srv.URL+"/", CrawlOptions{
+		MaxDepth:   1,
+		FetchDelay: time.Millisecond,
+		Stats:      stats,
+		Visitor: CrawlVisitorFunc(func(ctx context.Context, p *CrawledPage) error {
+			return nil
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Crawl error: %v", err)
+	}
+
+	host := strings.TrimPrefix(srv.URL, "http://")
+	hosts := stats.Hosts()
+	if len(hosts) != 1 {
+		t.Fatalf("Hosts: got %v, want only %q", hosts, host)
+	}
+	st := hosts[host]
+	if st.Requests != 3 {
+		t.Fatalf("Requests: got %d, want 3", st.Requests)
+	}
+	if st.NextAllowed.IsZero() {
+		t.Fatalf("NextAllowed: got zero, want the end of the fetch delay")
+	}
+}
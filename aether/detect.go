@@ -7,6 +7,8 @@ package aether
 
 import (
 	"context"
+	"net/http"
+	"strings"
 
 	idetect "github.com/Nibir1/Aether/internal/detect"
 	ihtml "github.com/Nibir1/Aether/internal/html"
@@ -39,8 +41,31 @@ func (c *Client) Detect(ctx context.Context, url string) (*DetectionResult, erro
 		return nil, err
 	}
 
+	return detectBody(url, res.Body, res.Header.Clone()), nil
+}
+
+// DetectBytes runs the same detection as Detect on a body the caller
+// already has, such as a file or an earlier fetch, without touching the
+// network. contentTypeHint plays the role of the Content-Type response
+// header and may be empty, in which case the type is sniffed from body.
+// The result's URL is empty.
+func (c *Client) DetectBytes(body []byte, contentTypeHint string) (*DetectionResult, error) {
+	if c == nil {
+		return nil, ErrNilClient
+	}
+
+	h := http.Header{}
+	if ct := strings.TrimSpace(contentTypeHint); ct != "" {
+		h.Set("Content-Type", ct)
+	}
+	return detectBody("", body, h), nil
+}
+
+// detectBody implements Detect and DetectBytes for a fetched or given
+// body.
+func detectBody(url string, body []byte, headers http.Header) *DetectionResult {
 	// Step 1: MIME + heuristic detection
-	dr := idetect.Detect(res.Body, res.Header.Clone())
+	dr := idetect.Detect(body, headers)
 
 	out := &DetectionResult{
 		URL:      url,
@@ -55,7 +80,7 @@ func (c *Client) Detect(ctx context.Context, url string) (*DetectionResult, erro
 
 	// Step 2: For HTML, extract title, description, canonical URL, etc.
 	if dr.RawType == idetect.TypeHTML {
-		doc, err := ihtml.ParseDocument(res.Body)
+		doc, err := ihtml.ParseDocument(body)
 		if err == nil {
 			meta := idetect.ExtractBasicMeta(doc)
 			out.Metadata = meta
@@ -64,5 +89,5 @@ func (c *Client) Detect(ctx context.Context, url string) (*DetectionResult, erro
 		}
 	}
 
-	return out, nil
+	return out
 }
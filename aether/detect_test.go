@@ -0,0 +1,80 @@
+// aether/detect_test.go
+package aether
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const detectFixture = `<!DOCTYPE html>
+<html lang="en"><head>
+<meta charset="ISO-8859-1">
+<title>Local Page</title>
+<link rel="canonical" href="https://example.com/local">
+</head><body><article><p>Hello.</p></article></body></html>`
+
+func TestDetectBytes_HTMLTitleAndCharset(t *testing.T) {
+	cli, err := NewClient(WithOfflineMode(true))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	got, err := cli.DetectBytes([]byte(detectFixture), "")
+	if err != nil {
+		t.Fatalf("DetectBytes error: %v", err)
+	}
+	if got.RawType != "html" {
+		t.Fatalf("RawType: got %q, want %q", got.RawType, "html")
+	}
+	if got.Title != "Local Page" {
+		t.Fatalf("Title: got %q, want %q", got.Title, "Local Page")
+	}
+	if got.Charset != "iso-8859-1" {
+		t.Fatalf("Charset: got %q, want %q", got.Charset, "iso-8859-1")
+	}
+
+	hinted, err := cli.DetectBytes([]byte(detectFixture), "text/html; charset=UTF-8")
+	if err != nil {
+		t.Fatalf("DetectBytes error: %v", err)
+	}
+	if hinted.Charset != "utf-8" {
+		t.Fatalf("Charset with hint: got %q, want %q", hinted.Charset, "utf-8")
+	}
+
+	if _, err := cli.DetectBytes([]byte("hi"), ""); err != nil {
+		t.Fatalf("DetectBytes on a short body: %v", err)
+	}
+}
+
+func TestDetectBytes_MatchesFetchPath(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(detectFixture))
+	}))
+	defer srv.Close()
+
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	fetched, err := cli.Detect(context.Background(), srv.URL+"/page")
+	if err != nil {
+		t.Fatalf("Detect error: %v", err)
+	}
+	local, err := cli.DetectBytes([]byte(detectFixture), "text/html")
+	if err != nil {
+		t.Fatalf("DetectBytes error: %v", err)
+	}
+
+	if local.RawType != fetched.RawType || local.SubType != fetched.SubType ||
+		local.MIME != fetched.MIME || local.Charset != fetched.Charset ||
+		local.Title != fetched.Title || local.Canonical != fetched.Canonical {
+		t.Fatalf("DetectBytes differs from Detect:\ngot  %+v\nwant %+v", local, fetched)
+	}
+}
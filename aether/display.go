@@ -17,9 +17,15 @@ package aether
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/Nibir1/Aether/internal/display"
+	ihtml "github.com/Nibir1/Aether/internal/html"
 	"github.com/Nibir1/Aether/internal/model"
 	"github.com/Nibir1/Aether/plugins"
 )
@@ -42,6 +48,30 @@ func (c *Client) RenderMarkdownWithTheme(doc *NormalizedDocument, theme display.
 	return r.RenderMarkdown((*model.Document)(doc))
 }
 
+// SectionRenderFunc renders one section with a custom role as text.
+type SectionRenderFunc = display.SectionRenderFunc
+
+// RegisterSectionRenderer makes the built-in text formats (markdown,
+// text) render sections with the given role through fn instead of the
+// default body layout. It is meant for plugins that emit roles of their
+// own; a nil fn restores the default. Registrations are process-wide.
+func RegisterSectionRenderer(role SectionRole, fn SectionRenderFunc) {
+	display.RegisterSectionRenderer(role, fn)
+}
+
+// RenderTOC renders the section headings of doc as a nested bullet list
+// with the default theme. Sections without a heading are skipped, and
+// nesting follows each section's heading level (the "heading_level"
+// section metadata when present).
+func (c *Client) RenderTOC(doc *NormalizedDocument) string {
+	return display.RenderTOC((*model.Document)(doc), display.DefaultTheme())
+}
+
+// RenderTOCWithTheme is RenderTOC with a custom theme.
+func (c *Client) RenderTOCWithTheme(doc *NormalizedDocument, theme display.Theme) string {
+	return display.RenderTOC((*model.Document)(doc), theme)
+}
+
 //
 // ───────────────────────────────────────────────────────────────────────────
 //                               PREVIEW RENDERING
@@ -60,6 +90,15 @@ func (c *Client) RenderPreviewWithTheme(doc *NormalizedDocument, theme display.T
 	return pr.RenderPreview(p)
 }
 
+// RenderPreviewHighlight renders a preview whose summary emphasizes every
+// case-insensitive, whole-word occurrence of terms with the theme's
+// strong style, e.g. to show why a search result matched.
+func (c *Client) RenderPreviewHighlight(doc *NormalizedDocument, terms []string, theme display.Theme) string {
+	pr := display.NewPreviewRenderer(theme)
+	p := pr.MakePreview((*model.Document)(doc))
+	return pr.RenderPreviewHighlight(p, terms)
+}
+
 //
 // ───────────────────────────────────────────────────────────────────────────
 //                                 TABLE RENDERING
@@ -76,6 +115,17 @@ func (c *Client) RenderTableWithTheme(header []string, rows [][]string, theme di
 	return display.RenderTable(theme, tbl)
 }
 
+//
+// ───────────────────────────────────────────────────────────────────────────
+//                                  CODE BLOCKS
+// ───────────────────────────────────────────────────────────────────────────
+//
+
+// RenderCodeBlockWithLang renders code verbatim inside a ```lang fence.
+func (c *Client) RenderCodeBlockWithLang(code, lang string) string {
+	return display.RenderCodeBlockWithLang(code, lang)
+}
+
 //
 // ───────────────────────────────────────────────────────────────────────────
 //                                  PUBLIC THEMES
@@ -126,16 +176,113 @@ func (c *Client) ListDisplayFormats() []string {
 	return c.plugins.ListDisplayFormats()
 }
 
+// builtinRenderFormats are the formats Render handles without a
+// DisplayPlugin ("md" and "text" are aliases of "markdown").
+var builtinRenderFormats = []string{"html", "markdown", "md", "preview", "raw", "text"}
+
+// ListAllRenderFormats returns every format Render accepts: the
+// built-in formats plus those of registered DisplayPlugins, lowercase,
+// de-duplicated and sorted. Use IsBuiltinRenderFormat to tell them
+// apart.
+func (c *Client) ListAllRenderFormats() []string {
+	out := slices.Clone(builtinRenderFormats)
+	if c != nil && c.plugins != nil {
+		out = append(out, c.plugins.ListDisplayFormats()...)
+	}
+	slices.Sort(out)
+	return slices.Compact(out)
+}
+
+// IsBuiltinRenderFormat reports whether Render handles format itself
+// rather than through a DisplayPlugin. "html" is built-in unless a
+// registered plugin claims it.
+func (c *Client) IsBuiltinRenderFormat(format string) bool {
+	f := normalizeFormat(format)
+	if f == "html" {
+		return c == nil || !c.hasDisplayPlugin(f)
+	}
+	return f != "" && isBuiltinFormat(f)
+}
+
 //
 // ───────────────────────────────────────────────────────────────────────────
 //                   UNIFIED RENDER DISPATCHER (BUILT-IN + PLUGIN)
 // ───────────────────────────────────────────────────────────────────────────
 //
 
+// RenderOption customizes a single Render call.
+type RenderOption func(*renderOptions)
+
+type renderOptions struct {
+	maxSections int
+
+	// width is the column width for wrapping; 0 leaves it to the theme.
+	width int
+
+	// noColor suppresses ANSI styling; set internally when the output
+	// is headed for a file or another non-terminal writer.
+	noColor bool
+
+	// html configures the built-in "html" format.
+	html display.HTMLOptions
+}
+
+// HTMLPolicy is the tag/attribute allowlist used by WithHTMLSanitizer.
+// Tags maps a lowercase element name to the attributes it may keep.
+type HTMLPolicy = ihtml.Policy
+
+// DefaultHTMLPolicy allows b, i, em, strong, code, p, ul, li and a[href].
+func DefaultHTMLPolicy() HTMLPolicy { return ihtml.DefaultPolicy() }
+
+// WithHTMLSanitizer switches the built-in "html" format from escaping
+// all markup in section text to keeping the tags and attributes allowed
+// by p and stripping the rest. <script> and similar elements are always
+// removed with their content, and javascript: URLs are always dropped.
+// A policy with no tags means DefaultHTMLPolicy.
+func WithHTMLSanitizer(p HTMLPolicy) RenderOption {
+	return func(o *renderOptions) {
+		o.html.Sanitize = true
+		o.html.Policy = p
+	}
+}
+
+// WithHTMLTOC makes the built-in "html" format open with a table of
+// contents linking to the section headings' id anchors.
+func WithHTMLTOC() RenderOption {
+	return func(o *renderOptions) {
+		o.html.TOC = true
+	}
+}
+
+// WithWidth renders built-in formats at n columns instead of the
+// detected width. RenderTo otherwise uses the width of the destination
+// terminal, or 80 columns when the destination is not a terminal.
+// n <= 0 keeps the default.
+func WithWidth(n int) RenderOption {
+	return func(o *renderOptions) {
+		if n > 0 {
+			o.width = n
+		}
+	}
+}
+
+// WithMaxSections renders only the first n sections and ends the output
+// with a note such as "… and 4,980 more items". It applies to the
+// built-in formats and to display plugins (which receive the truncated
+// document plus a trailing note section). n <= 0 means unlimited.
+func WithMaxSections(n int) RenderOption {
+	return func(o *renderOptions) {
+		if n > 0 {
+			o.maxSections = n
+		}
+	}
+}
+
 // Render renders a normalized document into a given format.
-// Built-in: markdown/md, preview, text
+// Built-in: markdown/md, preview, text, raw (the document's content
+// bytes, unrendered), and html when no DisplayPlugin claims it.
 // All other formats → MUST come from a DisplayPlugin.
-func (c *Client) Render(ctx context.Context, format string, doc *NormalizedDocument) ([]byte, error) {
+func (c *Client) Render(ctx context.Context, format string, doc *NormalizedDocument, opts ...RenderOption) ([]byte, error) {
 	if c == nil {
 		return nil, fmt.Errorf("aether: nil client")
 	}
@@ -143,18 +290,31 @@ func (c *Client) Render(ctx context.Context, format string, doc *NormalizedDocum
 		return nil, fmt.Errorf("aether: nil document")
 	}
 
-	f := normalizeFormat(format)
+	var ro renderOptions
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&ro)
+		}
+	}
 
-	// ───── Built-in formats ────────────────────────────────────────────────
-	switch f {
-	case "markdown", "md", "":
-		return []byte(c.RenderMarkdown(doc)), nil
+	theme := display.DefaultTheme()
+	theme.MaxSections = ro.maxSections
+	if ro.width > 0 {
+		theme.MaxWidth = ro.width
+	} else if w, ok := c.detectWidth(); ok && w > 0 {
+		theme.MaxWidth = w
+	}
+	if ro.noColor {
+		theme.Color = display.ColorModeNever
+	}
 
-	case "text":
-		return []byte(c.RenderMarkdown(doc)), nil
+	f := normalizeFormat(format)
 
-	case "preview":
-		return []byte(c.RenderPreview(doc)), nil
+	// ───── Built-in formats (and HTML unless a plugin overrides it) ────────
+	if isBuiltinFormat(f) || (f == "html" && !c.hasDisplayPlugin(f)) {
+		return c.cachedRender(f, doc, theme, &ro, func() ([]byte, error) {
+			return c.renderBuiltin(f, doc, theme, &ro)
+		})
 	}
 
 	// ───── Plugin-required formats (Strict Mode) ───────────────────────────
@@ -168,17 +328,181 @@ func (c *Client) Render(ctx context.Context, format string, doc *NormalizedDocum
 	}
 
 	// NormalizedDocument is an alias of model.Document — convert properly.
-	m := (*model.Document)(doc)
+	m, omitted := display.TruncateSections((*model.Document)(doc), ro.maxSections)
 	pdoc := modelToPluginDocument(m)
+	if omitted > 0 {
+		pdoc.Sections = append(pdoc.Sections, plugins.Section{
+			Role: plugins.SectionRole(model.SectionRoleMetadata),
+			Text: display.OverflowNote(omitted),
+			Meta: map[string]string{"omitted_sections": strconv.Itoa(omitted)},
+		})
+	}
 
 	return p.Render(ctx, pdoc)
 }
 
+// renderBuiltin renders doc in a format Render handles itself.
+func (c *Client) renderBuiltin(f string, doc *NormalizedDocument, theme display.Theme, ro *renderOptions) ([]byte, error) {
+	switch f {
+	case "markdown", "md", "", "text":
+		return []byte(c.RenderMarkdownWithTheme(doc, theme)), nil
+
+	case "preview":
+		return []byte(c.RenderPreviewWithTheme(doc, theme)), nil
+
+	case "raw":
+		return rawContent(doc)
+
+	case "html":
+		r := display.NewRenderer(theme)
+		return []byte(r.RenderHTML((*model.Document)(doc), ro.html)), nil
+	}
+	return nil, fmt.Errorf("aether: %q is not a built-in format", f)
+}
+
+// RenderToFile renders doc in the given format via Render and writes the
+// output to path with mode 0644, replacing any existing file. Like
+// RenderTo for a non-terminal writer, the output carries no ANSI styling
+// and is wrapped to 80 columns unless WithWidth says otherwise.
+//
+// The format is resolved before anything is written: an unknown format,
+// or a destination extension rejected by a DisplayPlugin implementing
+// plugins.DisplayFileMetadata, returns an error without touching the
+// filesystem.
+func (c *Client) RenderToFile(ctx context.Context, format string, doc *NormalizedDocument, path string, opts ...RenderOption) error {
+	if c == nil {
+		return fmt.Errorf("aether: nil client")
+	}
+	if strings.TrimSpace(path) == "" {
+		return fmt.Errorf("aether: empty output path")
+	}
+
+	if f := normalizeFormat(format); !isBuiltinFormat(f) && (f != "html" || c.hasDisplayPlugin(f)) {
+		if c.plugins == nil {
+			return fmt.Errorf("aether: no plugin registry available for format %q", f)
+		}
+		p := c.plugins.FindDisplayByFormat(f)
+		if p == nil {
+			return fmt.Errorf("aether: no display plugin registered for format %q", f)
+		}
+		if fm, ok := p.(plugins.DisplayFileMetadata); ok {
+			if err := checkFileExtension(path, fm.FileExtensions()); err != nil {
+				return fmt.Errorf("aether: format %q: %w", f, err)
+			}
+		}
+	}
+
+	// Files get the fallback width rather than the width of whatever
+	// terminal the program runs in; an explicit WithWidth still wins.
+	opts = append([]RenderOption{WithWidth(display.DefaultWidth)}, opts...)
+	out, err := c.Render(ctx, format, doc, append(opts, withoutColor())...)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, out, 0o644); err != nil {
+		return fmt.Errorf("aether: write %s: %w", path, err)
+	}
+	return nil
+}
+
+// RenderTo renders doc in the given format via Render and writes the
+// output to w. ANSI styling is only emitted when w is a terminal, so
+// redirecting a program's output to a file yields clean text. Text is
+// wrapped to the terminal's width, or to 80 columns when w is not a
+// terminal; WithWidth overrides both.
+func (c *Client) RenderTo(ctx context.Context, w io.Writer, format string, doc *NormalizedDocument, opts ...RenderOption) error {
+	if c == nil {
+		return fmt.Errorf("aether: nil client")
+	}
+	if w == nil {
+		return fmt.Errorf("aether: nil writer")
+	}
+	if !display.IsTerminalWriter(w) {
+		opts = append(opts, withoutColor())
+	}
+	// The detected width goes first so an explicit WithWidth wins.
+	opts = append([]RenderOption{WithWidth(display.WriterWidth(w))}, opts...)
+
+	out, err := c.Render(ctx, format, doc, opts...)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(out); err != nil {
+		return fmt.Errorf("aether: write: %w", err)
+	}
+	return nil
+}
+
+// detectWidth reports the width of the terminal on stdout.
+func (c *Client) detectWidth() (int, bool) {
+	if c.terminalWidth != nil {
+		return c.terminalWidth()
+	}
+	return display.DetectTerminalWidth()
+}
+
+// withoutColor disables ANSI styling for built-in formats.
+func withoutColor() RenderOption {
+	return func(o *renderOptions) { o.noColor = true }
+}
+
+// StripANSI removes ANSI escape sequences from s, for callers that
+// capture rendered output and need plain text regardless of the theme.
+func (c *Client) StripANSI(s string) string {
+	return display.StripANSI(s)
+}
+
+// isBuiltinFormat reports whether the normalized format is rendered
+// without a DisplayPlugin.
+func isBuiltinFormat(f string) bool {
+	switch f {
+	case "markdown", "md", "", "text", "preview", "raw":
+		return true
+	}
+	return false
+}
+
+// rawContent returns the document's primary content untouched: Content,
+// or the text of the first body section when Content is empty.
+func rawContent(doc *NormalizedDocument) ([]byte, error) {
+	if strings.TrimSpace(doc.Content) != "" {
+		return []byte(doc.Content), nil
+	}
+	for _, s := range doc.Sections {
+		if s.Role == model.SectionRoleBody && strings.TrimSpace(s.Text) != "" {
+			return []byte(s.Text), nil
+		}
+	}
+	return nil, fmt.Errorf("aether: raw format: document has no content or body section text")
+}
+
+// hasDisplayPlugin reports whether a DisplayPlugin is registered for the
+// normalized format.
+func (c *Client) hasDisplayPlugin(f string) bool {
+	return c.plugins != nil && c.plugins.FindDisplayByFormat(f) != nil
+}
+
+// checkFileExtension verifies that path ends in one of exts. An empty
+// list accepts any path.
+func checkFileExtension(path string, exts []string) error {
+	if len(exts) == 0 {
+		return nil
+	}
+	ext := filepath.Ext(path)
+	for _, e := range exts {
+		if strings.EqualFold(ext, e) {
+			return nil
+		}
+	}
+	return fmt.Errorf("file extension %q not in %s", ext, strings.Join(exts, ", "))
+}
+
 // RenderSearchResult normalizes a SearchResult and passes it to Render().
-func (c *Client) RenderSearchResult(ctx context.Context, format string, sr *SearchResult) ([]byte, error) {
+func (c *Client) RenderSearchResult(ctx context.Context, format string, sr *SearchResult, opts ...RenderOption) ([]byte, error) {
 	if sr == nil {
 		return nil, fmt.Errorf("aether: nil SearchResult")
 	}
 	doc := c.NormalizeSearchResult(sr)
-	return c.Render(ctx, format, doc)
+	return c.Render(ctx, format, doc, opts...)
 }
@@ -0,0 +1,279 @@
+// aether/display_test.go
+package aether
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Nibir1/Aether/internal/display"
+	"github.com/Nibir1/Aether/plugins"
+)
+
+type htmlDisplay struct{}
+
+func (htmlDisplay) Name() string             { return "html" }
+func (htmlDisplay) Description() string      { return "renders HTML" }
+func (htmlDisplay) Format() string           { return "html" }
+func (htmlDisplay) FileExtensions() []string { return []string{".html", ".htm"} }
+func (htmlDisplay) Render(ctx context.Context, doc *plugins.Document) ([]byte, error) {
+	return []byte("<h1>" + doc.Title + "</h1>"), nil
+}
+
+func TestRenderToFile_Markdown(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	doc := &NormalizedDocument{
+		Title:   "Saved",
+		Excerpt: "Written straight to disk.",
+	}
+
+	path := filepath.Join(t.TempDir(), "out.md")
+	if err := cli.RenderToFile(context.Background(), "markdown", doc, path); err != nil {
+		t.Fatalf("RenderToFile error: %v", err)
+	}
+
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile error: %v", err)
+	}
+	want, _ := cli.Render(context.Background(), "markdown", doc)
+	if string(got) != string(want) {
+		t.Fatalf("file contents: got %q, want %q", got, want)
+	}
+	if !strings.Contains(string(got), "Saved") {
+		t.Fatalf("file contents missing title: %q", got)
+	}
+}
+
+func TestRenderToFile_RejectsBeforeWriting(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	if err := cli.RegisterDisplayPlugin(htmlDisplay{}); err != nil {
+		t.Fatalf("RegisterDisplayPlugin error: %v", err)
+	}
+	doc := &NormalizedDocument{Title: "Nope"}
+	dir := t.TempDir()
+
+	cases := map[string]string{
+		"unknown format":  "nosuchformat",
+		"wrong extension": "html",
+	}
+	for name, format := range cases {
+		path := filepath.Join(dir, name+".txt")
+		if err := cli.RenderToFile(context.Background(), format, doc, path); err == nil {
+			t.Fatalf("%s: expected error", name)
+		}
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Fatalf("%s: file was created", name)
+		}
+	}
+
+	path := filepath.Join(dir, "ok.html")
+	if err := cli.RenderToFile(context.Background(), "html", doc, path); err != nil {
+		t.Fatalf("RenderToFile error: %v", err)
+	}
+}
+
+func TestRenderToFile_IgnoresTerminalWidth(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	// Even when run from a wide terminal, a file gets the fallback width.
+	cli.terminalWidth = func() (int, bool) { return 200, true }
+
+	doc := &NormalizedDocument{
+		Title:   "Wide",
+		Excerpt: strings.Repeat("lorem ipsum dolor sit amet ", 20),
+	}
+
+	wide, err := cli.Render(context.Background(), "preview", doc)
+	if err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	if !strings.Contains(string(wide), strings.Repeat("lorem ipsum dolor sit amet ", 3)) {
+		t.Fatalf("Render ignored the terminal width:\n%s", wide)
+	}
+
+	path := filepath.Join(t.TempDir(), "out.txt")
+	if err := cli.RenderToFile(context.Background(), "preview", doc, path); err != nil {
+		t.Fatalf("RenderToFile error: %v", err)
+	}
+	got, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile error: %v", err)
+	}
+	for _, line := range strings.Split(string(got), "\n") {
+		if n := len([]rune(line)); n > display.DefaultWidth {
+			t.Fatalf("line wider than %d columns (%d): %q", display.DefaultWidth, n, line)
+		}
+	}
+}
+
+func TestRenderTo_BufferHasNoANSI(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	doc := &NormalizedDocument{
+		Title: "Piped",
+		Sections: []NormalizedSection{
+			{Role: SectionRoleBody, Heading: "Intro", Text: "Some **bold** text."},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := cli.RenderTo(context.Background(), &buf, "markdown", doc); err != nil {
+		t.Fatalf("RenderTo error: %v", err)
+	}
+	if buf.Len() == 0 {
+		t.Fatalf("RenderTo wrote nothing")
+	}
+	if strings.Contains(buf.String(), "\x1b") {
+		t.Fatalf("ANSI escape in buffer output: %q", buf.String())
+	}
+	if got := cli.StripANSI("\x1b[1mbold\x1b[0m"); got != "bold" {
+		t.Fatalf("StripANSI: got %q, want %q", got, "bold")
+	}
+}
+
+func TestRender_HTMLSanitizer(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	doc := &NormalizedDocument{
+		Title: "Doc",
+		Sections: []NormalizedSection{
+			{Role: SectionRoleBody, Text: `<p><em>hi</em><script>x()</script><a href="javascript:x()">go</a></p>`},
+		},
+	}
+
+	escaped, err := cli.Render(context.Background(), "html", doc)
+	if err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	if !strings.Contains(string(escaped), "&lt;em&gt;") {
+		t.Fatalf("default html render should escape markup: %q", escaped)
+	}
+
+	clean, err := cli.Render(context.Background(), "html", doc, WithHTMLSanitizer(DefaultHTMLPolicy()))
+	if err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	out := string(clean)
+	if !strings.Contains(out, "<p><em>hi</em><a>go</a></p>") {
+		t.Fatalf("sanitized output: %q", out)
+	}
+	if strings.Contains(out, "script") || strings.Contains(out, "javascript") {
+		t.Fatalf("unsafe content survived: %q", out)
+	}
+}
+
+func TestRender_RawPassesContentThrough(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	content := "{\"items\": [1, 2]}\n  **not markdown**\n"
+	got, err := cli.Render(context.Background(), "raw", &NormalizedDocument{Title: "JSON", Content: content})
+	if err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	if string(got) != content {
+		t.Fatalf("raw: got %q, want %q", got, content)
+	}
+
+	body := &NormalizedDocument{Sections: []NormalizedSection{
+		{Role: SectionRoleMetadata, Text: "meta"},
+		{Role: SectionRoleBody, Text: "  body text  "},
+	}}
+	if got, err := cli.Render(context.Background(), "raw", body); err != nil || string(got) != "  body text  " {
+		t.Fatalf("raw from body section: got %q, %v", got, err)
+	}
+
+	if _, err := cli.Render(context.Background(), "raw", &NormalizedDocument{Title: "Empty"}); err == nil {
+		t.Fatal("raw with no content: expected an error")
+	}
+}
+
+func TestParseMarkdown_RoundTripIsStable(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	md := "# Field notes\n\nObservations from the trip.\n\n## Morning\n\nFog over the lake.\n\n## Evening\n\nClear skies.\n\n```text\nlog: 21:00 clear\n```"
+
+	doc := cli.ParseMarkdown(md)
+	if doc.Title != "Field notes" || doc.Excerpt != "Observations from the trip." {
+		t.Fatalf("title %q, excerpt %q", doc.Title, doc.Excerpt)
+	}
+	if len(doc.Sections) != 4 || doc.Sections[0].Text != "Observations from the trip." ||
+		doc.Sections[1].Heading != "Morning" || doc.Sections[2].Heading != "Evening" {
+		t.Fatalf("sections: %+v", doc.Sections)
+	}
+
+	first := cli.RenderMarkdown(doc)
+	if first != md {
+		t.Fatalf("first render:\ngot  %q\nwant %q", first, md)
+	}
+	second := cli.RenderMarkdown(cli.ParseMarkdown(first))
+	if second != first {
+		t.Fatalf("second render:\ngot  %q\nwant %q", second, first)
+	}
+}
+
+type pdfDisplay struct{}
+
+func (pdfDisplay) Name() string        { return "pdf" }
+func (pdfDisplay) Description() string { return "renders PDF" }
+func (pdfDisplay) Format() string      { return "PDF" }
+func (pdfDisplay) Render(ctx context.Context, doc *plugins.Document) ([]byte, error) {
+	return []byte("%PDF"), nil
+}
+
+func TestListAllRenderFormats_BuiltinsAndPlugins(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	if err := cli.RegisterDisplayPlugin(pdfDisplay{}); err != nil {
+		t.Fatalf("RegisterDisplayPlugin error: %v", err)
+	}
+	if err := cli.RegisterDisplayPlugin(htmlDisplay{}); err != nil {
+		t.Fatalf("RegisterDisplayPlugin error: %v", err)
+	}
+
+	got := cli.ListAllRenderFormats()
+	want := []string{"html", "markdown", "md", "pdf", "preview", "raw", "text"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ListAllRenderFormats: got %q, want %q", got, want)
+	}
+
+	for f, builtin := range map[string]bool{
+		"markdown": true, "MD": true, "raw": true, "pdf": false, "html": false, "": false,
+	} {
+		if got := cli.IsBuiltinRenderFormat(f); got != builtin {
+			t.Fatalf("IsBuiltinRenderFormat(%q): got %v, want %v", f, got, builtin)
+		}
+	}
+
+	plain, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	if !plain.IsBuiltinRenderFormat("html") {
+		t.Fatal("html without a plugin should be built-in")
+	}
+}
@@ -31,6 +31,7 @@ import (
 //   • HTTP & transport errors
 //   • robots.txt violations
 //   • parsing errors
+//   • per-operation timeouts (e.g. a slow OpenAPI endpoint)
 //
 // This is re-exported from internal/errors.Kind.
 // Using a type alias guarantees binary and semantic compatibility.
@@ -50,6 +51,9 @@ const (
 	ErrorKindHTTP    ErrorKind = internal.KindHTTP
 	ErrorKindRobots  ErrorKind = internal.KindRobots
 	ErrorKindParsing ErrorKind = internal.KindParsing
+	ErrorKindTimeout ErrorKind = internal.KindTimeout
+	ErrorKindOffline ErrorKind = internal.KindOffline
+	ErrorKindClosed  ErrorKind = internal.KindClosed
 )
 
 // ───────────────────────────────────────────────────────────────
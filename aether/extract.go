@@ -10,9 +10,12 @@ package aether
 import (
 	"context"
 	"fmt"
+	neturl "net/url"
 
+	"github.com/Nibir1/Aether/internal/config"
 	iextract "github.com/Nibir1/Aether/internal/extract"
 	ihtml "github.com/Nibir1/Aether/internal/html"
+	"github.com/Nibir1/Aether/internal/trace"
 )
 
 //
@@ -27,16 +30,32 @@ import (
 // HTML is a sanitized HTML fragment of the main content.
 // Excerpt is a short summary derived from the article body.
 // Meta contains document metadata extracted from <meta> tags.
+// Images lists absolute URLs of images found in the main content, with
+// tracking pixels filtered out. Meta["lead_image"] holds the preferred
+// preview image (og:image if present, else the first content image).
+// StructuredData lists the schema.org items (Product, Recipe, Event, …)
+// the page declares in JSON-LD; normalization turns each into an entity
+// section.
+// Extracted is false when Content is shorter than the client's minimum
+// article length (see WithMinArticleLength); callers should then treat
+// the page as a non-article and fall back to its raw text or metadata.
 type Article struct {
-	URL     string
-	Title   string
-	Byline  string
-	Content string
-	HTML    string
-	Excerpt string
-	Meta    map[string]string
+	URL            string
+	Title          string
+	Byline         string
+	Content        string
+	HTML           string
+	Excerpt        string
+	Images         []string
+	Meta           map[string]string
+	StructuredData []StructuredItem
+	Extracted      bool
 }
 
+// StructuredItem is one schema.org node from a page's JSON-LD, with
+// nested properties flattened into dotted keys such as "offers.price".
+type StructuredItem = ihtml.StructuredItem
+
 //
 // ───────────────────────────────────────────────────────────────
 //                  EXTRACT FROM RAW HTML (NO FETCH)
@@ -48,43 +67,138 @@ type Article struct {
 // url is optional but recommended; it is stored in the Article result
 // and may be used by future features (e.g. canonical URL resolution).
 func (c *Client) ExtractArticleFromHTML(html []byte, url string) (*Article, error) {
+	return c.extractArticle(context.Background(), html, url)
+}
+
+// extractArticle implements ExtractArticleFromHTML inside an extract
+// span started from ctx.
+func (c *Client) extractArticle(ctx context.Context, html []byte, url string) (*Article, error) {
 	if len(html) == 0 {
 		return nil, fmt.Errorf("aether: empty HTML buffer")
 	}
 
-	doc, err := ihtml.ParseDocument(html)
+	_, end := trace.Start(c.tracer(), ctx, trace.SpanExtract)
+	defer end()
+
+	doc, err := ihtml.ParseArticleDocument(html)
 	if err != nil {
 		return nil, err
 	}
 
-	// Base HTML metadata
+	// Base HTML metadata, plus normalized OpenGraph / Twitter Card keys.
 	title := ihtml.ExtractTitle(doc)
 	meta := ihtml.ExtractMeta(doc)
+	for k, v := range ihtml.SocialMeta(meta) {
+		meta[k] = v
+	}
+	// JSON-LD is read before extraction, which strips <script> elements.
+	structured := ihtml.ExtractJSONLD(doc)
 
 	// Run Readability-style extraction
-	internal := iextract.Extract(doc, url)
+	internal := iextract.ExtractWithOptions(doc, url, iextract.Options{
+		Aggressiveness: c.extractionAggressiveness(),
+	})
 	if internal == nil {
 		internal = &iextract.Article{}
 	}
 
-	// Prefer extractor title if present; otherwise use <title>.
+	// Prefer extractor title if present; then og:title / twitter:title,
+	// which are usually free of site-name suffixes; finally <title>.
 	finalTitle := internal.Title
+	if finalTitle == "" {
+		finalTitle = meta["og_title"]
+	}
+	if finalTitle == "" {
+		finalTitle = meta["twitter_title"]
+	}
 	if finalTitle == "" {
 		finalTitle = title
 	}
 
+	// Lead image: OpenGraph / Twitter image first, then first content image.
+	lead := meta["og_image"]
+	if lead == "" {
+		lead = meta["twitter_image"]
+	}
+	if lead == "" {
+		lead = internal.TopImageURL
+	}
+	if lead != "" {
+		meta["lead_image"] = lead
+	}
+
 	article := &Article{
-		URL:     url,
-		Title:   finalTitle,
-		Byline:  internal.Byline,
-		Content: internal.Text,
-		HTML:    internal.ContentHTML,
-		Excerpt: internal.Excerpt,
-		Meta:    meta,
+		URL:            url,
+		Title:          finalTitle,
+		Byline:         internal.Byline,
+		Content:        internal.Text,
+		HTML:           internal.ContentHTML,
+		Excerpt:        internal.Excerpt,
+		Images:         internal.Images,
+		Meta:           meta,
+		StructuredData: structured,
+		Extracted:      internal.LongEnough(c.minArticleLength()),
 	}
 	return article, nil
 }
 
+// minArticleLength returns the configured minimum article length, or the
+// default for a nil or unconfigured client.
+func (c *Client) minArticleLength() int {
+	if c == nil || c.cfg == nil {
+		return config.DefaultMinArticleLength
+	}
+	return c.cfg.MinArticleLength
+}
+
+// WithMinArticleLength sets the minimum number of characters of main
+// content for ExtractArticle and ExtractArticleFromHTML to set
+// Article.Extracted. The default is 200; 0 accepts any length.
+func WithMinArticleLength(n int) Option {
+	return func(c *config.Config) {
+		if n >= 0 {
+			c.MinArticleLength = n
+		}
+	}
+}
+
+// ExtractionAggressiveness controls how readily article extraction
+// treats page blocks as boilerplate.
+type ExtractionAggressiveness = iextract.Aggressiveness
+
+const (
+	// ExtractionConservative removes only scripts, embeds and blocks
+	// whose class or id marks them as ads.
+	ExtractionConservative = iextract.AggressivenessConservative
+
+	// ExtractionNormal (the default) also removes navigation, headers,
+	// footers, sidebars, menus and comment threads.
+	ExtractionNormal = iextract.AggressivenessNormal
+
+	// ExtractionAggressive also removes share widgets, related links,
+	// promos, banners and newsletter prompts, and needs more text before
+	// a block is considered main content.
+	ExtractionAggressive = iextract.AggressivenessAggressive
+)
+
+// WithExtractionAggressiveness sets how readily ExtractArticle and
+// ExtractArticleFromHTML drop boilerplate. Class and id hints are matched
+// on whole words, so a "headline" block is never mistaken for an "ad".
+func WithExtractionAggressiveness(level ExtractionAggressiveness) Option {
+	return func(c *config.Config) {
+		c.ExtractionAggressiveness = int(level)
+	}
+}
+
+// extractionAggressiveness returns the configured level, or the default
+// for a nil or unconfigured client.
+func (c *Client) extractionAggressiveness() iextract.Aggressiveness {
+	if c == nil || c.cfg == nil {
+		return iextract.AggressivenessDefault
+	}
+	return iextract.Aggressiveness(c.cfg.ExtractionAggressiveness)
+}
+
 //
 // ───────────────────────────────────────────────────────────────
 //                  HIGH-LEVEL: FETCH + EXTRACT
@@ -95,6 +209,11 @@ func (c *Client) ExtractArticleFromHTML(html []byte, url string) (*Article, erro
 // article extraction on the retrieved HTML.
 //
 // This is a convenience wrapper around Fetch + ExtractArticleFromHTML.
+// Pages whose main content is shorter than the minimum article length
+// still return an Article, with Extracted set to false.
+//
+// With WithPreferAMP(true), a page that declares an AMP version is
+// extracted from that version instead (see ampArticle).
 func (c *Client) ExtractArticle(ctx context.Context, url string) (*Article, error) {
 	if c == nil {
 		return nil, fmt.Errorf("aether: nil client in ExtractArticle")
@@ -107,5 +226,76 @@ func (c *Client) ExtractArticle(ctx context.Context, url string) (*Article, erro
 	if err != nil {
 		return nil, err
 	}
-	return c.ExtractArticleFromHTML(res.Body, url)
+	if c.cfg != nil && c.cfg.PreferAMP {
+		if art := c.ampArticle(ctx, res.Body, url); art != nil {
+			return art, nil
+		}
+	}
+	return c.extractArticle(ctx, res.Body, url)
+}
+
+//
+// ───────────────────────────────────────────────────────────────
+//                         AMP VARIANTS
+// ───────────────────────────────────────────────────────────────
+//
+
+// WithPreferAMP makes ExtractArticle use a page's AMP version when the
+// page declares one with <link rel="amphtml">. AMP pages carry little
+// besides the article, so extraction from them is usually cleaner. The
+// AMP URL is fetched like any other (robots.txt applies), and the page
+// itself is extracted whenever the AMP version cannot be used.
+func WithPreferAMP(enabled bool) Option {
+	return func(c *config.Config) {
+		c.PreferAMP = enabled
+	}
+}
+
+// ampArticle extracts the AMP version declared by page, or returns nil
+// so the caller falls back to page itself: when there is no amphtml
+// link, when fetching it fails (including a robots.txt refusal) or
+// answers a non-2xx status, or when it yields no article. The returned
+// Article keeps pageURL as its URL and records the AMP URL in
+// Meta["amp_url"].
+func (c *Client) ampArticle(ctx context.Context, page []byte, pageURL string) *Article {
+	doc, err := ihtml.ParseDocument(page)
+	if err != nil {
+		return nil
+	}
+	href := ihtml.LinkRel(doc, "amphtml")
+	if href == "" {
+		return nil
+	}
+	base, err := neturl.Parse(pageURL)
+	if err != nil {
+		return nil
+	}
+	ref, err := base.Parse(href)
+	if err != nil || (ref.Scheme != "http" && ref.Scheme != "https") {
+		return nil
+	}
+	ampURL := ref.String()
+	if ampURL == pageURL {
+		return nil
+	}
+
+	res, err := c.Fetch(ctx, ampURL)
+	if err != nil {
+		c.logger.Debugf("aether: AMP version %s unavailable, using %s: %v", ampURL, pageURL, err)
+		return nil
+	}
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		c.logger.Debugf("aether: AMP version %s answered %d, using %s", ampURL, res.StatusCode, pageURL)
+		return nil
+	}
+
+	// Relative links and images in the AMP document resolve against the
+	// AMP URL; the article still reports the page that was asked for.
+	art, err := c.extractArticle(ctx, res.Body, ampURL)
+	if err != nil || !art.Extracted {
+		return nil
+	}
+	art.URL = pageURL
+	art.Meta["amp_url"] = ampURL
+	return art
 }
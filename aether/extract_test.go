@@ -0,0 +1,513 @@
+// aether/extract_test.go
+package aether
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	iextract "github.com/Nibir1/Aether/internal/extract"
+	ihtml "github.com/Nibir1/Aether/internal/html"
+)
+
+const ogFixture = `<!DOCTYPE html>
+<html>
+<head>
+  <meta property="og:title" content="OG Headline">
+  <meta property="og:description" content="OG description text.">
+  <meta property="og:image" content="https://cdn.example.com/lead.jpg">
+  <meta name="twitter:card" content="summary_large_image">
+</head>
+<body>
+  <article>
+    <p>This is the first paragraph of a reasonably long article body, long enough to be picked as content.</p>
+    <p>A second paragraph follows with more text, commas, and detail so the extractor scores it well.</p>
+  </article>
+</body>
+</html>`
+
+func TestExtractArticleFromHTML_OpenGraph(t *testing.T) {
+	var c *Client
+	art, err := c.ExtractArticleFromHTML([]byte(ogFixture), "https://example.com/post")
+	if err != nil {
+		t.Fatalf("ExtractArticleFromHTML error: %v", err)
+	}
+
+	if art.Title != "OG Headline" {
+		t.Fatalf("Title: got %q, want %q", art.Title, "OG Headline")
+	}
+	if got := art.Meta["og_image"]; got != "https://cdn.example.com/lead.jpg" {
+		t.Fatalf("og_image: got %q, want %q", got, "https://cdn.example.com/lead.jpg")
+	}
+	if got := art.Meta["twitter_card"]; got != "summary_large_image" {
+		t.Fatalf("twitter_card: got %q, want %q", got, "summary_large_image")
+	}
+
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	doc := cli.NormalizeSearchResult(&SearchResult{Article: art})
+	if got := doc.Metadata["og_image"]; got != "https://cdn.example.com/lead.jpg" {
+		t.Fatalf("normalized og_image: got %q, want %q", got, "https://cdn.example.com/lead.jpg")
+	}
+	if got := doc.Metadata["og_title"]; got != "OG Headline" {
+		t.Fatalf("normalized og_title: got %q, want %q", got, "OG Headline")
+	}
+}
+
+const imageFixture = `<html><head><title>Images</title></head>
+<body>
+  <article>
+    <img src="https://www.google-analytics.com/collect?v=1" alt="">
+    <img src="/img/spacer-track.png" width="1" height="1">
+    <p>Opening paragraph of the article with enough words, commas, and detail to be the main content.</p>
+    <figure><img src="/img/lead.jpg" srcset="/img/lead-small.jpg 320w, /img/lead-large.jpg 1280w"></figure>
+    <p>Second paragraph of the article, also fairly long, with commas, so scoring picks this block.</p>
+    <p><img src="inline.png"></p>
+  </article>
+</body></html>`
+
+const trackingPathFixture = `<html><head><title>Gallery</title></head>
+<body>
+  <article>
+    <p>Opening paragraph of the gallery post with enough words, commas, and detail to be the main content.</p>
+    <img src="/img/thumb_151x151.jpg">
+    <img src="/img/800x1100.png">
+    <img src="/pixels/sunset.jpg">
+    <img src="/pixelart/cat.png">
+    <img src="/t/1x1.gif">
+    <img src="/track/pixel.gif?id=3">
+    <img src="/b/beacon">
+    <p>Second paragraph of the gallery post, also fairly long, with commas, so scoring picks this block.</p>
+  </article>
+</body></html>`
+
+func TestExtractArticleFromHTML_TrackingPathsMatchWholeTokens(t *testing.T) {
+	var c *Client
+	art, err := c.ExtractArticleFromHTML([]byte(trackingPathFixture), "https://example.com/gallery")
+	if err != nil {
+		t.Fatalf("ExtractArticleFromHTML error: %v", err)
+	}
+
+	want := []string{
+		"https://example.com/img/thumb_151x151.jpg",
+		"https://example.com/img/800x1100.png",
+		"https://example.com/pixels/sunset.jpg",
+		"https://example.com/pixelart/cat.png",
+	}
+	if strings.Join(art.Images, " ") != strings.Join(want, " ") {
+		t.Fatalf("Images: got %v, want %v", art.Images, want)
+	}
+}
+
+func TestExtractArticleFromHTML_LeadImage(t *testing.T) {
+	var c *Client
+	art, err := c.ExtractArticleFromHTML([]byte(imageFixture), "https://example.com/posts/one")
+	if err != nil {
+		t.Fatalf("ExtractArticleFromHTML error: %v", err)
+	}
+
+	want := []string{
+		"https://example.com/img/lead-large.jpg",
+		"https://example.com/posts/inline.png",
+	}
+	if len(art.Images) != len(want) {
+		t.Fatalf("Images: got %v, want %v", art.Images, want)
+	}
+	for i := range want {
+		if art.Images[i] != want[i] {
+			t.Fatalf("Images[%d]: got %q, want %q", i, art.Images[i], want[i])
+		}
+	}
+	if got := art.Meta["lead_image"]; got != want[0] {
+		t.Fatalf("lead_image: got %q, want %q", got, want[0])
+	}
+
+	// og:image takes precedence over content images.
+	og, err := c.ExtractArticleFromHTML([]byte(ogFixture), "https://example.com/post")
+	if err != nil {
+		t.Fatalf("ExtractArticleFromHTML error: %v", err)
+	}
+	if got := og.Meta["lead_image"]; got != "https://cdn.example.com/lead.jpg" {
+		t.Fatalf("lead_image (og): got %q, want %q", got, "https://cdn.example.com/lead.jpg")
+	}
+}
+
+func TestExtractArticleFromHTML_ParagraphsSurviveRendering(t *testing.T) {
+	var c *Client
+	art, err := c.ExtractArticleFromHTML([]byte(ogFixture), "https://example.com/post")
+	if err != nil {
+		t.Fatalf("ExtractArticleFromHTML error: %v", err)
+	}
+	if !strings.Contains(art.Content, "content.\n\nA second paragraph") {
+		t.Fatalf("paragraph break lost in Content: %q", art.Content)
+	}
+
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	theme := DefaultTheme()
+	theme.MaxWidth = 1000
+
+	doc := cli.NormalizeSearchResult(&SearchResult{Article: art}, WithWhitespaceMode(WhitespaceParagraphs))
+	out := cli.RenderMarkdownWithTheme(doc, theme)
+	if !strings.Contains(out, "content.\n\nA second paragraph") {
+		t.Fatalf("paragraph break lost in rendering:\n%s", out)
+	}
+
+	flat := cli.NormalizeSearchResult(&SearchResult{Article: art}, WithWhitespaceMode(WhitespaceCollapse))
+	out = cli.RenderMarkdownWithTheme(flat, theme)
+	if strings.Contains(out, "content.\n\nA second paragraph") {
+		t.Fatalf("collapse mode kept paragraph break:\n%s", out)
+	}
+}
+
+func TestExtractArticleFromHTML_MinArticleLength(t *testing.T) {
+	page := func(text string) []byte {
+		return []byte("<html><body><article><p>" + text + "</p></article></body></html>")
+	}
+	short := page("Moved. Click here to continue to the new site.")
+	long := page(strings.Repeat("A short post that still says something useful. ", 5))
+
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	art, err := cli.ExtractArticleFromHTML(short, "https://example.com/stub")
+	if err != nil {
+		t.Fatalf("short: ExtractArticleFromHTML error: %v", err)
+	}
+	if art.Extracted {
+		t.Fatalf("short: Extracted = true for %d chars of content", len(art.Content))
+	}
+
+	art, err = cli.ExtractArticleFromHTML(long, "https://example.com/post")
+	if err != nil {
+		t.Fatalf("long: ExtractArticleFromHTML error: %v", err)
+	}
+	if !art.Extracted {
+		t.Fatalf("long: Extracted = false for %d chars of content", len(art.Content))
+	}
+
+	lenient, err := NewClient(WithMinArticleLength(0))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	if art, _ := lenient.ExtractArticleFromHTML(short, ""); !art.Extracted {
+		t.Fatalf("WithMinArticleLength(0): Extracted = false")
+	}
+}
+
+const headlineFixture = `<html><body>
+  <div class="headline">
+    <p>Headline story paragraph with plenty of real words, commas, and detail to be kept as content.</p>
+    <p>Another paragraph of the headline story, again long enough, with commas, to score as article text.</p>
+  </div>
+  <div class="headline-ad">
+    <p>Buy now! Sponsored text that should never be part of the extracted article content at all.</p>
+  </div>
+</body></html>`
+
+func TestExtractArticleFromHTML_HeadlineIsNotAnAd(t *testing.T) {
+	for _, level := range []ExtractionAggressiveness{ExtractionConservative, ExtractionNormal, ExtractionAggressive} {
+		cli, err := NewClient(WithExtractionAggressiveness(level))
+		if err != nil {
+			t.Fatalf("NewClient error: %v", err)
+		}
+		art, err := cli.ExtractArticleFromHTML([]byte(headlineFixture), "https://example.com/news")
+		if err != nil {
+			t.Fatalf("ExtractArticleFromHTML error: %v", err)
+		}
+		if !strings.Contains(art.Content, "Headline story paragraph") {
+			t.Fatalf("level %d: headline content dropped: %q", level, art.Content)
+		}
+		if strings.Contains(art.Content, "Buy now") {
+			t.Fatalf("level %d: headline-ad content kept: %q", level, art.Content)
+		}
+	}
+}
+
+const boilerplateClassFixture = `<html><body>
+  <div class="story">
+    <p>Story paragraph with plenty of real words, commas, and detail to be kept as the article content.</p>
+    <p>Another story paragraph, again long enough, with commas, so it scores as the main article text.</p>
+    <div class="advertising"><p>Advertising copy that should be stripped, even though it has words, commas.</p></div>
+    <div class="commentlist"><p>Comment thread text that should be stripped, even with words, and commas.</p></div>
+    <div id="mainnav"><p>Navigation text that should be stripped, even though it has words, and commas.</p></div>
+    <div class="footerwrap"><p>Footer wrapper text that should be stripped, even with words, and commas.</p></div>
+  </div>
+</body></html>`
+
+func TestExtractArticleFromHTML_StripsBaselineBoilerplateClasses(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	art, err := cli.ExtractArticleFromHTML([]byte(boilerplateClassFixture), "https://example.com/story")
+	if err != nil {
+		t.Fatalf("ExtractArticleFromHTML error: %v", err)
+	}
+	if !strings.Contains(art.Content, "Story paragraph") {
+		t.Fatalf("story content dropped: %q", art.Content)
+	}
+	for _, junk := range []string{"Advertising copy", "Comment thread", "Navigation text", "Footer wrapper"} {
+		if strings.Contains(art.Content, junk) {
+			t.Fatalf("%q kept at the default level: %q", junk, art.Content)
+		}
+	}
+}
+
+func TestExtractArticleFromHTML_LargePageMatchesUnprunedExtraction(t *testing.T) {
+	var b strings.Builder
+	b.WriteString(`<html><head><title>Big page</title><meta property="og:title" content="Big Story"></head><body>`)
+	b.WriteString(`<nav class="menu"><a href="/">Home</a></nav><article>`)
+	for b.Len() <= ihtml.PruneThreshold {
+		b.WriteString(`<!-- ad slot --><script>window.dataLayer.push({"event":"view"});</script>`)
+		b.WriteString(`<p>A paragraph of story text, long enough and with commas, so that it scores as content.</p>`)
+		b.WriteString(`<noscript><img src="/pixel.gif"></noscript>`)
+	}
+	b.WriteString(`</article><footer>Copyright</footer></body></html>`)
+	page := []byte(b.String())
+
+	var c *Client
+	art, err := c.ExtractArticleFromHTML(page, "https://example.com/big")
+	if err != nil {
+		t.Fatalf("ExtractArticleFromHTML error: %v", err)
+	}
+
+	if !art.Extracted {
+		t.Fatalf("Extracted: got false, want true")
+	}
+
+	doc, err := ihtml.ParseDocument(page)
+	if err != nil {
+		t.Fatalf("ParseDocument error: %v", err)
+	}
+	want := iextract.ExtractWithOptions(doc, "https://example.com/big", iextract.Options{})
+	if art.Content != want.Text {
+		t.Fatalf("Text differs from unpruned extraction: got %d bytes, want %d", len(art.Content), len(want.Text))
+	}
+	if art.Title != "Big Story" {
+		t.Fatalf("Title: got %q, want %q", art.Title, "Big Story")
+	}
+}
+
+const tableFixture = `<html><body><article>
+  <p>The inner planets differ a lot in how many moons they have, as the table below shows.</p>
+  <table>
+    <thead><tr><th>Planet</th><th>Moons</th></tr></thead>
+    <tbody>
+      <tr><td>Earth</td><td>1</td></tr>
+      <tr><td>Mars</td><td>2</td></tr>
+    </tbody>
+  </table>
+  <p>Mercury and Venus have none at all, which makes them the odd ones out among the planets.</p>
+</article></body></html>`
+
+func TestExtractArticleFromHTML_TableBecomesTableSection(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	art, err := cli.ExtractArticleFromHTML([]byte(tableFixture), "https://example.com/planets")
+	if err != nil {
+		t.Fatalf("ExtractArticleFromHTML error: %v", err)
+	}
+
+	doc := cli.NormalizeSearchResult(&SearchResult{Query: "planets", Article: art})
+	tables := doc.SectionsByRole(SectionRoleTable)
+	if len(tables) != 1 {
+		t.Fatalf("table sections: got %d, want 1 (sections %+v)", len(tables), doc.Sections)
+	}
+	want := "| Planet | Moons |\n| --- | --- |\n| Earth | 1 |\n| Mars | 2 |"
+	if tables[0].Text != want {
+		t.Fatalf("table text:\ngot  %q\nwant %q", tables[0].Text, want)
+	}
+	if n := len(doc.SectionsByRole(SectionRoleBody)); n != 2 {
+		t.Fatalf("body sections: got %d, want 2", n)
+	}
+
+	out := cli.RenderMarkdown(doc)
+	for _, line := range []string{"Earth", "Mars"} {
+		if !strings.Contains(out, line) {
+			t.Fatalf("rendered output lacks %q:\n%s", line, out)
+		}
+	}
+	if strings.Contains(out, "| --- |") {
+		t.Fatalf("table rendered as raw pipe text:\n%s", out)
+	}
+}
+
+const productFixture = `<html><head><title>Trail Shoe</title>
+<script type="application/ld+json">{
+  "@context": "https://schema.org",
+  "@type": "Product",
+  "@id": "https://shop.example.com/p/42#product",
+  "name": "Trail Shoe 2",
+  "description": "A light shoe for rough ground.",
+  "sku": "TS2-42",
+  "brand": {"@type": "Brand", "name": "Acme"},
+  "offers": {"@type": "Offer", "price": 89.95, "priceCurrency": "EUR", "availability": "https://schema.org/InStock"},
+  "aggregateRating": {"@type": "AggregateRating", "ratingValue": "4.6", "reviewCount": 128}
+}</script></head>
+<body><article>
+  <p>The Trail Shoe 2 keeps the grip of its predecessor while shedding almost a hundred grams per pair.</p>
+  <p>Its upper is a single piece of recycled mesh, and the sole uses a softer compound than before.</p>
+</article></body></html>`
+
+func TestExtractArticleFromHTML_JSONLDProductBecomesEntity(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	art, err := cli.ExtractArticleFromHTML([]byte(productFixture), "https://shop.example.com/p/42")
+	if err != nil {
+		t.Fatalf("ExtractArticleFromHTML error: %v", err)
+	}
+	if len(art.StructuredData) != 1 || art.StructuredData[0].Type != "Product" {
+		t.Fatalf("structured data: got %+v, want one Product", art.StructuredData)
+	}
+
+	doc := cli.NormalizeSearchResult(&SearchResult{Query: "trail shoe", Article: art})
+	entities := doc.SectionsByRole(SectionRoleEntity)
+	if len(entities) != 1 {
+		t.Fatalf("entity sections: got %d, want 1 (sections %+v)", len(entities), doc.Sections)
+	}
+	sec := entities[0]
+	if sec.Heading != "Trail Shoe 2" {
+		t.Fatalf("heading: got %q, want %q", sec.Heading, "Trail Shoe 2")
+	}
+	if sec.Text != "A light shoe for rough ground." {
+		t.Fatalf("text: got %q", sec.Text)
+	}
+	for k, want := range map[string]string{
+		"schema_type":                 "Product",
+		"id":                          "https://shop.example.com/p/42#product",
+		"sku":                         "TS2-42",
+		"brand.name":                  "Acme",
+		"offers.price":                "89.95",
+		"offers.priceCurrency":        "EUR",
+		"aggregateRating.ratingValue": "4.6",
+		"aggregateRating.reviewCount": "128",
+	} {
+		if got := sec.Meta[k]; got != want {
+			t.Fatalf("meta %s: got %q, want %q", k, got, want)
+		}
+	}
+	if doc.Title != "Trail Shoe" || len(doc.SectionsByRole(SectionRoleBody)) == 0 {
+		t.Fatalf("article part lost: title %q, sections %+v", doc.Title, doc.Sections)
+	}
+}
+
+const ampPageFixture = `<!DOCTYPE html>
+<html>
+<head>
+  <title>Canonical Story</title>
+  <link rel="amphtml" href="/story/amp">
+</head>
+<body>
+  <article>
+    <p>Canonical page text that is surrounded by widgets, but still long enough to count as the article body here.</p>
+    <p>A second canonical paragraph keeps the extractor confident that this block is the main content of the page.</p>
+  </article>
+</body>
+</html>`
+
+const ampVariantFixture = `<!DOCTYPE html>
+<html amp>
+<head><title>AMP Story</title></head>
+<body>
+  <article>
+    <p>AMP page text carries the same story with none of the clutter, and is long enough to be the article body.</p>
+    <img src="hero.jpg" alt="Hero">
+    <p>A second AMP paragraph follows with more detail, commas, and sentences so the extractor scores it highly.</p>
+  </article>
+</body>
+</html>`
+
+func TestExtractArticle_PreferAMP(t *testing.T) {
+	var ampHits atomic.Int32
+	var disallowAMP atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/robots.txt":
+			if disallowAMP.Load() {
+				w.Write([]byte("User-agent: *\nDisallow: /story/amp\n"))
+				return
+			}
+			http.NotFound(w, r)
+		case "/story":
+			w.Write([]byte(ampPageFixture))
+		case "/story/amp":
+			ampHits.Add(1)
+			w.Write([]byte(ampVariantFixture))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+	pageURL := srv.URL + "/story"
+
+	// Off by default: the AMP link is ignored.
+	plain, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	art, err := plain.ExtractArticle(ctx, pageURL)
+	if err != nil {
+		t.Fatalf("ExtractArticle error: %v", err)
+	}
+	if !strings.Contains(art.Content, "Canonical page text") || ampHits.Load() != 0 {
+		t.Fatalf("default extraction used AMP: content %q, AMP hits %d", art.Content, ampHits.Load())
+	}
+
+	amp, err := NewClient(WithPreferAMP(true))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	art, err = amp.ExtractArticle(ctx, pageURL)
+	if err != nil {
+		t.Fatalf("ExtractArticle error: %v", err)
+	}
+	if !strings.Contains(art.Content, "AMP page text") {
+		t.Fatalf("content not from AMP variant: %q", art.Content)
+	}
+	if got, want := art.URL, pageURL; got != want {
+		t.Fatalf("URL: got %q, want %q", got, want)
+	}
+	if got, want := art.Meta["amp_url"], srv.URL+"/story/amp"; got != want {
+		t.Fatalf("amp_url: got %q, want %q", got, want)
+	}
+	// Relative URLs resolve against the AMP document, not the page.
+	if want := srv.URL + "/story/hero.jpg"; len(art.Images) == 0 || art.Images[0] != want {
+		t.Fatalf("images: got %v, want [%s]", art.Images, want)
+	}
+
+	// robots.txt disallowing the AMP URL falls back to the page itself.
+	disallowAMP.Store(true)
+	blocked, err := NewClient(WithPreferAMP(true))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	hits := ampHits.Load()
+	art, err = blocked.ExtractArticle(ctx, pageURL)
+	if err != nil {
+		t.Fatalf("ExtractArticle error: %v", err)
+	}
+	if !strings.Contains(art.Content, "Canonical page text") {
+		t.Fatalf("content not from canonical page: %q", art.Content)
+	}
+	if ampHits.Load() != hits {
+		t.Fatal("AMP URL fetched despite robots.txt")
+	}
+	if _, ok := art.Meta["amp_url"]; ok {
+		t.Fatal("amp_url set on fallback")
+	}
+}
@@ -0,0 +1,85 @@
+// aether/feed_merge.go
+//
+// Enriching normalized feeds with extracted articles.
+//
+// A feed usually carries only a title and a short description per item.
+// Callers that go on to extract each item's full article can fold the
+// result back into the feed document with MergeArticleIntoFeedItem, so a
+// single NormalizedDocument holds both the feed structure and the full
+// text.
+
+package aether
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+// MergeArticleIntoFeedItem finds the feed-item section of doc whose
+// "link" metadata equals itemLink and enriches it with article: the
+// section text becomes the article's clean content, the item's original
+// description is kept in Meta["summary"], and the article's byline and
+// metadata are added without overwriting existing keys.
+//
+// When no feed item matches, or the article has no content, an error is
+// returned and doc is left unchanged.
+func (c *Client) MergeArticleIntoFeedItem(doc *NormalizedDocument, itemLink string, article *Article) error {
+	if c == nil {
+		return fmt.Errorf("aether: nil client in MergeArticleIntoFeedItem")
+	}
+	if doc == nil {
+		return fmt.Errorf("aether: nil document in MergeArticleIntoFeedItem")
+	}
+	if article == nil {
+		return fmt.Errorf("aether: nil article in MergeArticleIntoFeedItem")
+	}
+	link := strings.TrimSpace(itemLink)
+	if link == "" {
+		return fmt.Errorf("aether: empty item link in MergeArticleIntoFeedItem")
+	}
+	content := strings.TrimSpace(article.Content)
+	if content == "" {
+		return fmt.Errorf("aether: article for %q has no content to merge", link)
+	}
+
+	idx := -1
+	for i := range doc.Sections {
+		s := &doc.Sections[i]
+		if s.Role == model.SectionRoleFeedItem && strings.TrimSpace(s.Meta["link"]) == link {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return fmt.Errorf("aether: no feed item with link %q", link)
+	}
+
+	sec := &doc.Sections[idx]
+	meta := make(map[string]string, len(sec.Meta)+len(article.Meta)+2)
+	for k, v := range sec.Meta {
+		meta[k] = v
+	}
+	if summary := strings.TrimSpace(sec.Text); summary != "" && summary != content {
+		setIfAbsent(meta, "summary", summary)
+	}
+	setIfAbsent(meta, "byline", strings.TrimSpace(article.Byline))
+	for k, v := range article.Meta {
+		setIfAbsent(meta, k, strings.TrimSpace(v))
+	}
+
+	sec.Text = content
+	sec.Meta = meta
+	return nil
+}
+
+// setIfAbsent stores a non-empty value under key unless key is set.
+func setIfAbsent(meta map[string]string, key, value string) {
+	if value == "" {
+		return
+	}
+	if _, ok := meta[key]; !ok {
+		meta[key] = value
+	}
+}
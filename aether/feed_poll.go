@@ -0,0 +1,154 @@
+// aether/feed_poll.go
+//
+// Conditional feed polling.
+//
+// Feeds are polled often and change rarely. After a successful fetch
+// the client remembers the feed's validators (ETag, Last-Modified) and
+// the parsed Feed. The next FetchRSS or FetchRSSSince of the same URL
+// sends If-None-Match / If-Modified-Since; when the server answers 304
+// Not Modified, or a cached response still carries the same validators,
+// the body is not parsed at all.
+
+package aether
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+)
+
+// ErrFeedNotModified is returned by FetchRSSSince when the feed has not
+// changed since the client last fetched it.
+var ErrFeedNotModified = errors.New("aether: feed not modified")
+
+// maxFeedPolls bounds the number of feeds whose validators are kept.
+const maxFeedPolls = 256
+
+// feedPoll is what the client remembers about one feed URL.
+type feedPoll struct {
+	etag         string
+	lastModified string
+	feed         *Feed
+}
+
+// feedPolls is a client's feed validator table. It is safe for
+// concurrent use.
+type feedPolls struct {
+	mu      sync.Mutex
+	entries map[string]*feedPoll
+}
+
+func newFeedPolls() *feedPolls {
+	return &feedPolls{entries: make(map[string]*feedPoll)}
+}
+
+// reset drops every stored feed.
+func (p *feedPolls) reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	clear(p.entries)
+}
+
+func (p *feedPolls) get(url string) *feedPoll {
+	if p == nil {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.entries[url]
+}
+
+// put records feed under the validators in h. Responses without
+// validators are forgotten, since they cannot be revalidated.
+func (p *feedPolls) put(url string, h http.Header, feed *Feed) {
+	if p == nil {
+		return
+	}
+	poll := &feedPoll{etag: h.Get("ETag"), lastModified: h.Get("Last-Modified"), feed: feed}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if poll.etag == "" && poll.lastModified == "" {
+		delete(p.entries, url)
+		return
+	}
+	if _, ok := p.entries[url]; !ok && len(p.entries) >= maxFeedPolls {
+		for k := range p.entries {
+			delete(p.entries, k)
+			break
+		}
+	}
+	p.entries[url] = poll
+}
+
+// matches reports whether h carries the validators recorded in poll.
+func (poll *feedPoll) matches(h http.Header) bool {
+	if poll.etag != "" {
+		return h.Get("ETag") == poll.etag
+	}
+	return poll.lastModified != "" && h.Get("Last-Modified") == poll.lastModified
+}
+
+// FetchRSSSince fetches and parses the feed at url like FetchRSS, but
+// returns ErrFeedNotModified when the feed is unchanged since this
+// client last fetched it: the server answered 304 Not Modified to the
+// conditional request, or the response carries the same validators.
+// An unchanged feed is not parsed.
+func (c *Client) FetchRSSSince(ctx context.Context, url string) (*Feed, error) {
+	if c == nil {
+		return nil, fmt.Errorf("aether: nil client")
+	}
+	feed, unchanged, err := c.pollFeed(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	if unchanged {
+		return nil, ErrFeedNotModified
+	}
+	return feed, nil
+}
+
+// pollFeed performs a conditional fetch of url. When the feed is
+// unchanged it returns a copy of the previously parsed feed and
+// unchanged == true.
+func (c *Client) pollFeed(ctx context.Context, url string) (feed *Feed, unchanged bool, err error) {
+	prev := c.feedPolls.get(url)
+
+	var opts []FetchOption
+	if prev != nil {
+		if prev.etag != "" {
+			opts = append(opts, WithHeader("If-None-Match", prev.etag))
+		}
+		if prev.lastModified != "" {
+			opts = append(opts, WithHeader("If-Modified-Since", prev.lastModified))
+		}
+	}
+
+	resp, err := c.Fetch(ctx, url, opts...)
+	if err != nil {
+		return nil, false, err
+	}
+	if prev != nil && (resp.StatusCode == http.StatusNotModified || prev.matches(resp.Header)) {
+		return copyFeed(prev.feed), true, nil
+	}
+
+	feed, err = c.ParseRSS(resp.Body)
+	if err != nil {
+		return nil, false, err
+	}
+	c.feedPolls.put(url, resp.Header, feed)
+	return copyFeed(feed), false, nil
+}
+
+// copyFeed returns a copy of f whose Items slice the caller may modify.
+func copyFeed(f *Feed) *Feed {
+	if f == nil {
+		return nil
+	}
+	cp := *f
+	cp.Items = slices.Clone(f.Items)
+	return &cp
+}
@@ -22,6 +22,17 @@ type FetchResult struct {
 	Header     http.Header
 	Body       []byte
 	FetchedAt  time.Time
+
+	// FromCache is true when the body was served from Aether's cache.
+	// FetchedAt is then the time of the original network fetch.
+	FromCache bool
+
+	// Partial and ContentRange describe the answer to FetchRange: Partial
+	// is true when the server returned only the requested range (206),
+	// and ContentRange holds its Content-Range header. A FetchRange
+	// result with Partial false carries the whole resource.
+	Partial      bool
+	ContentRange string
 }
 
 // FetchOptions describes optional parameters for Fetch.
@@ -69,6 +80,32 @@ func (c *Client) Fetch(ctx context.Context, rawURL string, opts ...FetchOption)
 		return nil, err
 	}
 
+	return &FetchResult{
+		URL:        resp.URL,
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       resp.Body,
+		FetchedAt:  resp.FetchedAt,
+		FromCache:  resp.FromCache,
+	}, nil
+}
+
+// Head performs a robots.txt-compliant HTTP HEAD for the given URL and
+// returns the status code and headers with an empty Body. It is a cheap
+// way to check existence, content type or size before a full Fetch.
+//
+// HEAD responses are never cached. A non-2xx status is not an error;
+// servers that do not support HEAD usually answer 405 or 501.
+func (c *Client) Head(ctx context.Context, rawURL string) (*FetchResult, error) {
+	if c == nil || c.fetcher == nil {
+		return nil, fmt.Errorf("aether: client is not initialized")
+	}
+
+	resp, err := c.fetcher.Head(ctx, rawURL)
+	if err != nil {
+		return nil, err
+	}
+
 	return &FetchResult{
 		URL:        resp.URL,
 		StatusCode: resp.StatusCode,
@@ -0,0 +1,102 @@
+// aether/fetch_range_test.go
+package aether
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFetchRange_PartialAndFallback(t *testing.T) {
+	blob := []byte(strings.Repeat("0123456789", 100))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ranged.bin":
+			// ServeContent implements Range / Content-Range.
+			http.ServeContent(w, r, "ranged.bin", time.Time{}, bytes.NewReader(blob))
+		case "/plain.bin":
+			w.Write(blob)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	ctx := context.Background()
+
+	res, err := cli.FetchRange(ctx, srv.URL+"/ranged.bin", 10, 19)
+	if err != nil {
+		t.Fatalf("FetchRange error: %v", err)
+	}
+	if res.StatusCode != http.StatusPartialContent || !res.Partial {
+		t.Fatalf("status: got %d (partial %v), want 206", res.StatusCode, res.Partial)
+	}
+	if got := string(res.Body); got != "0123456789" {
+		t.Fatalf("body: got %q, want %q", got, "0123456789")
+	}
+	if got, want := res.ContentRange, "bytes 10-19/1000"; got != want {
+		t.Fatalf("Content-Range: got %q, want %q", got, want)
+	}
+
+	// Resume from an offset to the end.
+	tail, err := cli.FetchRange(ctx, srv.URL+"/ranged.bin", 995, -1)
+	if err != nil {
+		t.Fatalf("FetchRange error: %v", err)
+	}
+	if got := string(tail.Body); got != "56789" {
+		t.Fatalf("tail: got %q, want %q", got, "56789")
+	}
+
+	// A server without range support returns the whole body.
+	full, err := cli.FetchRange(ctx, srv.URL+"/plain.bin", 10, 19)
+	if err != nil {
+		t.Fatalf("FetchRange error: %v", err)
+	}
+	if full.Partial || full.StatusCode != http.StatusOK || len(full.Body) != len(blob) {
+		t.Fatalf("fallback: got status %d, partial %v, %d bytes", full.StatusCode, full.Partial, len(full.Body))
+	}
+
+	if _, err := cli.FetchRange(ctx, srv.URL+"/ranged.bin", 20, 10); err == nil {
+		t.Fatalf("expected error for inverted range")
+	}
+}
+
+func TestFetchToWriter_StreamsBody(t *testing.T) {
+	blob := strings.Repeat("x", 64*1024)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/big.bin" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(blob))
+	}))
+	defer srv.Close()
+
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	n, err := cli.FetchToWriter(context.Background(), srv.URL+"/big.bin", &buf)
+	if err != nil {
+		t.Fatalf("FetchToWriter error: %v", err)
+	}
+	if n != int64(len(blob)) || buf.String() != blob {
+		t.Fatalf("wrote %d bytes, want %d", n, len(blob))
+	}
+
+	buf.Reset()
+	if _, err := cli.FetchToWriter(context.Background(), srv.URL+"/missing", &buf); err == nil || buf.Len() != 0 {
+		t.Fatalf("404: got err %v, %d bytes written", err, buf.Len())
+	}
+}
@@ -43,6 +43,23 @@ func (c *Client) StreamNormalizedJSONL(ctx context.Context, w io.Writer, doc *No
 		return fmt.Errorf("aether: nil document in StreamNormalizedJSONL")
 	}
 
+	if err := writeJSONLHeader(w, doc); err != nil {
+		return err
+	}
+
+	// Sections (one per line)
+	for i := range doc.Sections {
+		if err := writeJSONLSection(w, &doc.Sections[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeJSONLHeader writes the document line and, when doc has metadata,
+// the metadata line.
+func writeJSONLHeader(w io.Writer, doc *NormalizedDocument) error {
 	// Document header line
 	if err := writeJSONL(w, JSONLObject{
 		Type: "document",
@@ -68,25 +85,22 @@ func (c *Client) StreamNormalizedJSONL(ctx context.Context, w io.Writer, doc *No
 			return err
 		}
 	}
-
-	// Sections (one per line)
-	for _, s := range doc.Sections {
-		if err := writeJSONL(w, JSONLObject{
-			Type: "section",
-			Data: map[string]interface{}{
-				"role":    s.Role,
-				"heading": s.Heading,
-				"text":    s.Text,
-				"meta":    s.Meta,
-			},
-		}); err != nil {
-			return err
-		}
-	}
-
 	return nil
 }
 
+// writeJSONLSection writes one section line.
+func writeJSONLSection(w io.Writer, s *NormalizedSection) error {
+	return writeJSONL(w, JSONLObject{
+		Type: "section",
+		Data: map[string]interface{}{
+			"role":    s.Role,
+			"heading": s.Heading,
+			"text":    s.Text,
+			"meta":    s.Meta,
+		},
+	})
+}
+
 //
 // ────────────────────────────────────────────────────────────────
 //       STREAM SEARCHRESULT DIRECTLY AS JSONL
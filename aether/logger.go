@@ -0,0 +1,30 @@
+// aether/logger.go
+//
+// Pluggable logging.
+//
+// By default Aether writes its diagnostics to stderr through a small
+// built-in logger (see WithDebugLogging). Applications with their own
+// structured logging (slog, zap, ...) can route those messages into it
+// by supplying a Logger; the client, HTTP fetcher, caches and OpenAPI
+// integrations all log through the same instance.
+
+package aether
+
+import (
+	"github.com/Nibir1/Aether/internal/config"
+	"github.com/Nibir1/Aether/internal/log"
+)
+
+// Logger receives Aether's log messages. Implementations must be safe
+// for concurrent use and decide for themselves which levels to keep.
+type Logger = log.Logger
+
+// WithLogger routes all of the client's log output to l. A nil logger
+// keeps the built-in stderr logger.
+func WithLogger(l Logger) Option {
+	return func(c *config.Config) {
+		if l != nil {
+			c.Logger = l
+		}
+	}
+}
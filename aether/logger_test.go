@@ -0,0 +1,76 @@
+// aether/logger_test.go
+package aether
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// captureLogger records every message with its level.
+type captureLogger struct {
+	mu    sync.Mutex
+	lines []string
+}
+
+func (l *captureLogger) add(level, format string, args ...any) {
+	l.mu.Lock()
+	l.lines = append(l.lines, level+": "+fmt.Sprintf(format, args...))
+	l.mu.Unlock()
+}
+
+func (l *captureLogger) Debugf(format string, args ...any) { l.add("debug", format, args...) }
+func (l *captureLogger) Infof(format string, args ...any)  { l.add("info", format, args...) }
+func (l *captureLogger) Warnf(format string, args ...any)  { l.add("warn", format, args...) }
+func (l *captureLogger) Errorf(format string, args ...any) { l.add("error", format, args...) }
+
+func TestWithLogger_ReceivesClientLogs(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("body"))
+	}))
+	defer srv.Close()
+
+	logger := &captureLogger{}
+	cli, err := NewClient(WithLogger(logger), WithSharedCache(NewMemoryCache(16, time.Minute)))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	// The second fetch is a cache hit, which is logged at debug level.
+	for i := 0; i < 2; i++ {
+		if _, err := cli.Fetch(context.Background(), srv.URL+"/page"); err != nil {
+			t.Fatalf("Fetch error: %v", err)
+		}
+	}
+
+	logger.mu.Lock()
+	defer logger.mu.Unlock()
+	found := false
+	for _, line := range logger.lines {
+		if strings.HasPrefix(line, "debug: ") && strings.Contains(line, "cache hit") {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("cache hit not logged to custom logger; got %q", logger.lines)
+	}
+}
+
+func TestWithLogger_NilKeepsDefault(t *testing.T) {
+	cli, err := NewClient(WithLogger(nil))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	if cli.logger == nil {
+		t.Fatalf("nil logger replaced the built-in logger")
+	}
+}
@@ -0,0 +1,30 @@
+// aether/markdown.go
+//
+// Markdown input.
+//
+// ParseMarkdown brings Markdown produced elsewhere (or by RenderMarkdown)
+// into the normalized document model, so it can be transformed, rendered
+// in other formats or converted to TOON like any fetched page.
+
+package aether
+
+import "github.com/Nibir1/Aether/internal/normalize"
+
+// ParseMarkdown converts Markdown text into a normalized article
+// document and applies TransformPlugins (if any).
+//
+// The first level-1 ATX heading becomes the title and the first
+// paragraph the excerpt. Every other ATX heading starts a body section
+// holding the text up to the next heading, with the heading level in
+// Meta["heading_level"]. Fenced code blocks become code sections with the
+// fence's language in Meta["lang"]. Other Markdown is kept as text.
+//
+// The parser reads the structure RenderMarkdown writes, so parsing and
+// rendering a simple document again reproduces the same Markdown.
+func (c *Client) ParseMarkdown(md string) *NormalizedDocument {
+	doc := normalize.NormalizeMarkdown(md)
+	if c == nil {
+		return doc
+	}
+	return c.applyTransformPlugins(doc)
+}
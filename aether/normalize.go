@@ -12,20 +12,149 @@
 package aether
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/Nibir1/Aether/internal/model"
 	"github.com/Nibir1/Aether/internal/normalize"
 	"github.com/Nibir1/Aether/internal/toon"
+	"github.com/Nibir1/Aether/internal/trace"
 )
 
 // Alias for public use.
+//
+// Besides its fields, a NormalizedDocument offers nil-safe section
+// helpers for post-processing before rendering: SectionsByRole,
+// FirstSection and RemoveSectionsByRole.
 type NormalizedDocument = model.Document
 
+// NormalizedSection is the public alias for a section of a
+// NormalizedDocument.
+type NormalizedSection = model.Section
+
+// SectionRole is the public alias for a normalized section's role.
+type SectionRole = model.SectionRole
+
+// Section roles produced by the normalization pipeline.
+const (
+	SectionRoleBody     = model.SectionRoleBody
+	SectionRoleSummary  = model.SectionRoleSummary
+	SectionRoleFeedItem = model.SectionRoleFeedItem
+	SectionRoleEntity   = model.SectionRoleEntity
+	SectionRoleMetadata = model.SectionRoleMetadata
+	SectionRoleCode     = model.SectionRoleCode
+	SectionRoleTable    = model.SectionRoleTable
+	SectionRoleUnknown  = model.SectionRoleUnknown
+)
+
+// NormalizeOption tunes how NormalizeSearchResult assembles a document.
+type NormalizeOption = normalize.Option
+
+// WithSectionOrder reorders normalized sections by role, e.g. feed items
+// first for a news UI. Unlisted roles follow in their original order, and
+// ordering within each role is stable.
+func WithSectionOrder(roles ...SectionRole) NormalizeOption {
+	return normalize.WithSectionOrder(roles...)
+}
+
+// WhitespaceMode selects how normalized text whitespace is handled.
+type WhitespaceMode = normalize.WhitespaceMode
+
+// Whitespace modes for WithWhitespaceMode.
+const (
+	WhitespacePreserve   = normalize.WhitespacePreserve
+	WhitespaceCollapse   = normalize.WhitespaceCollapse
+	WhitespaceParagraphs = normalize.WhitespaceParagraphs
+)
+
+// WithWhitespaceMode selects how whitespace in normalized content and
+// section text is treated. WhitespaceParagraphs keeps paragraph breaks
+// and code indentation; WhitespaceCollapse produces single-line text.
+func WithWhitespaceMode(mode WhitespaceMode) NormalizeOption {
+	return normalize.WithWhitespaceMode(mode)
+}
+
+// WithKindWhitespaceMode overrides the whitespace mode for one document
+// kind (e.g. collapse JSON but keep paragraphs for articles).
+func WithKindWhitespaceMode(kind model.DocumentKind, mode WhitespaceMode) NormalizeOption {
+	return normalize.WithKindWhitespaceMode(kind, mode)
+}
+
+// WithMaxMetadataValueLength caps normalized metadata values at n runes
+// (default 1024); a negative n disables the cap. Control characters are
+// always stripped and whitespace collapsed so values render on one line.
+func WithMaxMetadataValueLength(n int) NormalizeOption {
+	return normalize.WithMaxMetadataValueLength(n)
+}
+
+// WithTypedMetadata additionally exposes numeric and boolean metadata as
+// JSON-native values in NormalizedDocument.TypedMetadata (and in TOON's
+// typed_attributes), so "score": 42 serializes unquoted. Without keys,
+// well-known numeric keys such as "score" and any key ending in "_unix",
+// "_count" or "_seconds" are typed. The string Metadata is unchanged.
+func WithTypedMetadata(keys ...string) NormalizeOption {
+	return normalize.WithTypedMetadata(keys...)
+}
+
+// ExcerptStrategy selects how excerpts are derived during normalization.
+type ExcerptStrategy = normalize.ExcerptStrategy
+
+// Excerpt strategies for WithExcerptStrategy.
+const (
+	ExcerptPrefix         = normalize.ExcerptPrefix
+	ExcerptFirstParagraph = normalize.ExcerptFirstParagraph
+)
+
+// WithExcerptStrategy chooses how article and HTML excerpts are derived.
+// ExcerptPrefix (the default) takes the start of the content;
+// ExcerptFirstParagraph skips short lead lines such as bylines and uses
+// the first paragraph of real text. Excerpts supplied by the source are
+// never replaced.
+func WithExcerptStrategy(s ExcerptStrategy) NormalizeOption {
+	return normalize.WithExcerptStrategy(s)
+}
+
+// Fallback sources for WithTitleFallbacks and WithExcerptFallbacks that
+// are not metadata keys.
+const (
+	FallbackFirstHeading   = normalize.FallbackFirstHeading
+	FallbackURLSegment     = normalize.FallbackURLSegment
+	FallbackFirstParagraph = normalize.FallbackFirstParagraph
+)
+
+// DefaultTitleFallbacks and DefaultExcerptFallbacks are chains suited to
+// HTML pages: OpenGraph, then Twitter Card metadata, then the document
+// itself.
+var (
+	DefaultTitleFallbacks   = normalize.DefaultTitleFallbacks
+	DefaultExcerptFallbacks = normalize.DefaultExcerptFallbacks
+)
+
+// WithTitleFallbacks sets where the title of a document whose source has
+// none comes from, e.g. WithTitleFallbacks("og_title", "twitter_title",
+// FallbackFirstHeading, FallbackURLSegment). Sources are tried in order
+// and the first non-empty one wins; if all are empty the usual fallback
+// (excerpt, URL or "(untitled)") is kept.
+func WithTitleFallbacks(sources ...string) NormalizeOption {
+	return normalize.WithTitleFallbacks(sources...)
+}
+
+// WithExcerptFallbacks is WithTitleFallbacks for the excerpt, e.g.
+// WithExcerptFallbacks("og_description", FallbackFirstParagraph). It
+// replaces the excerpt cut from the start of the content.
+func WithExcerptFallbacks(sources ...string) NormalizeOption {
+	return normalize.WithExcerptFallbacks(sources...)
+}
+
 // NormalizeSearchResult converts a public SearchResult into a canonical
 // normalized Document and applies TransformPlugins (if any).
-func (c *Client) NormalizeSearchResult(sr *SearchResult) *NormalizedDocument {
+//
+// A nil result, or one with no primary document, article, feed or
+// entities, yields a document whose IsEmpty reports true and whose
+// "diagnostic" metadata says why.
+func (c *Client) NormalizeSearchResult(sr *SearchResult, opts ...NormalizeOption) *NormalizedDocument {
 	if c == nil {
 		return &model.Document{
 			Kind:     model.DocumentKindUnknown,
@@ -34,7 +163,9 @@ func (c *Client) NormalizeSearchResult(sr *SearchResult) *NormalizedDocument {
 	}
 
 	// (1) Core normalization pipeline
-	doc := normalize.Pipeline(convertSearchResult(sr))
+	_, end := trace.Start(c.tracer(), context.Background(), trace.SpanNormalize)
+	doc := normalize.Pipeline(convertSearchResult(sr), opts...)
+	end()
 	if doc == nil {
 		return &model.Document{
 			Kind:     model.DocumentKindUnknown,
@@ -48,6 +179,32 @@ func (c *Client) NormalizeSearchResult(sr *SearchResult) *NormalizedDocument {
 	return finalDoc
 }
 
+// NormalizeFeed converts a parsed Feed directly into a normalized Document
+// (one feed_item section per item) and applies TransformPlugins (if any).
+//
+// This avoids building a synthetic SearchResult just to normalize a feed.
+func (c *Client) NormalizeFeed(feed *Feed) *NormalizedDocument {
+	doc := normalize.NormalizeFeed(convertFeed(feed))
+	if c == nil {
+		return doc
+	}
+	return c.applyTransformPlugins(doc)
+}
+
+// NormalizeFeedItems converts each item of a Feed into its own normalized
+// Document. Item link, author, guid and published/updated timestamps are
+// preserved in Metadata. TransformPlugins are applied to every item.
+func (c *Client) NormalizeFeedItems(feed *Feed) []*NormalizedDocument {
+	docs := normalize.NormalizeFeedItems(convertFeed(feed))
+	if c == nil {
+		return docs
+	}
+	for i, d := range docs {
+		docs[i] = c.applyTransformPlugins(d)
+	}
+	return docs
+}
+
 // MarshalSearchResultJSON returns pretty-printed JSON for a SearchResult.
 func (c *Client) MarshalSearchResultJSON(sr *SearchResult) ([]byte, error) {
 	doc := c.NormalizeSearchResult(sr)
@@ -61,6 +218,30 @@ func (c *Client) MarshalSearchResultTOON(sr *SearchResult) ([]byte, error) {
 	return json.MarshalIndent(tdoc, "", "  ")
 }
 
+// MarshalCanonicalJSON returns a deterministic JSON encoding of doc for
+// snapshot tests and change detection: the same document always yields
+// the same bytes.
+//
+// Fields appear in declaration order and map keys (metadata, typed
+// metadata, section meta) are sorted. Sections and links keep their
+// document order, which is part of the content. The output is indented
+// with two spaces, ends in a newline, and leaves <, > and & unescaped so
+// diffs stay readable.
+func (c *Client) MarshalCanonicalJSON(doc *NormalizedDocument) ([]byte, error) {
+	if doc == nil {
+		return nil, fmt.Errorf("aether: nil document")
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, fmt.Errorf("aether: canonical JSON: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
 //
 // ─────────────────────────────────────────────
 //         ADAPTER: public → internal types
@@ -83,10 +264,37 @@ func convertSearchResult(in *SearchResult) *normalize.SearchResult {
 			Intent: string(in.Plan.Intent),
 		},
 
-		// No entities here because aether.SearchResult has none (yet).
+		// JSON-LD items found during article extraction.
+		Entities: convertStructuredData(in.Article),
 	}
 }
 
+// convertStructuredData turns an article's JSON-LD items into entities.
+// The schema.org type is kept under "schema_type" and every flattened
+// property becomes entity metadata.
+func convertStructuredData(in *Article) []*normalize.Entity {
+	if in == nil || len(in.StructuredData) == 0 {
+		return nil
+	}
+	out := make([]*normalize.Entity, 0, len(in.StructuredData))
+	for _, item := range in.StructuredData {
+		meta := make(map[string]string, len(item.Properties)+2)
+		for k, v := range item.Properties {
+			meta[k] = v
+		}
+		meta["schema_type"] = item.Type
+		meta["source"] = "json-ld"
+		out = append(out, &normalize.Entity{
+			ID:       item.ID,
+			Label:    item.Name,
+			Summary:  item.Description,
+			URL:      item.URL,
+			Metadata: meta,
+		})
+	}
+	return out
+}
+
 func convertPrimaryDocument(in *SearchDocument) *normalize.SearchDocument {
 	if in == nil {
 		return nil
@@ -110,6 +318,7 @@ func convertArticle(in *Article) *normalize.Article {
 		Title:   in.Title,
 		Content: in.Content,
 		Meta:    in.Meta,
+		HTML:    in.HTML,
 	}
 }
 
@@ -118,22 +327,35 @@ func convertFeed(in *Feed) *normalize.Feed {
 		return nil
 	}
 
-	out := &normalize.Feed{}
+	out := &normalize.Feed{
+		Title:       in.Title,
+		Description: in.Description,
+		Link:        in.Link,
+	}
 	for _, item := range in.Items {
-		out.Items = append(out.Items, normalize.FeedItem{
-			Title:       item.Title,
-			Link:        item.Link,
-			Author:      item.Author,
-			GUID:        item.GUID,
-			Description: item.Description,
-			Content:     item.Content,
-			Published:   item.Published,
-			Updated:     item.Updated,
-		})
+		out.Items = append(out.Items, convertFeedItem(item))
 	}
 	return out
 }
 
+func convertFeedItem(item FeedItem) normalize.FeedItem {
+	var authors []normalize.FeedAuthor
+	for _, a := range item.Authors {
+		authors = append(authors, normalize.FeedAuthor{Name: a.Name, Email: a.Email, URI: a.URI})
+	}
+	return normalize.FeedItem{
+		Title:       item.Title,
+		Link:        item.Link,
+		Author:      item.Author,
+		Authors:     authors,
+		GUID:        item.GUID,
+		Description: item.Description,
+		Content:     item.Content,
+		Published:   item.Published,
+		Updated:     item.Updated,
+	}
+}
+
 //
 // ─────────────────────────────────────────────
 //         TRANSFORM PLUGIN EXECUTION PIPELINE
@@ -173,6 +395,10 @@ func (c *Client) applyTransformPlugins(doc *model.Document) *model.Document {
 			// Skip failing plugin but keep the rest of the pipeline.
 			continue
 		}
+		if err := c.validatePluginDocument(name, out); err != nil {
+			c.logger.Warnf("aether: discarding transform output: %v", err)
+			continue
+		}
 
 		// Convert plugins.Document → model.Document
 		next := pluginToModelDocument(out)
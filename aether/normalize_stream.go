@@ -0,0 +1,154 @@
+// aether/normalize_stream.go
+//
+// Streaming normalization.
+//
+// NormalizeSearchResult returns the whole normalized document, which
+// for a result carrying a feed of tens of thousands of items means one
+// in-memory section per item. StreamNormalize produces the same output
+// as normalizing first and then calling StreamNormalizedJSONL or
+// StreamTOON, but builds each feed item's section only when it is about
+// to be written, so memory does not grow with the size of the feed.
+
+package aether
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Nibir1/Aether/internal/normalize"
+	"github.com/Nibir1/Aether/internal/toon"
+	"github.com/Nibir1/Aether/internal/trace"
+)
+
+// StreamNormalize normalizes sr and writes it to w in the given format:
+//
+//   - "jsonl": the lines written by StreamNormalizedJSONL
+//   - "toon":  the events written by StreamTOON
+//
+// Feed items are converted and written one at a time. Transform plugins
+// need the whole document, so when any are registered the result is
+// normalized in full first and then streamed.
+func (c *Client) StreamNormalize(ctx context.Context, w io.Writer, sr *SearchResult, format string, opts ...NormalizeOption) error {
+	if c == nil {
+		return fmt.Errorf("aether: nil client in StreamNormalize")
+	}
+	if w == nil {
+		return fmt.Errorf("aether: nil writer in StreamNormalize")
+	}
+	if sr == nil {
+		return fmt.Errorf("aether: nil SearchResult in StreamNormalize")
+	}
+
+	var out normalizedStreamWriter
+	switch f := strings.ToLower(strings.TrimSpace(format)); f {
+	case "jsonl":
+		out = &jsonlStreamWriter{w: w}
+	case "toon":
+		out = &toonStreamWriter{tw: newTOONEventWriter(ctx, w)}
+	default:
+		return fmt.Errorf("aether: unsupported stream format %q (want jsonl or toon)", f)
+	}
+
+	_, end := trace.Start(c.tracer(), ctx, trace.SpanNormalize)
+	defer end()
+
+	if c.plugins != nil && len(c.plugins.ListTransforms()) > 0 {
+		doc := c.NormalizeSearchResult(sr, opts...)
+		return streamSections(ctx, out, doc, -1, nil, nil)
+	}
+
+	// Normalize everything but the feed items; see normalize.PipelineStream.
+	var items []FeedItem
+	if sr.Feed != nil {
+		items = sr.Feed.Items
+	}
+
+	s := normalize.PipelineStream(convertSearchResult(sr), opts...)
+	return streamSections(ctx, out, s.Doc, s.FeedAt, items, s)
+}
+
+// streamSections writes doc's header and sections, inserting the
+// sections for items at index feedAt (when feedAt >= 0).
+func streamSections(ctx context.Context, out normalizedStreamWriter, doc *NormalizedDocument, feedAt int, items []FeedItem, s *normalize.Stream) error {
+	if feedAt < 0 {
+		items = nil
+	}
+	if err := out.header(doc, len(doc.Sections)+len(items)); err != nil {
+		return err
+	}
+
+	for i := 0; i <= len(doc.Sections); i++ {
+		if i == feedAt {
+			for _, item := range items {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+				sec := s.FeedSection(convertFeedItem(item))
+				if err := out.section(&sec); err != nil {
+					return err
+				}
+			}
+		}
+		if i == len(doc.Sections) {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := out.section(&doc.Sections[i]); err != nil {
+			return err
+		}
+	}
+	return out.end()
+}
+
+// normalizedStreamWriter writes one output format of StreamNormalize.
+type normalizedStreamWriter interface {
+	// header writes the document-level output; sections is the total
+	// number of sections that will follow.
+	header(doc *NormalizedDocument, sections int) error
+	section(sec *NormalizedSection) error
+	end() error
+}
+
+type jsonlStreamWriter struct {
+	w io.Writer
+}
+
+func (j *jsonlStreamWriter) header(doc *NormalizedDocument, _ int) error {
+	return writeJSONLHeader(j.w, doc)
+}
+
+func (j *jsonlStreamWriter) section(sec *NormalizedSection) error {
+	return writeJSONLSection(j.w, sec)
+}
+
+func (j *jsonlStreamWriter) end() error { return nil }
+
+type toonStreamWriter struct {
+	tw *toonEventWriter
+}
+
+// header converts doc without its sections. FromModel only emits a
+// content token for documents without sections, so the content is
+// dropped here whenever sections follow.
+func (t *toonStreamWriter) header(doc *NormalizedDocument, sections int) error {
+	head := *doc
+	head.Sections = nil
+	if sections > 0 {
+		head.Content = ""
+	}
+	tdoc := toon.FromModel(&head)
+	if err := t.tw.start(tdoc); err != nil {
+		return err
+	}
+	return t.tw.tokens(tdoc.Tokens)
+}
+
+func (t *toonStreamWriter) section(sec *NormalizedSection) error {
+	return t.tw.tokens(toon.FromSection(sec).Tokens)
+}
+
+func (t *toonStreamWriter) end() error { return t.tw.end() }
@@ -0,0 +1,182 @@
+// aether/normalize_stream_test.go
+package aether
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// largeFeedResult builds a search result with an article and a feed of
+// n items.
+func largeFeedResult(n int) *SearchResult {
+	feed := &Feed{Title: "Wire", Link: "https://news.example.com/"}
+	for i := 0; i < n; i++ {
+		feed.Items = append(feed.Items, FeedItem{
+			Title:       fmt.Sprintf("Story %d", i),
+			Link:        fmt.Sprintf("https://news.example.com/%d", i),
+			Description: fmt.Sprintf("Summary  of story %d,\n\n with  extra   spaces.", i),
+			Authors:     []FeedAuthor{{Name: "Ann"}, {Name: "Bo", Email: "bo@example.com"}},
+			Published:   int64(1700000000 + i),
+		})
+	}
+	return &SearchResult{
+		Query: "news",
+		PrimaryDocument: &SearchDocument{
+			URL:   "https://news.example.com/",
+			Kind:  SearchDocumentKindFeed,
+			Title: "Wire",
+		},
+		Article: &Article{Title: "Lead", Content: "The lead story of the day."},
+		Feed:    feed,
+	}
+}
+
+func TestStreamNormalize_JSONLMatchesFullNormalization(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	sr := largeFeedResult(50)
+
+	for name, opts := range map[string][]NormalizeOption{
+		"default":  nil,
+		"ordered":  {WithSectionOrder(SectionRoleFeedItem)},
+		"collapse": {WithWhitespaceMode(WhitespaceCollapse)},
+	} {
+		var want, got bytes.Buffer
+		if err := cli.StreamNormalizedJSONL(context.Background(), &want, cli.NormalizeSearchResult(sr, opts...)); err != nil {
+			t.Fatalf("%s: StreamNormalizedJSONL error: %v", name, err)
+		}
+		if err := cli.StreamNormalize(context.Background(), &got, sr, "jsonl", opts...); err != nil {
+			t.Fatalf("%s: StreamNormalize error: %v", name, err)
+		}
+		if got.String() != want.String() {
+			t.Fatalf("%s: streamed JSONL differs:\ngot  %s\nwant %s", name, got.String(), want.String())
+		}
+	}
+}
+
+func TestStreamNormalize_TOONEvents(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	sr := largeFeedResult(20)
+
+	var got, want bytes.Buffer
+	if err := cli.StreamNormalize(context.Background(), &got, sr, "toon"); err != nil {
+		t.Fatalf("StreamNormalize error: %v", err)
+	}
+	if err := cli.StreamTOON(context.Background(), &want, cli.NormalizeSearchResult(sr)); err != nil {
+		t.Fatalf("StreamTOON error: %v", err)
+	}
+
+	// Meta tokens follow map order, so compare event sequences with the
+	// attributes of meta tokens left out.
+	summarize := func(s string) []string {
+		var out []string
+		for _, line := range strings.Split(strings.TrimSpace(s), "\n") {
+			var ev toonStreamEvent
+			if err := json.Unmarshal([]byte(line), &ev); err != nil {
+				t.Fatalf("bad event %q: %v", line, err)
+			}
+			if ev.Token != nil {
+				ev.Token.Attrs = nil
+			}
+			b, _ := json.Marshal(ev)
+			out = append(out, string(b))
+		}
+		return out
+	}
+	g, w := summarize(got.String()), summarize(want.String())
+	if strings.Join(g, "\n") != strings.Join(w, "\n") {
+		t.Fatalf("streamed TOON differs:\ngot  %s\nwant %s", strings.Join(g, "\n"), strings.Join(w, "\n"))
+	}
+
+	if err := cli.StreamNormalize(context.Background(), &got, sr, "pdf"); err == nil {
+		t.Fatal("StreamNormalize accepted an unknown format")
+	}
+}
+
+// heapSampler records the live heap after a GC every n writes.
+type heapSampler struct {
+	every, writes int
+	peak          uint64
+}
+
+func (h *heapSampler) Write(p []byte) (int, error) {
+	h.writes++
+	if h.writes%h.every == 0 {
+		runtime.GC()
+		var ms runtime.MemStats
+		runtime.ReadMemStats(&ms)
+		h.peak = max(h.peak, ms.HeapAlloc)
+	}
+	return len(p), nil
+}
+
+func TestStreamNormalize_LargeFeedHeapStaysBounded(t *testing.T) {
+	if testing.Short() {
+		t.Skip("large feed")
+	}
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	sr := largeFeedResult(10000)
+
+	runtime.GC()
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+	base := ms.HeapAlloc
+
+	h := &heapSampler{every: 1000}
+	if err := cli.StreamNormalize(context.Background(), h, sr, "jsonl"); err != nil {
+		t.Fatalf("StreamNormalize error: %v", err)
+	}
+	if h.writes != 10000+1+2 { // sections, document and metadata lines
+		t.Fatalf("lines written: got %d, want %d", h.writes, 10003)
+	}
+
+	// Normalizing the whole feed first holds over 5 MB here; streaming
+	// keeps only the skeleton and the current item.
+	if grown := int64(h.peak) - int64(base); grown > 1<<20 {
+		t.Fatalf("live heap grew by %d bytes while streaming 10,000 items", grown)
+	}
+	runtime.KeepAlive(sr)
+}
+
+func BenchmarkStreamNormalize_Feed10k(b *testing.B) {
+	cli, err := NewClient()
+	if err != nil {
+		b.Fatal(err)
+	}
+	sr := largeFeedResult(10000)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := cli.StreamNormalize(context.Background(), io.Discard, sr, "jsonl"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkNormalizeThenStream_Feed10k(b *testing.B) {
+	cli, err := NewClient()
+	if err != nil {
+		b.Fatal(err)
+	}
+	sr := largeFeedResult(10000)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		doc := cli.NormalizeSearchResult(sr)
+		if err := cli.StreamNormalizedJSONL(context.Background(), io.Discard, doc); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,405 @@
+// aether/normalize_test.go
+package aether
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+func TestNormalizeFeedItems_PreservesItemMetadata(t *testing.T) {
+	feed := &Feed{
+		Title: "Example Feed",
+		Link:  "https://example.com/",
+		Items: []FeedItem{
+			{
+				Title:     "First Post",
+				Link:      "https://example.com/first",
+				Author:    "alice",
+				Content:   "First body.",
+				Published: 1700000000,
+			},
+			{
+				Title:       "Second Post",
+				Link:        "https://example.com/second",
+				Author:      "bob",
+				Description: "Second summary.",
+				Published:   1700000100,
+			},
+		},
+	}
+
+	var c *Client
+	docs := c.NormalizeFeedItems(feed)
+	if len(docs) != 2 {
+		t.Fatalf("got %d documents, want 2", len(docs))
+	}
+
+	want := []struct{ link, author, published string }{
+		{"https://example.com/first", "alice", "1700000000"},
+		{"https://example.com/second", "bob", "1700000100"},
+	}
+	for i, d := range docs {
+		if d.SourceURL != want[i].link {
+			t.Fatalf("doc %d SourceURL: got %q, want %q", i, d.SourceURL, want[i].link)
+		}
+		if got := d.Metadata["link"]; got != want[i].link {
+			t.Fatalf("doc %d link: got %q, want %q", i, got, want[i].link)
+		}
+		if got := d.Metadata["author"]; got != want[i].author {
+			t.Fatalf("doc %d author: got %q, want %q", i, got, want[i].author)
+		}
+		if got := d.Metadata["published_unix"]; got != want[i].published {
+			t.Fatalf("doc %d published_unix: got %q, want %q", i, got, want[i].published)
+		}
+		if got := d.Metadata["feed_title"]; got != "Example Feed" {
+			t.Fatalf("doc %d feed_title: got %q, want %q", i, got, "Example Feed")
+		}
+	}
+
+	if docs[1].Content != "Second summary." {
+		t.Fatalf("Content fallback: got %q, want %q", docs[1].Content, "Second summary.")
+	}
+}
+
+func TestNormalizeFeed_SectionsPerItem(t *testing.T) {
+	feed := &Feed{
+		Title: "Example Feed",
+		Items: []FeedItem{{Title: "A"}, {Title: "B"}},
+	}
+
+	var c *Client
+	doc := c.NormalizeFeed(feed)
+	if doc.Title != "Example Feed" {
+		t.Fatalf("Title: got %q, want %q", doc.Title, "Example Feed")
+	}
+	if len(doc.Sections) != 2 {
+		t.Fatalf("got %d sections, want 2", len(doc.Sections))
+	}
+	for _, s := range doc.Sections {
+		if s.Role != model.SectionRoleFeedItem {
+			t.Fatalf("section role: got %q, want %q", s.Role, model.SectionRoleFeedItem)
+		}
+	}
+}
+
+func TestNormalizeFeedItems_AllAuthorsInMetadata(t *testing.T) {
+	feed := &Feed{Items: []FeedItem{{
+		Title:  "Joint post",
+		Author: "Ada Lovelace",
+		Authors: []FeedAuthor{
+			{Name: "Ada Lovelace", Email: "ada@example.com"},
+			{Name: "Charles Babbage"},
+		},
+	}}}
+
+	var c *Client
+	doc := c.NormalizeFeedItems(feed)[0]
+
+	if got, want := doc.Metadata["authors"], "Ada Lovelace, Charles Babbage"; got != want {
+		t.Fatalf("authors: got %q, want %q", got, want)
+	}
+	if got, want := doc.Metadata["author_emails"], "ada@example.com"; got != want {
+		t.Fatalf("author_emails: got %q, want %q", got, want)
+	}
+}
+
+func TestNormalizeSearchResult_EmptyResult(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	doc := cli.NormalizeSearchResult(&SearchResult{})
+	if !doc.IsEmpty() {
+		t.Fatalf("IsEmpty: got false for a fully empty SearchResult")
+	}
+	if got := doc.Metadata["empty"]; got != "true" {
+		t.Fatalf("metadata[empty]: got %q, want %q", got, "true")
+	}
+	if doc.Metadata["diagnostic"] == "" {
+		t.Fatalf("missing diagnostic metadata")
+	}
+
+	// Found but blank is not "nothing found".
+	blank := cli.NormalizeSearchResult(&SearchResult{
+		PrimaryDocument: &SearchDocument{Kind: SearchDocumentKindText},
+	})
+	if blank.IsEmpty() {
+		t.Fatalf("IsEmpty: got true for a blank but present document")
+	}
+
+	var nilDoc *NormalizedDocument
+	if !nilDoc.IsEmpty() {
+		t.Fatalf("IsEmpty: got false for nil document")
+	}
+}
+
+const pythonSnippet = "def greet(name):\n    if name:\n        return f\"hi {name}\"\n    return   \"hi\""
+
+func TestNormalizeSearchResult_VerbatimCode(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	content := "Here is   the helper:\n\n```python\n" + pythonSnippet + "\n```\n\n" +
+		"And the identity:\n\n$$\ne^{i  \\pi} + 1 = 0\n$$\n\nThat is all."
+	sr := &SearchResult{Article: &Article{Title: "Code", Content: content}}
+
+	doc := cli.NormalizeSearchResult(sr, WithWhitespaceMode(WhitespaceCollapse))
+
+	var code, math *NormalizedSection
+	for i := range doc.Sections {
+		s := &doc.Sections[i]
+		switch s.Meta[model.MetaVerbatim] {
+		case model.VerbatimCode:
+			code = s
+		case model.VerbatimMath:
+			math = s
+		}
+	}
+	if code == nil || code.Role != SectionRoleCode {
+		t.Fatalf("no verbatim code section in %+v", doc.Sections)
+	}
+	if code.Text != pythonSnippet {
+		t.Fatalf("code text:\ngot  %q\nwant %q", code.Text, pythonSnippet)
+	}
+	if code.Meta["lang"] != "python" {
+		t.Fatalf("lang: got %q, want %q", code.Meta["lang"], "python")
+	}
+	if math == nil || math.Text != "$$\ne^{i  \\pi} + 1 = 0\n$$" {
+		t.Fatalf("math section: %+v", math)
+	}
+
+	md, err := cli.Render(context.Background(), "markdown", doc, withoutColor())
+	if err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	if !strings.Contains(string(md), "```python\n"+pythonSnippet+"\n```") {
+		t.Fatalf("markdown lost code verbatim:\n%s", md)
+	}
+	if !strings.Contains(string(md), "$$\ne^{i  \\pi} + 1 = 0\n$$") {
+		t.Fatalf("markdown lost math verbatim:\n%s", md)
+	}
+
+	tdoc := cli.ToTOONFromModel(doc)
+	found := false
+	for _, tok := range tdoc.Tokens {
+		if tok.Text == pythonSnippet {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("TOON tokens lost code verbatim: %+v", tdoc.Tokens)
+	}
+}
+
+func TestNormalizeSearchResult_UnterminatedVerbatimStaysProse(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	for name, opener := range map[string]string{"math": "$$ costs", "fence": "```"} {
+		content := "Prices start at\n" + opener + " five dollars.\n\nA later paragraph.\n\nAnd the last one."
+		sr := &SearchResult{Article: &Article{Title: "Prose", Content: content}}
+
+		doc := cli.NormalizeSearchResult(sr)
+
+		var text []string
+		for _, s := range doc.Sections {
+			if s.Role == SectionRoleCode || s.Meta[model.MetaVerbatim] != "" {
+				t.Fatalf("%s: unterminated opener produced a verbatim section: %+v", name, s)
+			}
+			text = append(text, s.Text)
+		}
+		joined := strings.Join(text, "\n")
+		for _, want := range []string{"A later paragraph.", "And the last one."} {
+			if !strings.Contains(joined, want) {
+				t.Fatalf("%s: prose %q missing from %q", name, want, joined)
+			}
+		}
+	}
+}
+
+func TestNormalizeSearchResult_HangingIndentNeedsCodeOpener(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	cases := map[string]bool{
+		"- groceries\n    - milk\n    - bread":      false,
+		"Jane Doe\n    12 Harbour Road\n    Bergen": false,
+		"def total(items):\n    return sum(items)":  true,
+		"func main() {\n\tfmt.Println(\"hi\")\n}":   true,
+	}
+	for content, wantCode := range cases {
+		sr := &SearchResult{Article: &Article{Title: "Indent", Content: "Intro paragraph.\n\n" + content}}
+		doc := cli.NormalizeSearchResult(sr)
+
+		gotCode := false
+		for _, s := range doc.Sections {
+			if s.Meta[model.MetaVerbatim] == model.VerbatimCode {
+				gotCode = true
+			}
+		}
+		if gotCode != wantCode {
+			t.Fatalf("%q: verbatim code %v, want %v (sections %+v)", content, gotCode, wantCode, doc.Sections)
+		}
+	}
+}
+
+func TestNormalizeSearchResult_TypedMetadata(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	sr := &SearchResult{PrimaryDocument: &SearchDocument{
+		URL:   "https://example.com/item",
+		Title: "Item",
+		Metadata: map[string]string{
+			"score":          "42",
+			"published_unix": "1700000000",
+			"zip":            "02139",
+		},
+	}}
+
+	doc := cli.NormalizeSearchResult(sr, WithTypedMetadata())
+	out, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+	for _, want := range []string{`"score":42`, `"published_unix":1700000000`, `"score":"42"`} {
+		if !strings.Contains(string(out), want) {
+			t.Fatalf("JSON missing %s: %s", want, out)
+		}
+	}
+	if _, ok := doc.TypedMetadata["zip"]; ok {
+		t.Fatalf("zip should not be typed: %v", doc.TypedMetadata)
+	}
+
+	tout, err := json.Marshal(cli.ToTOONFromModel(doc))
+	if err != nil {
+		t.Fatalf("Marshal TOON error: %v", err)
+	}
+	if !strings.Contains(string(tout), `"typed_attributes":{`) || !strings.Contains(string(tout), `"score":42`) {
+		t.Fatalf("TOON missing typed score: %s", tout)
+	}
+
+	if plain := cli.NormalizeSearchResult(sr); plain.TypedMetadata != nil {
+		t.Fatalf("typed metadata without option: %v", plain.TypedMetadata)
+	}
+}
+
+func TestMergeArticleIntoFeedItem(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	doc := cli.NormalizeFeed(&Feed{
+		Title: "News",
+		Items: []FeedItem{
+			{Title: "One", Link: "https://example.com/one", Description: "Teaser one."},
+			{Title: "Two", Link: "https://example.com/two", Description: "Teaser two."},
+		},
+	})
+
+	art := &Article{
+		Content: "The full text of article two.",
+		Byline:  "Ada",
+		Meta:    map[string]string{"lang": "en", "link": "https://ignored.example"},
+	}
+
+	if err := cli.MergeArticleIntoFeedItem(doc, "https://example.com/missing", art); err == nil ||
+		!strings.Contains(err.Error(), "no feed item") {
+		t.Fatalf("missing link: got %v, want a no-feed-item error", err)
+	}
+	if doc.Sections[0].Text != "Teaser one." || doc.Sections[1].Text != "Teaser two." {
+		t.Fatalf("document changed by a failed merge: %+v", doc.Sections)
+	}
+
+	if err := cli.MergeArticleIntoFeedItem(doc, "https://example.com/two", art); err != nil {
+		t.Fatalf("MergeArticleIntoFeedItem error: %v", err)
+	}
+	two := doc.Sections[1]
+	if two.Text != art.Content {
+		t.Fatalf("Text: got %q, want %q", two.Text, art.Content)
+	}
+	for k, want := range map[string]string{
+		"summary": "Teaser two.",
+		"byline":  "Ada",
+		"lang":    "en",
+		"link":    "https://example.com/two",
+	} {
+		if two.Meta[k] != want {
+			t.Fatalf("Meta[%q]: got %q, want %q", k, two.Meta[k], want)
+		}
+	}
+	if doc.Sections[0].Text != "Teaser one." {
+		t.Fatalf("other item changed: %q", doc.Sections[0].Text)
+	}
+}
+
+func TestMarshalCanonicalJSON_ByteStable(t *testing.T) {
+	build := func(reverse bool) *NormalizedDocument {
+		keys := []string{"zeta", "alpha", "mid", "beta", "omega", "gamma"}
+		if reverse {
+			for i, j := 0, len(keys)-1; i < j; i, j = i+1, j-1 {
+				keys[i], keys[j] = keys[j], keys[i]
+			}
+		}
+		doc := &NormalizedDocument{
+			Kind:          model.DocumentKindArticle,
+			Title:         "Q&A <draft>",
+			Metadata:      map[string]string{},
+			TypedMetadata: map[string]any{},
+			Sections: []NormalizedSection{
+				{Role: SectionRoleBody, Heading: "One", Text: "first", Meta: map[string]string{}},
+				{Role: SectionRoleBody, Heading: "Two", Text: "second"},
+			},
+		}
+		for _, k := range keys {
+			doc.Metadata[k] = "v-" + k
+			doc.TypedMetadata[k] = int64(len(k))
+			doc.Sections[0].Meta[k] = strings.Repeat("x", len(k))
+		}
+		return doc
+	}
+
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	want, err := cli.MarshalCanonicalJSON(build(false))
+	if err != nil {
+		t.Fatalf("MarshalCanonicalJSON error: %v", err)
+	}
+	for i := 0; i < 20; i++ {
+		got, err := cli.MarshalCanonicalJSON(build(i%2 == 1))
+		if err != nil {
+			t.Fatalf("MarshalCanonicalJSON error: %v", err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Fatalf("run %d differs:\ngot  %s\nwant %s", i, got, want)
+		}
+	}
+
+	out := string(want)
+	if !strings.Contains(out, `"title": "Q&A <draft>"`) {
+		t.Fatalf("title is escaped or missing:\n%s", out)
+	}
+	if strings.Index(out, `"alpha"`) > strings.Index(out, `"zeta"`) {
+		t.Fatalf("metadata keys are not sorted:\n%s", out)
+	}
+	if strings.Index(out, `"One"`) > strings.Index(out, `"Two"`) {
+		t.Fatalf("sections are not in document order:\n%s", out)
+	}
+	if !strings.HasSuffix(out, "}\n") {
+		t.Fatalf("output does not end in a newline")
+	}
+}
@@ -9,10 +9,64 @@ package aether
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/Nibir1/Aether/internal/config"
 	"github.com/Nibir1/Aether/internal/model"
+	iopenapi "github.com/Nibir1/Aether/internal/openapi"
 )
 
+//
+// ────────────────────────────────────────────────
+//            PER-ENDPOINT TIMEOUTS
+// ────────────────────────────────────────────────
+//
+
+// OpenAPIEndpoint names one OpenAPI integration.
+type OpenAPIEndpoint = iopenapi.Endpoint
+
+const (
+	OpenAPIWikipedia  OpenAPIEndpoint = iopenapi.EndpointWikipedia
+	OpenAPIWikidata   OpenAPIEndpoint = iopenapi.EndpointWikidata
+	OpenAPIHackerNews OpenAPIEndpoint = iopenapi.EndpointHackerNews
+	OpenAPIGitHub     OpenAPIEndpoint = iopenapi.EndpointGitHub
+	OpenAPIWhiteHouse OpenAPIEndpoint = iopenapi.EndpointWhiteHouse
+	OpenAPIPress      OpenAPIEndpoint = iopenapi.EndpointPress
+	OpenAPIWeather    OpenAPIEndpoint = iopenapi.EndpointWeather
+)
+
+// WithOpenAPITimeout overrides the per-request timeout of one OpenAPI
+// endpoint. Each request runs under a child of the caller's context, so
+// a slow endpoint fails with an ErrorKindTimeout error while the rest of
+// the caller's deadline stays available. Defaults are 10s (15s for
+// Wikidata); d == 0 disables the endpoint timeout.
+func WithOpenAPITimeout(endpoint OpenAPIEndpoint, d time.Duration) Option {
+	return func(c *config.Config) {
+		if d < 0 {
+			return
+		}
+		if c.OpenAPITimeouts == nil {
+			c.OpenAPITimeouts = map[string]time.Duration{}
+		}
+		c.OpenAPITimeouts[string(endpoint)] = d
+	}
+}
+
+// WithOpenAPIMirrors sets the fallback origins of one OpenAPI endpoint.
+// When the primary host fails with a network error, a timeout or a 5xx
+// response, the same path is requested from each mirror in order before
+// the error is returned. A mirror is an origin such as
+// "https://en.m.wikipedia.org" or a bare host name. Calling it with no
+// mirrors disables fallback, including the built-in Wikipedia mirror.
+func WithOpenAPIMirrors(endpoint OpenAPIEndpoint, mirrors ...string) Option {
+	return func(c *config.Config) {
+		if c.OpenAPIMirrors == nil {
+			c.OpenAPIMirrors = map[string][]string{}
+		}
+		c.OpenAPIMirrors[string(endpoint)] = append([]string(nil), mirrors...)
+	}
+}
+
 //
 // ────────────────────────────────────────────────
 //            PUBLIC DATA MODELS
@@ -25,6 +79,19 @@ type WikiSummary struct {
 	Extract     string
 	URL         string
 	Language    string
+
+	// FromCache reports whether the summary came from Aether's cache;
+	// CacheAge is the age of that cache entry.
+	FromCache bool
+	CacheAge  time.Duration
+
+	// FetchedAt is when the summary was fetched from the network; for
+	// cached summaries this is the original fetch time.
+	FetchedAt time.Time
+
+	// CacheTTL is how long the summary was cached for, following the
+	// response's Cache-Control / Expires headers; 0 if it was not.
+	CacheTTL time.Duration
 }
 
 type HackerNewsStory struct {
@@ -98,6 +165,10 @@ func (c *Client) WikipediaSummary(ctx context.Context, title string) (*WikiSumma
 		Extract:     internal.Extract,
 		URL:         internal.URL,
 		Language:    internal.Language,
+		FromCache:   internal.FromCache,
+		CacheAge:    internal.CacheAge,
+		FetchedAt:   internal.FetchedAt,
+		CacheTTL:    internal.CacheTTL,
 	}, nil
 }
 
@@ -134,6 +205,10 @@ func (c *Client) HackerNewsTopStories(ctx context.Context, limit int) ([]HackerN
 
 // HackerNewsTopStoriesDocuments fetches top N stories and converts
 // them into model.Document objects ready for JSON / TOON / Lite TOON / BTON pipelines.
+//
+// Each document's Metadata carries "fetched_at" (RFC 3339, the original
+// fetch time even when served from cache) and "ttl_seconds" (the
+// effective cache TTL, "0" when caching is off).
 func (c *Client) HackerNewsTopStoriesDocuments(ctx context.Context, limit int) ([]*model.Document, error) {
 	if c == nil || c.openapi == nil {
 		return nil, fmt.Errorf("aether: openapi subsystem not initialized")
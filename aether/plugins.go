@@ -16,6 +16,7 @@ package aether
 import (
 	"fmt"
 
+	"github.com/Nibir1/Aether/internal/config"
 	"github.com/Nibir1/Aether/plugins"
 )
 
@@ -25,11 +26,50 @@ func (c *Client) initPlugins() {
 	c.plugins = plugins.NewRegistry()
 }
 
+// WithPluginValidation enables strict validation of plugin output. Each
+// Document returned by a SourcePlugin's Fetch or a TransformPlugin's
+// Apply is checked with plugins.ValidateDocument: an invalid source
+// document makes Search fail with a *plugins.ValidationError, and an
+// invalid transform result is logged and discarded. Off by default.
+func WithPluginValidation(enabled bool) Option {
+	return func(c *config.Config) {
+		c.ValidatePlugins = enabled
+	}
+}
+
+// validatePluginDocument applies plugins.ValidateDocument when strict
+// validation is enabled, attributing failures to the named plugin.
+func (c *Client) validatePluginDocument(name string, doc *plugins.Document) error {
+	if c.cfg == nil || !c.cfg.ValidatePlugins {
+		return nil
+	}
+	if err := plugins.ValidateDocument(doc); err != nil {
+		if verr, ok := err.(*plugins.ValidationError); ok {
+			verr.Plugin = name
+		}
+		return err
+	}
+	return nil
+}
+
+// WithPluginOverride switches the client's plugin registry between
+// strict mode (the default), where registering a name that is already
+// taken fails, and override mode, where it replaces the existing plugin.
+// Use the Replace*Plugin methods to get the replaced plugin back for
+// cleanup. It returns c so it can be chained.
+func (c *Client) WithPluginOverride(enabled bool) *Client {
+	if c != nil && c.plugins != nil {
+		c.plugins.SetOverride(enabled)
+	}
+	return c
+}
+
 // RegisterSourcePlugin registers a SourcePlugin.
 //
 // Strict naming:
 // If another plugin with the same name is already registered,
-// an error is returned by the registry.
+// an error is returned by the registry unless override mode is on
+// (see WithPluginOverride).
 func (c *Client) RegisterSourcePlugin(p plugins.SourcePlugin) error {
 	if c == nil {
 		return fmt.Errorf("aether: nil client")
@@ -63,3 +103,48 @@ func (c *Client) RegisterDisplayPlugin(p plugins.DisplayPlugin) error {
 	}
 	return c.plugins.RegisterDisplay(p)
 }
+
+// ReplaceSourcePlugin registers p like RegisterSourcePlugin and returns
+// the source plugin of the same name it replaced, or nil if the
+// name was free. Replacing requires override mode (see
+// WithPluginOverride); the swap is atomic with respect to concurrent
+// registrations and lookups.
+func (c *Client) ReplaceSourcePlugin(p plugins.SourcePlugin) (plugins.SourcePlugin, error) {
+	if c == nil {
+		return nil, fmt.Errorf("aether: nil client")
+	}
+	if c.plugins == nil {
+		return nil, fmt.Errorf("aether: plugin registry not initialized")
+	}
+	return c.plugins.ReplaceSource(p)
+}
+
+// ReplaceTransformPlugin registers p like RegisterTransformPlugin and returns
+// the transform plugin of the same name it replaced, or nil if the
+// name was free. Replacing requires override mode (see
+// WithPluginOverride); the swap is atomic with respect to concurrent
+// registrations and lookups.
+func (c *Client) ReplaceTransformPlugin(p plugins.TransformPlugin) (plugins.TransformPlugin, error) {
+	if c == nil {
+		return nil, fmt.Errorf("aether: nil client")
+	}
+	if c.plugins == nil {
+		return nil, fmt.Errorf("aether: plugin registry not initialized")
+	}
+	return c.plugins.ReplaceTransform(p)
+}
+
+// ReplaceDisplayPlugin registers p like RegisterDisplayPlugin and returns
+// the display plugin of the same name it replaced, or nil if the
+// name was free. Replacing requires override mode (see
+// WithPluginOverride); the swap is atomic with respect to concurrent
+// registrations and lookups.
+func (c *Client) ReplaceDisplayPlugin(p plugins.DisplayPlugin) (plugins.DisplayPlugin, error) {
+	if c == nil {
+		return nil, fmt.Errorf("aether: nil client")
+	}
+	if c.plugins == nil {
+		return nil, fmt.Errorf("aether: plugin registry not initialized")
+	}
+	return c.plugins.ReplaceDisplay(p)
+}
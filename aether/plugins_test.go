@@ -0,0 +1,224 @@
+// aether/plugins_test.go
+package aether
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Nibir1/Aether/plugins"
+)
+
+type brokenSource struct{}
+
+func (brokenSource) Name() string           { return "broken" }
+func (brokenSource) Description() string    { return "returns malformed documents" }
+func (brokenSource) Capabilities() []string { return nil }
+
+func (brokenSource) Fetch(ctx context.Context, query string) (*plugins.Document, error) {
+	return &plugins.Document{
+		Title:    "Broken",
+		Metadata: map[string]string{"": "orphan"},
+		Sections: []plugins.Section{{Text: "no role"}},
+	}, nil
+}
+
+func TestWithPluginValidation_RejectsInvalidDocument(t *testing.T) {
+	cli, err := NewClient(WithPluginValidation(true))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	if err := cli.RegisterSourcePlugin(brokenSource{}); err != nil {
+		t.Fatalf("RegisterSourcePlugin error: %v", err)
+	}
+
+	_, err = cli.Search(context.Background(), "anything")
+	var verr *plugins.ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Search error: got %v, want *plugins.ValidationError", err)
+	}
+	if verr.Plugin != "broken" {
+		t.Fatalf("Plugin: got %q, want %q", verr.Plugin, "broken")
+	}
+	msg := err.Error()
+	for _, want := range []string{"kind is empty", "metadata has an empty key", "section 0 has no role"} {
+		if !strings.Contains(msg, want) {
+			t.Fatalf("error %q does not mention %q", msg, want)
+		}
+	}
+}
+
+func TestPluginValidation_OffByDefault(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	if err := cli.RegisterSourcePlugin(brokenSource{}); err != nil {
+		t.Fatalf("RegisterSourcePlugin error: %v", err)
+	}
+
+	res, err := cli.Search(context.Background(), "anything")
+	if err != nil {
+		t.Fatalf("Search error: %v", err)
+	}
+	if res.Plan.Source != "broken" {
+		t.Fatalf("Source: got %q, want %q", res.Plan.Source, "broken")
+	}
+}
+
+type annotatingSource struct{}
+
+func (annotatingSource) Name() string           { return "annotating" }
+func (annotatingSource) Description() string    { return "annotates the search plan" }
+func (annotatingSource) Capabilities() []string { return nil }
+
+func (annotatingSource) Fetch(ctx context.Context, query string) (*plugins.Document, error) {
+	return &plugins.Document{Kind: plugins.DocumentKindText, Title: "plain"}, nil
+}
+
+func (s annotatingSource) FetchWithPlan(ctx context.Context, query string) (*plugins.Document, map[string]string, error) {
+	doc, err := s.Fetch(ctx, query)
+	return doc, map[string]string{"endpoint": "v2", "confidence": "high"}, err
+}
+
+func TestSourcePluginWithPlan_AnnotatesPlan(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	if err := cli.RegisterSourcePlugin(annotatingSource{}); err != nil {
+		t.Fatalf("RegisterSourcePlugin error: %v", err)
+	}
+
+	res, err := cli.Search(context.Background(), "anything")
+	if err != nil {
+		t.Fatalf("Search error: %v", err)
+	}
+	if res.Plan.Source != "annotating" {
+		t.Fatalf("Source: got %q, want %q", res.Plan.Source, "annotating")
+	}
+	if got := res.Plan.Metadata["endpoint"]; got != "v2" {
+		t.Fatalf("Plan.Metadata[endpoint]: got %q, want %q", got, "v2")
+	}
+	if got := res.Plan.Metadata["confidence"]; got != "high" {
+		t.Fatalf("Plan.Metadata[confidence]: got %q, want %q", got, "high")
+	}
+}
+
+type pagedAnnotatingSource struct{ annotatingSource }
+
+func (s pagedAnnotatingSource) FetchPage(ctx context.Context, query string, offset, limit int) (*plugins.Document, error) {
+	return s.Fetch(ctx, query)
+}
+
+func (s pagedAnnotatingSource) FetchPageWithPlan(ctx context.Context, query string, offset, limit int) (*plugins.Document, map[string]string, error) {
+	doc, err := s.FetchPage(ctx, query, offset, limit)
+	return doc, map[string]string{"endpoint": "v2/page", "page_offset": strconv.Itoa(offset)}, err
+}
+
+func TestSourcePluginWithPlan_AnnotatesPagedSearch(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	if err := cli.RegisterSourcePlugin(pagedAnnotatingSource{}); err != nil {
+		t.Fatalf("RegisterSourcePlugin error: %v", err)
+	}
+
+	res, err := cli.SearchPaged(context.Background(), "anything", 20, 10)
+	if err != nil {
+		t.Fatalf("SearchPaged error: %v", err)
+	}
+	if got, want := res.Plan.Metadata["endpoint"], "v2/page"; got != want {
+		t.Fatalf("Plan.Metadata[endpoint]: got %q, want %q", got, want)
+	}
+	if got, want := res.Plan.Metadata["page_offset"], "20"; got != want {
+		t.Fatalf("Plan.Metadata[page_offset]: got %q, want %q", got, want)
+	}
+	if got, want := res.PrimaryDocument.Metadata["aether.offset"], "20"; got != want {
+		t.Fatalf("aether.offset: got %q, want %q", got, want)
+	}
+
+	// Plain Search still goes through FetchWithPlan.
+	res, err = cli.Search(context.Background(), "anything")
+	if err != nil {
+		t.Fatalf("Search error: %v", err)
+	}
+	if got, want := res.Plan.Metadata["endpoint"], "v2"; got != want {
+		t.Fatalf("Search Plan.Metadata[endpoint]: got %q, want %q", got, want)
+	}
+}
+
+func TestSourcePluginWithPlan_PlainPluginLeavesPlanUnannotated(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	if err := cli.RegisterSourcePlugin(brokenSource{}); err != nil {
+		t.Fatalf("RegisterSourcePlugin error: %v", err)
+	}
+
+	res, err := cli.Search(context.Background(), "anything")
+	if err != nil {
+		t.Fatalf("Search error: %v", err)
+	}
+	if res.Plan.Metadata != nil {
+		t.Fatalf("Plan.Metadata: got %v, want nil", res.Plan.Metadata)
+	}
+}
+
+func TestWithPluginOverride_ReplacesSamePlugin(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	first := textSource{name: "news", title: "First"}
+	second := textSource{name: "news", title: "Second"}
+
+	if err := cli.RegisterSourcePlugin(first); err != nil {
+		t.Fatalf("RegisterSourcePlugin error: %v", err)
+	}
+	if err := cli.RegisterSourcePlugin(second); err == nil {
+		t.Fatal("strict mode accepted a duplicate name")
+	}
+
+	old, err := cli.WithPluginOverride(true).ReplaceSourcePlugin(second)
+	if err != nil {
+		t.Fatalf("ReplaceSourcePlugin error: %v", err)
+	}
+	if old != first {
+		t.Fatalf("replaced plugin: got %#v, want %#v", old, first)
+	}
+	if got := cli.plugins.GetSource("news"); got != second {
+		t.Fatalf("registered plugin: got %#v, want %#v", got, second)
+	}
+	// Concurrent swaps: every replaced plugin is one that was registered.
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(p textSource) {
+			defer wg.Done()
+			old, err := cli.ReplaceSourcePlugin(p)
+			if err != nil || (old != first && old != second) {
+				t.Errorf("concurrent replace: got (%#v, %v)", old, err)
+			}
+			cli.plugins.GetSource("news")
+		}([]textSource{first, second}[i%2])
+	}
+	wg.Wait()
+
+	if err := cli.RegisterSourcePlugin(first); err != nil {
+		t.Fatalf("RegisterSourcePlugin in override mode: %v", err)
+	}
+
+	cli.WithPluginOverride(false)
+	if _, err := cli.ReplaceSourcePlugin(second); err == nil {
+		t.Fatal("strict mode restored, but replacing still succeeded")
+	}
+	if got := cli.plugins.GetSource("news"); got != first {
+		t.Fatalf("strict-mode failure changed the registry: got %#v", got)
+	}
+}
@@ -0,0 +1,90 @@
+// aether/render_batch.go
+//
+// Batch normalization and rendering.
+//
+// NormalizeBatch and RenderBatch are the many-results counterparts of
+// NormalizeSearchResult and Render, for callers such as static-site
+// generators that turn a whole set of SearchResults into output files in
+// one pass. Work is spread over a small worker pool; outputs are always
+// index-aligned with the input, and a failure on one item never aborts
+// the others.
+
+package aether
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// batchWorkers is the worker pool size for NormalizeBatch and RenderBatch.
+const batchWorkers = 4
+
+// NormalizeBatch normalizes every result concurrently, applying opts and
+// TransformPlugins exactly as NormalizeSearchResult does. The returned
+// slice has one document per input, in input order.
+func (c *Client) NormalizeBatch(results []*SearchResult, opts ...NormalizeOption) []*NormalizedDocument {
+	docs := make([]*NormalizedDocument, len(results))
+	forEachIndex(len(results), func(i int) {
+		docs[i] = c.NormalizeSearchResult(results[i], opts...)
+	})
+	return docs
+}
+
+// RenderBatch normalizes results with NormalizeBatch and renders each
+// document through Render in the given format, concurrently.
+//
+// Both returned slices are index-aligned with results: outputs[i] holds
+// the rendered bytes of results[i], or nil when errs[i] is non-nil. A nil
+// result, a render failure or a cancelled ctx only affects its own item.
+func (c *Client) RenderBatch(ctx context.Context, format string, results []*SearchResult, opts ...RenderOption) ([][]byte, []error) {
+	outputs := make([][]byte, len(results))
+	errs := make([]error, len(results))
+
+	if c == nil {
+		for i := range errs {
+			errs[i] = ErrNilClient
+		}
+		return outputs, errs
+	}
+
+	docs := c.NormalizeBatch(results)
+	forEachIndex(len(results), func(i int) {
+		if results[i] == nil {
+			errs[i] = fmt.Errorf("aether: nil SearchResult at index %d", i)
+			return
+		}
+		if err := ctx.Err(); err != nil {
+			errs[i] = err
+			return
+		}
+		outputs[i], errs[i] = c.Render(ctx, format, docs[i], opts...)
+	})
+	return outputs, errs
+}
+
+// forEachIndex calls fn for every index in [0, n) on up to batchWorkers
+// goroutines and returns once all calls have finished.
+func forEachIndex(n int, fn func(i int)) {
+	workers := batchWorkers
+	if n < workers {
+		workers = n
+	}
+
+	idx := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range idx {
+				fn(i)
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		idx <- i
+	}
+	close(idx)
+	wg.Wait()
+}
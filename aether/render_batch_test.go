@@ -0,0 +1,46 @@
+// aether/render_batch_test.go
+package aether
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestRenderBatch_PreservesOrder(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	var results []*SearchResult
+	for i := 1; i <= 3; i++ {
+		results = append(results, &SearchResult{
+			PrimaryDocument: &SearchDocument{
+				URL:     fmt.Sprintf("https://example.com/%d", i),
+				Title:   fmt.Sprintf("Result %d", i),
+				Content: fmt.Sprintf("Body of result %d.", i),
+				Kind:    SearchDocumentKindArticle,
+			},
+		})
+	}
+	results = append(results, nil)
+
+	outs, errs := cli.RenderBatch(context.Background(), "markdown", results, withoutColor())
+	if len(outs) != 4 || len(errs) != 4 {
+		t.Fatalf("lengths: got %d outputs, %d errors, want 4 each", len(outs), len(errs))
+	}
+	for i := 0; i < 3; i++ {
+		if errs[i] != nil {
+			t.Fatalf("item %d: unexpected error: %v", i, errs[i])
+		}
+		want := fmt.Sprintf("Result %d", i+1)
+		if !strings.Contains(string(outs[i]), want) {
+			t.Fatalf("item %d: got %q, want it to contain %q", i, outs[i], want)
+		}
+	}
+	if errs[3] == nil || outs[3] != nil {
+		t.Fatalf("nil result: got output %q, error %v", outs[3], errs[3])
+	}
+}
@@ -0,0 +1,117 @@
+// aether/render_cache.go
+//
+// Render cache.
+//
+// Rendering the same document several times (one format after another,
+// or the same page for many requests) repeats the whole renderer each
+// time. With WithRenderCache, Render keeps the output of built-in
+// formats in a small LRU keyed by format, document hash and theme.
+// Because the key contains the hash of the document's canonical JSON
+// and a generation counter of the RegisterSectionRenderer table, any
+// change to the document, to the theme and render options, or to the
+// registered section renderers produces a different key; entries never
+// need explicit invalidation.
+
+package aether
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	icache "github.com/Nibir1/Aether/internal/cache"
+	"github.com/Nibir1/Aether/internal/config"
+	"github.com/Nibir1/Aether/internal/display"
+)
+
+// renderCacheTTL bounds how long an unused entry is kept. Entries are
+// never stale, so this only limits memory held by idle clients.
+const renderCacheTTL = time.Hour
+
+// WithRenderCache keeps up to entries rendered outputs of built-in
+// formats, so rendering an unchanged document again with the same
+// format and options returns the cached bytes. Output of display
+// plugins is never cached. entries <= 0 disables the cache (the
+// default).
+func WithRenderCache(entries int) Option {
+	return func(c *config.Config) {
+		c.RenderCacheSize = max(entries, 0)
+	}
+}
+
+// RenderCacheStats counts render cache lookups.
+type RenderCacheStats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// renderCache stores rendered output by renderCacheKey.
+type renderCache struct {
+	store  icache.Cache
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+func newRenderCache(cfg *config.Config) *renderCache {
+	return &renderCache{store: icache.NewMemoryWithClock(cfg.RenderCacheSize, renderCacheTTL, cfg.Clock)}
+}
+
+// RenderCacheStats reports the hits and misses of the render cache. It
+// is zero when the cache is disabled.
+func (c *Client) RenderCacheStats() RenderCacheStats {
+	if c == nil || c.renders == nil {
+		return RenderCacheStats{}
+	}
+	return RenderCacheStats{Hits: c.renders.hits.Load(), Misses: c.renders.misses.Load()}
+}
+
+// DocumentHash returns the hex SHA-256 of doc's canonical JSON (see
+// MarshalCanonicalJSON). Equal documents have equal hashes, so it can
+// key caches and detect changes between fetches.
+func (c *Client) DocumentHash(doc *NormalizedDocument) (string, error) {
+	data, err := c.MarshalCanonicalJSON(doc)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// renderCacheKey identifies one render: the format, the document hash,
+// the theme name and a digest of the full theme and render options,
+// which covers settings such as width, color and section limits. The
+// effective width is included because a theme without MaxWidth follows
+// the terminal, and the section renderer generation because registered
+// renderers change text output.
+func renderCacheKey(format, docHash string, theme display.Theme, ro *renderOptions) string {
+	opts := sha256.Sum256(fmt.Appendf(nil, "%+v|%+v|%d|%d", theme, ro.html,
+		display.EffectiveWidth(theme), display.SectionRenderersGeneration()))
+	return "render:" + format + ":" + docHash + ":" + theme.Name + ":" + hex.EncodeToString(opts[:8])
+}
+
+// cachedRender returns the output of render for the given key parts,
+// from the cache when possible.
+func (c *Client) cachedRender(format string, doc *NormalizedDocument, theme display.Theme, ro *renderOptions, render func() ([]byte, error)) ([]byte, error) {
+	if c.renders == nil {
+		return render()
+	}
+	hash, err := c.DocumentHash(doc)
+	if err != nil {
+		return render()
+	}
+	key := renderCacheKey(format, hash, theme, ro)
+	if out, ok := c.renders.store.Get(key); ok {
+		c.renders.hits.Add(1)
+		return out, nil
+	}
+	c.renders.misses.Add(1)
+
+	out, err := render()
+	if err != nil {
+		return nil, err
+	}
+	c.renders.store.Set(key, out, renderCacheTTL)
+	return out, nil
+}
@@ -0,0 +1,107 @@
+// aether/render_cache_test.go
+package aether
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Nibir1/Aether/internal/display"
+)
+
+func TestRenderCache_SecondRenderIsCached(t *testing.T) {
+	cli, err := NewClient(WithRenderCache(16))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	doc := &NormalizedDocument{
+		Title:    "Cached",
+		Sections: []NormalizedSection{{Role: SectionRoleBody, Heading: "Intro", Text: "Rendered once."}},
+	}
+	ctx := context.Background()
+
+	first, err := cli.Render(ctx, "markdown", doc)
+	if err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	second, err := cli.Render(ctx, "markdown", doc)
+	if err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	if string(first) != string(second) {
+		t.Fatalf("cached output differs:\ngot  %q\nwant %q", second, first)
+	}
+	if got, want := cli.RenderCacheStats(), (RenderCacheStats{Hits: 1, Misses: 1}); got != want {
+		t.Fatalf("stats after repeat: got %+v, want %+v", got, want)
+	}
+
+	// A different format, different options or a changed document each
+	// miss the cache.
+	if _, err := cli.Render(ctx, "html", doc); err != nil {
+		t.Fatalf("Render html error: %v", err)
+	}
+	if _, err := cli.Render(ctx, "markdown", doc, WithWidth(40)); err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	doc.Sections[0].Text = "Rendered twice."
+	changed, err := cli.Render(ctx, "markdown", doc)
+	if err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	if string(changed) == string(first) {
+		t.Fatal("changed document served stale output")
+	}
+	if got, want := cli.RenderCacheStats(), (RenderCacheStats{Hits: 1, Misses: 4}); got != want {
+		t.Fatalf("stats after changes: got %+v, want %+v", got, want)
+	}
+}
+
+func TestRenderCache_SectionRendererInvalidates(t *testing.T) {
+	cli, err := NewClient(WithRenderCache(16))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	role := SectionRole("cache_test_quote")
+	doc := &NormalizedDocument{
+		Title:    "Quoted",
+		Sections: []NormalizedSection{{Role: role, Text: "To be or not to be."}},
+	}
+	ctx := context.Background()
+
+	before, err := cli.Render(ctx, "markdown", doc)
+	if err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+
+	RegisterSectionRenderer(role, func(s NormalizedSection, theme display.Theme, width int) string {
+		return "> " + s.Text
+	})
+	defer RegisterSectionRenderer(role, nil)
+
+	after, err := cli.Render(ctx, "markdown", doc)
+	if err != nil {
+		t.Fatalf("Render error: %v", err)
+	}
+	if string(after) == string(before) {
+		t.Fatalf("registered section renderer ignored; stale output %q", after)
+	}
+	if !strings.Contains(string(after), "> To be or not to be.") {
+		t.Fatalf("output missing custom rendering: %q", after)
+	}
+}
+
+func TestRenderCache_DisabledByDefault(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	doc := &NormalizedDocument{Title: "Plain", Content: "Body."}
+	for i := 0; i < 2; i++ {
+		if _, err := cli.Render(context.Background(), "text", doc); err != nil {
+			t.Fatalf("Render error: %v", err)
+		}
+	}
+	if got := cli.RenderCacheStats(); got != (RenderCacheStats{}) {
+		t.Fatalf("stats without cache: got %+v", got)
+	}
+}
@@ -0,0 +1,41 @@
+// aether/robots.go
+//
+// Inspection and refresh of the robots.txt cache.
+//
+// Aether fetches robots.txt once per origin and keeps the rules for the
+// lifetime of the Client. Long-running servers can list the cached
+// origins with RobotsCacheHosts and force a refresh with RefreshRobots,
+// for example after a site changes its rules.
+
+package aether
+
+import (
+	"context"
+	"fmt"
+)
+
+// RobotsCacheHosts returns the origins ("https://example.com") whose
+// robots.txt is currently cached, sorted.
+func (c *Client) RobotsCacheHosts() []string {
+	if c == nil || c.fetcher == nil {
+		return nil
+	}
+	return c.fetcher.RobotsHosts()
+}
+
+// RefreshRobots evicts the cached robots.txt for host and fetches it
+// again, so later requests use the current rules. host is either an
+// origin such as "https://example.com", or a bare host name, which
+// refreshes every cached scheme and port of that host.
+//
+// If the re-fetch fails the entry stays evicted and the next request to
+// the host fetches robots.txt again.
+func (c *Client) RefreshRobots(host string) error {
+	if c == nil || c.fetcher == nil {
+		return fmt.Errorf("aether: nil client")
+	}
+	if err := c.fetcher.RefreshRobots(context.Background(), host); err != nil {
+		return fmt.Errorf("aether: refresh robots.txt for %q: %w", host, err)
+	}
+	return nil
+}
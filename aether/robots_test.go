@@ -0,0 +1,97 @@
+// aether/robots_test.go
+package aether
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRefreshRobots_RefetchesRules(t *testing.T) {
+	var (
+		robotsHits atomic.Int32
+		blockAll   atomic.Bool
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			robotsHits.Add(1)
+			if blockAll.Load() {
+				w.Write([]byte("User-agent: *\nDisallow: /\n"))
+				return
+			}
+			w.Write([]byte("User-agent: *\nDisallow: /private\n"))
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	ctx := context.Background()
+
+	if _, _, err := cli.FetchRaw(ctx, srv.URL+"/page"); err != nil {
+		t.Fatalf("FetchRaw error: %v", err)
+	}
+	hosts := cli.RobotsCacheHosts()
+	if len(hosts) != 1 || hosts[0] != srv.URL {
+		t.Fatalf("RobotsCacheHosts: got %v, want [%s]", hosts, srv.URL)
+	}
+
+	// The site tightens its rules; the cached copy still allows /page.
+	blockAll.Store(true)
+	if _, _, err := cli.FetchRaw(ctx, srv.URL+"/page"); err != nil {
+		t.Fatalf("FetchRaw with cached rules error: %v", err)
+	}
+	if got := robotsHits.Load(); got != 1 {
+		t.Fatalf("robots.txt fetches before refresh: got %d, want 1", got)
+	}
+
+	if err := cli.RefreshRobots(srv.URL); err != nil {
+		t.Fatalf("RefreshRobots error: %v", err)
+	}
+	if got := robotsHits.Load(); got != 2 {
+		t.Fatalf("robots.txt fetches after refresh: got %d, want 2", got)
+	}
+	if _, _, err := cli.FetchRaw(ctx, srv.URL+"/page"); err == nil {
+		t.Fatalf("FetchRaw after refresh: got nil error, want robots.txt disallow")
+	}
+	if got := robotsHits.Load(); got != 2 {
+		t.Fatalf("robots.txt fetches after next check: got %d, want 2", got)
+	}
+}
+
+func TestRefreshRobots_BareHostMatchesCachedOrigin(t *testing.T) {
+	var robotsHits atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			robotsHits.Add(1)
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	if _, _, err := cli.FetchRaw(context.Background(), srv.URL+"/"); err != nil {
+		t.Fatalf("FetchRaw error: %v", err)
+	}
+
+	if err := cli.RefreshRobots("127.0.0.1"); err != nil {
+		t.Fatalf("RefreshRobots error: %v", err)
+	}
+	if got := robotsHits.Load(); got != 2 {
+		t.Fatalf("robots.txt fetches: got %d, want 2", got)
+	}
+	if hosts := cli.RobotsCacheHosts(); len(hosts) != 1 || hosts[0] != srv.URL {
+		t.Fatalf("RobotsCacheHosts: got %v, want [%s]", hosts, srv.URL)
+	}
+}
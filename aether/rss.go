@@ -1,6 +1,6 @@
 // aether/rss.go
 //
-// Public RSS/Atom feed fetching and parsing interface for Aether.
+// Public RSS/Atom (and JSON Feed) fetching and parsing interface for Aether.
 // This integrates Aether’s robots.txt-compliant HTTP fetcher with the
 // internal RSS/Atom parsers and feed-type sniffing logic.
 //
@@ -13,8 +13,9 @@ package aether
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"io"
+	"strings"
 
 	irss "github.com/Nibir1/Aether/internal/rss"
 )
@@ -25,12 +26,20 @@ type FeedItem struct {
 	Link        string
 	Description string
 	Content     string
-	Author      string
+	Author      string // first author, kept for compatibility
+	Authors     []FeedAuthor
 	Published   int64
 	Updated     int64
 	GUID        string
 }
 
+// FeedAuthor is a person credited on a feed entry.
+type FeedAuthor struct {
+	Name  string
+	Email string
+	URI   string
+}
+
 // Feed is the public normalized RSS/Atom feed.
 type Feed struct {
 	Title       string
@@ -40,8 +49,18 @@ type Feed struct {
 	Items       []FeedItem
 }
 
+// ErrUnknownFeed is matched (via errors.Is) by parse errors for input
+// that is not recognizable as any supported feed format. Such errors
+// wrap the XML error from the first parse attempt, if any.
+var ErrUnknownFeed error = irss.ErrUnknownFeed
+
 // ParseRSS parses raw RSS/Atom XML bytes into a public Feed.
 //
+// Gzip-compressed input (for example the body of a `feed.xml.gz`) is
+// decompressed transparently. A feed that fails to parse reports why,
+// e.g. "malformed rss2 feed: XML syntax error on line 3: ...", or an
+// error matching ErrUnknownFeed when the format is not recognized.
+//
 // This method does NOT fetch or check robots.txt; it only parses.
 // Use FetchRSS() to fetch and parse in one call.
 func (c *Client) ParseRSS(xmlBytes []byte) (*Feed, error) {
@@ -49,47 +68,123 @@ func (c *Client) ParseRSS(xmlBytes []byte) (*Feed, error) {
 		return nil, fmt.Errorf("aether: nil client")
 	}
 
-	// Step 1 — fast pre-check using DetectFeedType
-	ft := irss.DetectFeedType(xmlBytes)
-	if ft == irss.FeedUnknown {
-		return nil, errors.New("aether: content does not appear to be a valid RSS/Atom feed")
+	xmlBytes, err := irss.Decompress(xmlBytes)
+	if err != nil {
+		return nil, fmt.Errorf("aether: %w", err)
 	}
 
-	// Step 2 — full parse of RSS/Atom variants
+	// Step 1 — full parse; the feed type is detected from the root
+	// element, and every format is tried when it is unrecognized.
 	internalFeed, err := irss.Parse(xmlBytes)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("aether: %w", err)
+	}
+
+	// Step 2 — cleanup / normalization
+	internalFeed.Clean()
+
+	// Step 3 — convert to public type
+	return feedFromInternal(internalFeed), nil
+}
+
+// ParseFeed parses feed bytes from any source (a file, a message queue,
+// a previous fetch) into a public Feed. RSS 2.0, RSS 1.0, Atom and JSON
+// Feed are recognized from the content itself, and gzip-compressed input
+// is decompressed transparently.
+//
+// Input whose root element is unrecognized is tried against every
+// format before giving up with an error matching ErrUnknownFeed. Pass
+// the result to NormalizeFeed to obtain a normalized document.
+func (c *Client) ParseFeed(data []byte) (*Feed, error) {
+	if c == nil {
+		return nil, fmt.Errorf("aether: nil client")
 	}
 
-	// Step 3 — cleanup / normalization
+	internalFeed, err := irss.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("aether: %w", err)
+	}
 	internalFeed.Clean()
 
-	// Step 4 — convert to public type
+	return feedFromInternal(internalFeed), nil
+}
+
+// feedFromInternal converts an internal feed to the public type.
+func feedFromInternal(f *irss.Feed) *Feed {
 	out := &Feed{
-		Title:       internalFeed.Title,
-		Description: internalFeed.Description,
-		Link:        internalFeed.Link,
-		Updated:     internalFeed.Updated.Unix(),
-	}
-
-	for _, it := range internalFeed.Items {
-		out.Items = append(out.Items, FeedItem{
-			Title:       it.Title,
-			Link:        it.Link,
-			Description: it.Description,
-			Content:     it.Content,
-			Author:      it.Author,
-			Published:   it.Published.Unix(),
-			Updated:     it.Updated.Unix(),
-			GUID:        it.GUID,
-		})
-	}
-
-	return out, nil
+		Title:       f.Title,
+		Description: f.Description,
+		Link:        f.Link,
+		Updated:     f.Updated.Unix(),
+	}
+	for _, it := range f.Items {
+		out.Items = append(out.Items, feedItemFromInternal(it))
+	}
+	return out
+}
+
+// ParseRSSStream parses a (potentially huge) RSS/Atom feed from r without
+// buffering the whole document, calling onItem once per item.
+//
+// The returned Feed carries only the channel-level fields; Items is left
+// empty. If onItem returns an error, parsing stops and that error is
+// returned.
+func (c *Client) ParseRSSStream(r io.Reader, onItem func(FeedItem) error) (*Feed, error) {
+	if c == nil {
+		return nil, fmt.Errorf("aether: nil client")
+	}
+	if onItem == nil {
+		return nil, fmt.Errorf("aether: nil onItem callback")
+	}
+
+	hdr, err := irss.ParseStream(r, func(it irss.Item) error {
+		it.Title = strings.TrimSpace(it.Title)
+		it.Description = strings.TrimSpace(it.Description)
+		it.Content = strings.TrimSpace(it.Content)
+		return onItem(feedItemFromInternal(it))
+	})
+	if hdr == nil {
+		return nil, err
+	}
+
+	out := &Feed{
+		Title:       strings.TrimSpace(hdr.Title),
+		Description: strings.TrimSpace(hdr.Description),
+		Link:        hdr.Link,
+		Updated:     hdr.Updated.Unix(),
+	}
+	return out, err
+}
+
+// feedItemFromInternal converts an internal feed item to the public type.
+func feedItemFromInternal(it irss.Item) FeedItem {
+	var authors []FeedAuthor
+	for _, a := range it.Authors {
+		authors = append(authors, FeedAuthor{Name: a.Name, Email: a.Email, URI: a.URI})
+	}
+	return FeedItem{
+		Title:       it.Title,
+		Link:        it.Link,
+		Description: it.Description,
+		Content:     it.Content,
+		Author:      it.Author,
+		Authors:     authors,
+		Published:   it.Published.Unix(),
+		Updated:     it.Updated.Unix(),
+		GUID:        it.GUID,
+	}
 }
 
 // FetchRSS fetches and parses an RSS/Atom feed, respecting robots.txt.
 //
+// Compressed feeds are handled transparently: `Content-Encoding: gzip`
+// is decoded by the transport, and gzip bodies served as `.gz` files or
+// application/gzip are decompressed before parsing.
+//
+// Repeated fetches of the same URL are conditional (see FetchRSSSince):
+// when the feed is unchanged, the previously parsed Feed is returned
+// without parsing it again.
+//
 // Example:
 //
 //	feed, err := client.FetchRSS(ctx, "https://example.com/feed.rss")
@@ -98,10 +193,27 @@ func (c *Client) FetchRSS(ctx context.Context, url string) (*Feed, error) {
 		return nil, fmt.Errorf("aether: nil client")
 	}
 
+	// Conditional fetch; an unchanged feed comes back without parsing.
+	feed, _, err := c.pollFeed(ctx, url)
+	return feed, err
+}
+
+// FetchRSSRaw is FetchRSS for diagnostics: it also returns the raw body
+// that was fetched, so a feed that fails to parse can be inspected.
+//
+// raw is nil only when the fetch itself failed. When parsing fails, err
+// describes why — for example "malformed rss2 feed: XML syntax error on
+// line 3: ..." — and feed is nil.
+func (c *Client) FetchRSSRaw(ctx context.Context, url string) (feed *Feed, raw []byte, err error) {
+	if c == nil {
+		return nil, nil, fmt.Errorf("aether: nil client")
+	}
+
 	resp, err := c.Fetch(ctx, url)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return c.ParseRSS(resp.Body)
+	feed, err = c.ParseRSS(resp.Body)
+	return feed, resp.Body, err
 }
@@ -0,0 +1,198 @@
+// aether/rss_test.go
+package aether
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const gzipTestFeed = `<?xml version="1.0"?>
+<rss version="2.0"><channel>
+  <title>Compressed</title>
+  <item><title>First</title><link>https://example.com/1</link></item>
+  <item><title>Second</title><link>https://example.com/2</link></item>
+</channel></rss>`
+
+func TestFetchRSS_Gzip(t *testing.T) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write([]byte(gzipTestFeed))
+	zw.Close()
+	compressed := buf.Bytes()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/feed.xml.gz":
+			w.Header().Set("Content-Type", "application/gzip")
+		case "/encoded.xml":
+			w.Header().Set("Content-Type", "application/rss+xml")
+			w.Header().Set("Content-Encoding", "gzip")
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(compressed)
+	}))
+	defer srv.Close()
+
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	for _, path := range []string{"/feed.xml.gz", "/encoded.xml"} {
+		feed, err := cli.FetchRSS(context.Background(), srv.URL+path)
+		if err != nil {
+			t.Fatalf("%s: FetchRSS error: %v", path, err)
+		}
+		if len(feed.Items) != 2 {
+			t.Fatalf("%s: items: got %d, want 2", path, len(feed.Items))
+		}
+		if feed.Items[1].Title != "Second" {
+			t.Fatalf("%s: Items[1].Title: got %q, want %q", path, feed.Items[1].Title, "Second")
+		}
+	}
+}
+
+const malformedFeed = `<?xml version="1.0"?>
+<rss version="2.0"><channel>
+  <title>Broken</title>
+  <item><title>Unclosed</item>
+</channel></rss>`
+
+func TestFetchRSSRaw_MalformedFeed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/feed.xml" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/rss+xml")
+		w.Write([]byte(malformedFeed))
+	}))
+	defer srv.Close()
+
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	feed, raw, err := cli.FetchRSSRaw(context.Background(), srv.URL+"/feed.xml")
+	if err == nil {
+		t.Fatalf("expected parse error, got feed %+v", feed)
+	}
+	if feed != nil {
+		t.Fatalf("feed: got %+v, want nil", feed)
+	}
+	if string(raw) != malformedFeed {
+		t.Fatalf("raw: got %q, want %q", raw, malformedFeed)
+	}
+	msg := err.Error()
+	if !strings.Contains(msg, "malformed rss2 feed") || !strings.Contains(msg, "line 4") {
+		t.Fatalf("error not descriptive: %q", msg)
+	}
+}
+
+func TestParseRSS_UnknownFeedWrapsCause(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	_, err = cli.ParseRSS([]byte("<html><body><p>Not a feed</body></html>"))
+	if err == nil {
+		t.Fatal("expected an error for an HTML page")
+	}
+	if !errors.Is(err, ErrUnknownFeed) {
+		t.Fatalf("error %v does not match ErrUnknownFeed", err)
+	}
+	if errors.Unwrap(errors.Unwrap(err)) == nil {
+		t.Fatalf("error %v does not wrap the parse failure", err)
+	}
+}
+
+func TestParseFeed_RSS2Bytes(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	feed, err := cli.ParseFeed([]byte(gzipTestFeed))
+	if err != nil {
+		t.Fatalf("ParseFeed error: %v", err)
+	}
+	if feed.Title != "Compressed" || len(feed.Items) != 2 {
+		t.Fatalf("got title %q with %d items", feed.Title, len(feed.Items))
+	}
+	if got := feed.Items[1].Link; got != "https://example.com/2" {
+		t.Fatalf("got %q, want %q", got, "https://example.com/2")
+	}
+
+	doc := cli.NormalizeFeed(feed)
+	if doc.Kind != DocumentKindFeed || len(doc.Sections) != 2 {
+		t.Fatalf("normalized: kind %q with %d sections", doc.Kind, len(doc.Sections))
+	}
+	if got := doc.Sections[0].Heading; got != "First" {
+		t.Fatalf("got %q, want %q", got, "First")
+	}
+
+	if _, err := cli.ParseFeed([]byte("not a feed")); err == nil {
+		t.Fatal("expected an error for non-feed bytes")
+	}
+}
+
+func TestFetchRSSSince_NotModifiedSkipsParsing(t *testing.T) {
+	var polls []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		polls = append(polls, r.Header.Get("If-None-Match"))
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("Content-Type", "application/rss+xml")
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(gzipTestFeed))
+	}))
+	defer srv.Close()
+
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	url := srv.URL + "/feed.xml"
+
+	feed, err := cli.FetchRSSSince(context.Background(), url)
+	if err != nil {
+		t.Fatalf("first poll error: %v", err)
+	}
+	if len(feed.Items) != 2 {
+		t.Fatalf("first poll items: got %d, want 2", len(feed.Items))
+	}
+
+	// The 304 has no body, so parsing it would fail; ErrFeedNotModified
+	// shows the response was never handed to the parser.
+	feed, err = cli.FetchRSSSince(context.Background(), url)
+	if !errors.Is(err, ErrFeedNotModified) || feed != nil {
+		t.Fatalf("second poll: got (%v, %v), want ErrFeedNotModified", feed, err)
+	}
+
+	// FetchRSS keeps returning a feed, reusing the parsed one on 304.
+	feed, err = cli.FetchRSS(context.Background(), url)
+	if err != nil || len(feed.Items) != 2 {
+		t.Fatalf("FetchRSS after 304: got (%v, %v)", feed, err)
+	}
+
+	want := []string{"", `"v1"`, `"v1"`}
+	if strings.Join(polls, ",") != strings.Join(want, ",") {
+		t.Fatalf("If-None-Match sent: got %q, want %q", polls, want)
+	}
+}
@@ -24,11 +24,18 @@ package aether
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
+	ihtml "github.com/Nibir1/Aether/internal/html"
+	hclient "github.com/Nibir1/Aether/internal/httpclient"
+	"github.com/Nibir1/Aether/internal/normalize"
+	"github.com/Nibir1/Aether/internal/trace"
 	"github.com/Nibir1/Aether/plugins"
 )
 
@@ -54,6 +61,22 @@ type SearchPlan struct {
 	Intent   SearchIntent
 	URL      string
 	Source   string
+
+	// Target is the kind of document a URL query is expected to return,
+	// guessed from the URL. It selects the Accept header sent with the
+	// request (see WithAcceptHeader). Empty for non-URL queries.
+	Target SearchDocumentKind
+
+	// Sources lists, in order, the sources Search tries for this query:
+	// "direct_fetch" for URLs, otherwise each registered source plugin
+	// followed by the "wikipedia" fallback.
+	Sources []string
+
+	// Metadata holds annotations contributed by the source plugin that
+	// answered, if it implements plugins.SourcePluginWithPlan (or, for
+	// SearchPaged on a paged plugin, plugins.PagedSourcePluginWithPlan).
+	// Nil otherwise.
+	Metadata map[string]string
 }
 
 // SearchDocumentKind describes the kind of the primary document.
@@ -87,6 +110,19 @@ type SearchResult struct {
 
 	Article *Article
 	Feed    *Feed
+
+	// FromCache reports whether PrimaryDocument was served from Aether's
+	// cache rather than the network; CacheAge is the entry's age. Both
+	// mirror the "from_cache" / "cache_age_seconds" document metadata.
+	FromCache bool
+	CacheAge  time.Duration
+
+	// Partial is set when the context expired before the search could
+	// finish, or when a source plugin delivered an incomplete document
+	// and the Wikipedia fallback then failed. Search returns this result
+	// alongside the error; PrimaryDocument holds whatever a source
+	// managed to deliver (it may be nil).
+	Partial bool
 }
 
 //
@@ -95,68 +131,186 @@ type SearchResult struct {
 // ────────────────────────────────────────────────
 //
 
+// searchPage carries an optional offset/limit window through the
+// search pipeline. A nil *searchPage means "no paging requested".
+type searchPage struct {
+	Offset int
+	Limit  int
+}
+
 // Search is the high-level Aether search pipeline.
+//
+// If ctx expires before the search completes, Search does not discard
+// what it already has: it returns a SearchResult with Partial set —
+// carrying any document a source plugin delivered before the deadline —
+// together with an error wrapping ctx.Err(). Check the error with
+// errors.Is(err, context.DeadlineExceeded) to tell this case apart.
+// Likewise, a plugin's incomplete document is returned with Partial set
+// when the Wikipedia fallback fails, alongside the fallback's error.
 func (c *Client) Search(ctx context.Context, query string) (*SearchResult, error) {
 	if c == nil {
 		return nil, fmt.Errorf("aether: nil client in Search")
 	}
+	return c.search(ctx, query, nil)
+}
 
-	query = strings.TrimSpace(query)
-	if query == "" {
-		return nil, fmt.Errorf("aether: empty query")
+// SearchPaged is like Search but requests a specific window of results.
+//
+// When the matched SourcePlugin implements plugins.PagedSourcePlugin,
+// offset and limit are passed through to FetchPage, or to
+// FetchPageWithPlan for plugins.PagedSourcePluginWithPlan, whose
+// annotations reach SearchPlan.Metadata as in Search. Plugins without
+// paging support, URL queries and the Wikipedia fallback ignore them.
+func (c *Client) SearchPaged(ctx context.Context, query string, offset, limit int) (*SearchResult, error) {
+	if c == nil {
+		return nil, fmt.Errorf("aether: nil client in SearchPaged")
+	}
+	if offset < 0 {
+		return nil, fmt.Errorf("aether: negative offset %d", offset)
 	}
+	if limit < 0 {
+		return nil, fmt.Errorf("aether: negative limit %d", limit)
+	}
+	return c.search(ctx, query, &searchPage{Offset: offset, Limit: limit})
+}
 
+// PlanSearch returns the plan Search would follow for query without
+// performing any I/O: the query classification and the ordered list of
+// sources that would be tried. Plugins are listed in the order Search
+// consults them; which one actually answers is only known at run time.
+func (c *Client) PlanSearch(query string) SearchPlan {
+	query = strings.TrimSpace(query)
 	plan := SearchPlan{
 		RawQuery: query,
 		Intent:   SearchIntentUnknown,
 	}
+	if query == "" {
+		return plan
+	}
 
-	// ─── URL Query ─────────────────────────────────────────────────
 	if isProbablyURL(query) {
 		plan.Intent = SearchIntentURL
 		plan.URL = query
+		plan.Target = urlTargetKind(query)
+		plan.Sources = []string{"direct_fetch"}
+		return plan
+	}
+
+	plan.Intent = SearchIntentLookup
+	if c != nil && c.plugins != nil {
+		plan.Sources = append(plan.Sources, c.plugins.ListSources()...)
+	}
+	plan.Sources = append(plan.Sources, "wikipedia")
+	return plan
+}
 
+// search implements Search and SearchPaged.
+func (c *Client) search(ctx context.Context, query string, page *searchPage) (*SearchResult, error) {
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("aether: empty query")
+	}
+
+	plan := c.PlanSearch(query)
+
+	// ─── URL Query ─────────────────────────────────────────────────
+	if plan.Intent == SearchIntentURL {
 		doc, err := c.searchURL(ctx, plan)
 		if err != nil {
 			return nil, err
 		}
 
-		return &SearchResult{
-			Query:           query,
-			Plan:            plan,
-			PrimaryDocument: doc,
-		}, nil
+		return newSearchResult(query, plan, doc), nil
 	}
 
 	// ─── Textual Query (Lookup/Plugin) ─────────────────────────────
-	plan.Intent = SearchIntentLookup
 
 	// 1) Try source plugins
+	var (
+		partial       *SearchDocument
+		partialSource string
+		partialNotes  map[string]string
+	)
 	if c.plugins != nil {
-		if doc, sourceName, err := c.searchViaPlugins(ctx, query); err == nil && doc != nil {
+		doc, sourceName, notes, err := c.searchViaPlugins(ctx, query, page)
+		if err == nil && doc != nil {
 			plan.Intent = SearchIntentPlugin
 			plan.Source = sourceName
+			plan.annotate(notes)
+
+			return newSearchResult(query, plan, doc), nil
+		}
 
-			return &SearchResult{
-				Query:           query,
-				Plan:            plan,
-				PrimaryDocument: doc,
-			}, nil
+		// Strict plugin validation failures are surfaced, not masked
+		// by the Wikipedia fallback.
+		var verr *plugins.ValidationError
+		if errors.As(err, &verr) {
+			return nil, fmt.Errorf("aether: %w", err)
 		}
+
+		// A plugin cut short by the context may still have delivered
+		// a document; keep it in case the fallback cannot finish.
+		partial, partialSource, partialNotes = doc, sourceName, notes
 	}
 
-	// 2) Fallback: Wikipedia Summary
-	doc, err := c.searchViaWikipedia(ctx, query)
-	if err != nil {
-		return nil, err
+	// 2) Fallback: Wikipedia Summary (pointless once ctx is done)
+	err := ctx.Err()
+	if err == nil {
+		var doc *SearchDocument
+		doc, err = c.searchViaWikipedia(ctx, query)
+		if err == nil {
+			plan.Source = "wikipedia"
+			return newSearchResult(query, plan, doc), nil
+		}
+		if ctx.Err() == nil && partial == nil {
+			return nil, err
+		}
+	}
+
+	// 3) The fallback failed or the context expired: hand back what
+	// was gathered.
+	if partial != nil {
+		plan.Intent = SearchIntentPlugin
+		plan.Source = partialSource
+		plan.annotate(partialNotes)
+	}
+	res := newSearchResult(query, plan, partial)
+	res.Partial = true
+	if ctx.Err() == nil {
+		return res, err
 	}
-	plan.Source = "wikipedia"
+	return res, fmt.Errorf("aether: search incomplete: %w", ctx.Err())
+}
+
+// annotate merges plugin plan annotations into p.Metadata.
+func (p *SearchPlan) annotate(notes map[string]string) {
+	if len(notes) == 0 {
+		return
+	}
+	if p.Metadata == nil {
+		p.Metadata = make(map[string]string, len(notes))
+	}
+	for k, v := range notes {
+		p.Metadata[k] = v
+	}
+}
 
-	return &SearchResult{
+// newSearchResult assembles a SearchResult, lifting the cache status
+// recorded in the primary document's metadata into typed fields.
+func newSearchResult(query string, plan SearchPlan, doc *SearchDocument) *SearchResult {
+	res := &SearchResult{
 		Query:           query,
 		Plan:            plan,
 		PrimaryDocument: doc,
-	}, nil
+	}
+	if doc != nil && doc.Metadata["from_cache"] == "true" {
+		res.FromCache = true
+		if secs, err := strconv.Atoi(doc.Metadata["cache_age_seconds"]); err == nil {
+			res.CacheAge = time.Duration(secs) * time.Second
+		}
+	}
+	return res
 }
 
 //
@@ -166,37 +320,55 @@ func (c *Client) Search(ctx context.Context, query string) (*SearchResult, error
 //
 
 func (c *Client) searchURL(ctx context.Context, plan SearchPlan) (*SearchDocument, error) {
-	body, headers, err := c.FetchRaw(ctx, plan.URL)
+	if c.fetcher == nil {
+		return nil, fmt.Errorf("aether: client is not initialized")
+	}
+	reqHeaders := http.Header{"Accept": {c.acceptFor(plan.Target)}}
+	resp, err := c.fetcher.Fetch(ctx, plan.URL, reqHeaders)
 	if err != nil {
 		return nil, err
 	}
+	body, headers := resp.Body, resp.Header
 
 	contentType := classifyContentType(headers)
 	textBody := string(body)
 
-	kind := SearchDocumentKindUnknown
-	switch {
-	case strings.Contains(contentType, "html"):
-		kind = SearchDocumentKindHTML
-	case strings.Contains(contentType, "json"):
-		kind = SearchDocumentKindJSON
-	case strings.HasPrefix(contentType, "text/"):
-		kind = SearchDocumentKindText
-	default:
-		kind = SearchDocumentKindBinary
-	}
+	kind := c.ClassifyContentType(contentType)
 
 	metadata := map[string]string{
 		"content_type": contentType,
 		"source":       "direct_fetch",
 	}
+	if lang := contentLanguage(headers); lang != "" {
+		metadata["content_language"] = lang
+	}
+	fromCache, cacheAge := hclient.CacheStatus(headers)
+	setCacheMetadata(metadata, fromCache, cacheAge)
+
+	// For HTML pages, surface <title> and OpenGraph / Twitter Card
+	// metadata so link previews have a title and image to work with.
+	title := ""
+	if kind == SearchDocumentKindHTML {
+		_, end := trace.Start(c.tracer(), ctx, trace.SpanExtract)
+		if doc, err := ihtml.ParseDocument(body); err == nil {
+			social := ihtml.SocialMeta(ihtml.ExtractMeta(doc))
+			for k, v := range social {
+				metadata[k] = v
+			}
+			title = social["og_title"]
+			if title == "" {
+				title = ihtml.ExtractTitle(doc)
+			}
+		}
+		end()
+	}
 
-	excerpt := buildExcerpt(textBody, 320)
+	excerpt := Excerpt(textBody, 320)
 
 	return &SearchDocument{
 		URL:      plan.URL,
 		Kind:     kind,
-		Title:    "",
+		Title:    title,
 		Excerpt:  excerpt,
 		Content:  textBody,
 		Metadata: metadata,
@@ -209,12 +381,22 @@ func (c *Client) searchURL(ctx context.Context, plan SearchPlan) (*SearchDocumen
 // ────────────────────────────────────────────────
 //
 
-// searchViaPlugins tries registered SourcePlugins.
-func (c *Client) searchViaPlugins(ctx context.Context, query string) (*SearchDocument, string, error) {
+// searchViaPlugins tries registered SourcePlugins. When page is non-nil
+// and a plugin implements PagedSourcePlugin, the window is passed through.
+// The plan annotations of the answering plugin are returned with its
+// document.
+func (c *Client) searchViaPlugins(ctx context.Context, query string, page *searchPage) (*SearchDocument, string, map[string]string, error) {
 	if c.plugins == nil {
-		return nil, "", fmt.Errorf("no plugin registry available")
+		return nil, "", nil, fmt.Errorf("no plugin registry available")
 	}
 
+	var (
+		partial      *SearchDocument
+		partialName  string
+		partialNotes map[string]string
+		partialErr   error
+	)
+
 	names := c.plugins.ListSources()
 	for _, name := range names {
 		p := c.plugins.GetSource(name)
@@ -222,26 +404,92 @@ func (c *Client) searchViaPlugins(ctx context.Context, query string) (*SearchDoc
 			continue
 		}
 
-		doc, err := p.Fetch(ctx, query)
-		if err != nil || doc == nil {
+		sd, notes, err := c.searchViaPlugin(ctx, name, p, query, page)
+		if err != nil {
+			var verr *plugins.ValidationError
+			if errors.As(err, &verr) {
+				return nil, name, nil, err
+			}
+			if sd != nil && partial == nil {
+				partial, partialName, partialNotes, partialErr = sd, name, notes, err
+			}
+			if ctx.Err() != nil {
+				break
+			}
 			continue
 		}
-
-		sd := searchDocumentFromPluginDocument(doc)
 		if sd == nil {
 			continue
 		}
 
-		// Annotate metadata with source plugin
-		if sd.Metadata == nil {
-			sd.Metadata = map[string]string{}
-		}
-		sd.Metadata["aether.source_plugin"] = name
+		return sd, name, notes, nil
+	}
+
+	if partial != nil {
+		return partial, partialName, partialNotes, fmt.Errorf("source %q incomplete: %w", partialName, partialErr)
+	}
+	return nil, "", nil, fmt.Errorf("no source plugin produced a result")
+}
 
-		return sd, name, nil
+// searchViaPlugin queries a single SourcePlugin and converts its answer.
+// A nil document with a nil error means the plugin had nothing to offer;
+// a *plugins.ValidationError is returned when strict validation rejects
+// the plugin's document. When the plugin returned a document together
+// with an error — cut short by the context, or reporting an incomplete
+// answer — both the document (marked "aether.partial") and the error are
+// returned. Plan annotations are returned only alongside a document.
+func (c *Client) searchViaPlugin(ctx context.Context, name string, p plugins.SourcePlugin, query string, page *searchPage) (*SearchDocument, map[string]string, error) {
+	var (
+		doc   *plugins.Document
+		notes map[string]string
+		err   error
+		paged bool
+	)
+	if pp, ok := p.(plugins.PagedSourcePluginWithPlan); ok && page != nil {
+		doc, notes, err = pp.FetchPageWithPlan(ctx, query, page.Offset, page.Limit)
+		paged = true
+	} else if pp, ok := p.(plugins.PagedSourcePlugin); ok && page != nil {
+		doc, err = pp.FetchPage(ctx, query, page.Offset, page.Limit)
+		paged = true
+	} else if wp, ok := p.(plugins.SourcePluginWithPlan); ok {
+		doc, notes, err = wp.FetchWithPlan(ctx, query)
+	} else {
+		doc, err = p.Fetch(ctx, query)
+	}
+	// A document returned together with an error is the plugin's
+	// partial answer; an error without a document is a plain failure.
+	cut := err != nil && doc != nil
+	if err != nil && !cut {
+		return nil, nil, err
+	}
+	if doc == nil {
+		return nil, nil, nil
+	}
+	if verr := c.validatePluginDocument(name, doc); verr != nil {
+		return nil, nil, verr
 	}
 
-	return nil, "", fmt.Errorf("no source plugin produced a result")
+	sd := searchDocumentFromPluginDocument(doc)
+	if sd == nil {
+		return nil, nil, err
+	}
+	if sd.Kind == SearchDocumentKindUnknown && doc.Metadata["content_type"] != "" {
+		sd.Kind = c.ClassifyContentType(doc.Metadata["content_type"])
+	}
+
+	// Annotate metadata with source plugin
+	if sd.Metadata == nil {
+		sd.Metadata = map[string]string{}
+	}
+	sd.Metadata["aether.source_plugin"] = name
+	if paged {
+		sd.Metadata["aether.offset"] = strconv.Itoa(page.Offset)
+		sd.Metadata["aether.limit"] = strconv.Itoa(page.Limit)
+	}
+	if cut {
+		sd.Metadata["aether.partial"] = "true"
+	}
+	return sd, notes, err
 }
 
 // Convert plugins.Document → SearchDocument.
@@ -279,7 +527,7 @@ func searchDocumentFromPluginDocument(doc *plugins.Document) *SearchDocument {
 
 	excerpt := doc.Excerpt
 	if strings.TrimSpace(excerpt) == "" {
-		excerpt = buildExcerpt(doc.Content, 320)
+		excerpt = Excerpt(doc.Content, 320)
 	}
 
 	return &SearchDocument{
@@ -312,10 +560,12 @@ func (c *Client) searchViaWikipedia(ctx context.Context, query string) (*SearchD
 		"lang":     summary.Language,
 		"page_url": summary.URL,
 	}
+	setCacheMetadata(meta, summary.FromCache, summary.CacheAge)
+	c.openapi.StampFreshness(meta, summary.FetchedAt, summary.CacheTTL)
 
 	excerpt := summary.Description
 	if strings.TrimSpace(excerpt) == "" {
-		excerpt = buildExcerpt(summary.Extract, 320)
+		excerpt = Excerpt(summary.Extract, 320)
 	}
 
 	return &SearchDocument{
@@ -334,6 +584,14 @@ func (c *Client) searchViaWikipedia(ctx context.Context, query string) (*SearchD
 // ────────────────────────────────────────────────
 //
 
+// setCacheMetadata records cache provenance on document metadata.
+func setCacheMetadata(meta map[string]string, fromCache bool, age time.Duration) {
+	meta["from_cache"] = strconv.FormatBool(fromCache)
+	if fromCache {
+		meta["cache_age_seconds"] = strconv.Itoa(int(age / time.Second))
+	}
+}
+
 func isProbablyURL(q string) bool {
 	if strings.HasPrefix(q, "http://") || strings.HasPrefix(q, "https://") {
 		u, err := url.Parse(q)
@@ -353,39 +611,26 @@ func classifyContentType(h http.Header) string {
 	return strings.ToLower(ct)
 }
 
-func buildExcerpt(body string, maxLen int) string {
-	body = strings.TrimSpace(body)
-	if body == "" {
-		return ""
-	}
-
-	body = collapseWhitespace(body)
-
-	if len(body) <= maxLen {
-		return body
-	}
-	if maxLen <= 3 {
-		return body[:maxLen]
-	}
-
-	return body[:maxLen-3] + "..."
-}
-
-func collapseWhitespace(s string) string {
-	var b strings.Builder
-	b.Grow(len(s))
-	space := false
-
-	for _, r := range s {
-		if r == ' ' || r == '\n' || r == '\t' || r == '\r' {
-			if !space {
-				b.WriteRune(' ')
-				space = true
+// contentLanguage returns the response's Content-Language as a
+// comma-separated list of language tags ("de-DE, en"), or "" when the
+// header is absent.
+func contentLanguage(h http.Header) string {
+	var tags []string
+	for _, v := range h.Values("Content-Language") {
+		for _, tag := range strings.Split(v, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				tags = append(tags, tag)
 			}
-		} else {
-			b.WriteRune(r)
-			space = false
 		}
 	}
-	return strings.TrimSpace(b.String())
+	return strings.Join(tags, ", ")
+}
+
+// Excerpt collapses whitespace in text and shortens it to at most maxLen
+// runes, ending with "…" when anything was cut. It never splits a
+// multi-byte character, so the result is valid UTF-8 whenever text is.
+// maxLen <= 0 returns the whole collapsed text. Search and the
+// normalization pipeline build their excerpts with the same rules.
+func Excerpt(text string, maxLen int) string {
+	return normalize.Excerpt(text, maxLen)
 }
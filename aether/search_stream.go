@@ -0,0 +1,172 @@
+// aether/search_stream.go
+//
+// Incremental, multi-source search.
+//
+// SearchStream is the streaming counterpart of Search. Where Search
+// consults source plugins one after another and returns the first
+// answer, SearchStream queries every registered source plugin at once
+// and delivers each document as soon as its source responds, so a slow
+// source never holds back a fast one. It is intended for live UIs that
+// want to show partial results while other sources are still working.
+//
+// SearchAll collects the whole stream and merges it into one normalized
+// document whose sections are ranked by relevance to the query.
+
+package aether
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/Nibir1/Aether/internal/normalize"
+)
+
+// SearchStream runs query against all sources concurrently and emits
+// each resulting document on the first channel in order of completion.
+//
+// URL queries produce a single direct-fetch document. Free-text queries
+// fan out to every registered SourcePlugin; when none of them yields a
+// document, the Wikipedia fallback is tried, just as Search would.
+//
+// Per-source failures are reported on the error channel and do not stop
+// the stream. Both channels are closed once every source has finished
+// or ctx is cancelled; callers should drain the document channel and
+// then read the (buffered) error channel.
+func (c *Client) SearchStream(ctx context.Context, query string) (<-chan *SearchDocument, <-chan error) {
+	docs := make(chan *SearchDocument)
+
+	if c == nil {
+		errs := make(chan error, 1)
+		errs <- fmt.Errorf("aether: nil client in SearchStream")
+		close(docs)
+		close(errs)
+		return docs, errs
+	}
+
+	query = strings.TrimSpace(query)
+	plan := c.PlanSearch(query)
+
+	var names []string
+	if plan.Intent == SearchIntentLookup && c.plugins != nil {
+		names = c.plugins.ListSources()
+	}
+	// One slot per source plus one for the fallback or URL fetch, so
+	// no sender ever blocks on an unread error.
+	errs := make(chan error, len(names)+1)
+
+	go func() {
+		defer close(errs)
+		defer close(docs)
+
+		if query == "" {
+			errs <- fmt.Errorf("aether: empty query")
+			return
+		}
+
+		if plan.Intent == SearchIntentURL {
+			doc, err := c.searchURL(ctx, plan)
+			if err != nil {
+				errs <- err
+				return
+			}
+			sendSearchDocument(ctx, docs, doc)
+			return
+		}
+
+		var (
+			wg      sync.WaitGroup
+			mu      sync.Mutex
+			emitted int
+		)
+		for _, name := range names {
+			p := c.plugins.GetSource(name)
+			if p == nil {
+				continue
+			}
+			wg.Add(1)
+			go func(name string) {
+				defer wg.Done()
+				sd, _, err := c.searchViaPlugin(ctx, name, p, query, nil)
+				if err != nil {
+					errs <- fmt.Errorf("aether: source %q: %w", name, err)
+					return
+				}
+				if sd == nil {
+					return
+				}
+				if sendSearchDocument(ctx, docs, sd) {
+					mu.Lock()
+					emitted++
+					mu.Unlock()
+				}
+			}(name)
+		}
+		wg.Wait()
+
+		if emitted > 0 || ctx.Err() != nil {
+			return
+		}
+
+		doc, err := c.searchViaWikipedia(ctx, query)
+		if err != nil {
+			errs <- err
+			return
+		}
+		sendSearchDocument(ctx, docs, doc)
+	}()
+
+	return docs, errs
+}
+
+// SearchAll queries every source like SearchStream, waits for all of
+// them, and merges their documents into a single NormalizedDocument.
+//
+// Each source contributes one body section tagged with its "provenance".
+// Sections are ordered by a TF-IDF relevance score against the query
+// terms, most relevant first, and carry the score in Meta["relevance"].
+// TransformPlugins are applied to the merged document.
+//
+// Per-source errors are ignored as long as at least one source answered;
+// otherwise they are returned joined.
+func (c *Client) SearchAll(ctx context.Context, query string) (*NormalizedDocument, error) {
+	if c == nil {
+		return nil, fmt.Errorf("aether: nil client in SearchAll")
+	}
+
+	docs, errs := c.SearchStream(ctx, query)
+
+	var collected []*normalize.SearchDocument
+	for d := range docs {
+		collected = append(collected, convertPrimaryDocument(d))
+	}
+	var failures []error
+	for err := range errs {
+		failures = append(failures, err)
+	}
+
+	if len(collected) == 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("aether: %w", err)
+		}
+		if len(failures) > 0 {
+			return nil, errors.Join(failures...)
+		}
+		return nil, fmt.Errorf("aether: no source returned a result for %q", query)
+	}
+
+	return c.applyTransformPlugins(normalize.Federated(query, collected)), nil
+}
+
+// sendSearchDocument delivers doc unless ctx is cancelled first and
+// reports whether it was delivered.
+func sendSearchDocument(ctx context.Context, docs chan<- *SearchDocument, doc *SearchDocument) bool {
+	select {
+	case docs <- doc:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
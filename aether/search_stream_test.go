@@ -0,0 +1,146 @@
+// aether/search_stream_test.go
+package aether
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Nibir1/Aether/plugins"
+)
+
+// delayedSource answers after a fixed delay.
+type delayedSource struct {
+	name  string
+	delay time.Duration
+}
+
+func (s delayedSource) Name() string           { return s.name }
+func (s delayedSource) Description() string    { return "test delayed source" }
+func (s delayedSource) Capabilities() []string { return nil }
+
+func (s delayedSource) Fetch(ctx context.Context, query string) (*plugins.Document, error) {
+	select {
+	case <-time.After(s.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return &plugins.Document{Title: s.name, Content: s.name + " body"}, nil
+}
+
+func TestSearchStream_OrdersByCompletion(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	// Register the slow source first so registration order and
+	// completion order disagree.
+	if err := cli.RegisterSourcePlugin(delayedSource{name: "slow", delay: 300 * time.Millisecond}); err != nil {
+		t.Fatalf("RegisterSourcePlugin error: %v", err)
+	}
+	if err := cli.RegisterSourcePlugin(delayedSource{name: "fast", delay: 10 * time.Millisecond}); err != nil {
+		t.Fatalf("RegisterSourcePlugin error: %v", err)
+	}
+
+	start := time.Now()
+	docs, errs := cli.SearchStream(context.Background(), "anything")
+
+	var got []string
+	for doc := range docs {
+		if len(got) == 0 {
+			if elapsed := time.Since(start); elapsed >= 300*time.Millisecond {
+				t.Fatalf("first document delayed by slow source: %v", elapsed)
+			}
+		}
+		got = append(got, doc.Metadata["aether.source_plugin"])
+	}
+	for err := range errs {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(got) != 2 || got[0] != "fast" || got[1] != "slow" {
+		t.Fatalf("completion order: got %v, want [fast slow]", got)
+	}
+}
+
+func TestSearchStream_ContextCancelClosesChannels(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	if err := cli.RegisterSourcePlugin(delayedSource{name: "slow", delay: time.Minute}); err != nil {
+		t.Fatalf("RegisterSourcePlugin error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	docs, errs := cli.SearchStream(ctx, "anything")
+	cancel()
+
+	done := make(chan struct{})
+	go func() {
+		for range docs {
+		}
+		for range errs {
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatalf("channels not closed after cancel")
+	}
+}
+
+// textSource answers immediately with fixed content.
+type textSource struct {
+	name, title, content string
+}
+
+func (s textSource) Name() string           { return s.name }
+func (s textSource) Description() string    { return "test text source" }
+func (s textSource) Capabilities() []string { return nil }
+
+func (s textSource) Fetch(ctx context.Context, query string) (*plugins.Document, error) {
+	return &plugins.Document{Title: s.title, Content: s.content}, nil
+}
+
+func TestSearchAll_RanksByRelevance(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	// The less relevant source is registered first.
+	if err := cli.RegisterSourcePlugin(textSource{
+		name:    "recipes",
+		title:   "Weeknight pasta",
+		content: "Boil the pasta, fry garlic in olive oil and toss with parmesan. Mentions go once.",
+	}); err != nil {
+		t.Fatalf("RegisterSourcePlugin error: %v", err)
+	}
+	if err := cli.RegisterSourcePlugin(textSource{
+		name:    "godocs",
+		title:   "Go concurrency patterns",
+		content: "Go concurrency uses goroutines and channels. Concurrency in Go favours communication over shared memory.",
+	}); err != nil {
+		t.Fatalf("RegisterSourcePlugin error: %v", err)
+	}
+
+	doc, err := cli.SearchAll(context.Background(), "go concurrency")
+	if err != nil {
+		t.Fatalf("SearchAll error: %v", err)
+	}
+	if len(doc.Sections) != 2 {
+		t.Fatalf("sections: got %d, want 2", len(doc.Sections))
+	}
+
+	first, second := doc.Sections[0], doc.Sections[1]
+	if got := first.Meta["provenance"]; got != "godocs" {
+		t.Fatalf("most relevant section: got %q, want %q", got, "godocs")
+	}
+	if first.Meta["relevance"] <= second.Meta["relevance"] {
+		t.Fatalf("relevance not descending: %q then %q", first.Meta["relevance"], second.Meta["relevance"])
+	}
+	if got := doc.Metadata["sources"]; got != "godocs,recipes" {
+		t.Fatalf("sources: got %q, want %q", got, "godocs,recipes")
+	}
+}
@@ -0,0 +1,426 @@
+// aether/search_test.go
+package aether
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Nibir1/Aether/plugins"
+)
+
+// pagedSource returns different content depending on the requested offset.
+type pagedSource struct{}
+
+func (pagedSource) Name() string           { return "paged" }
+func (pagedSource) Description() string    { return "test paged source" }
+func (pagedSource) Capabilities() []string { return nil }
+
+func (pagedSource) Fetch(ctx context.Context, query string) (*plugins.Document, error) {
+	return &plugins.Document{Title: "unpaged", Content: "unpaged"}, nil
+}
+
+func (pagedSource) FetchPage(ctx context.Context, query string, offset, limit int) (*plugins.Document, error) {
+	return &plugins.Document{
+		Title:   fmt.Sprintf("page %d", offset/limit),
+		Content: fmt.Sprintf("items %d-%d", offset, offset+limit-1),
+	}, nil
+}
+
+// plainSource does not implement PagedSourcePlugin.
+type plainSource struct{}
+
+func (plainSource) Name() string           { return "plain" }
+func (plainSource) Description() string    { return "test plain source" }
+func (plainSource) Capabilities() []string { return nil }
+
+func (plainSource) Fetch(ctx context.Context, query string) (*plugins.Document, error) {
+	return &plugins.Document{Title: "plain", Content: "plain body"}, nil
+}
+
+func TestSearchPaged_PassesWindowToPagedPlugin(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	if err := cli.RegisterSourcePlugin(pagedSource{}); err != nil {
+		t.Fatalf("RegisterSourcePlugin error: %v", err)
+	}
+
+	first, err := cli.SearchPaged(context.Background(), "anything", 0, 10)
+	if err != nil {
+		t.Fatalf("SearchPaged error: %v", err)
+	}
+	second, err := cli.SearchPaged(context.Background(), "anything", 10, 10)
+	if err != nil {
+		t.Fatalf("SearchPaged error: %v", err)
+	}
+
+	if got := first.PrimaryDocument.Content; got != "items 0-9" {
+		t.Fatalf("first page: got %q, want %q", got, "items 0-9")
+	}
+	if got := second.PrimaryDocument.Content; got != "items 10-19" {
+		t.Fatalf("second page: got %q, want %q", got, "items 10-19")
+	}
+	if got := second.PrimaryDocument.Metadata["aether.offset"]; got != "10" {
+		t.Fatalf("aether.offset: got %q, want %q", got, "10")
+	}
+
+	// Plain Search keeps using Fetch.
+	plain, err := cli.Search(context.Background(), "anything")
+	if err != nil {
+		t.Fatalf("Search error: %v", err)
+	}
+	if got := plain.PrimaryDocument.Content; got != "unpaged" {
+		t.Fatalf("Search: got %q, want %q", got, "unpaged")
+	}
+}
+
+func TestSearchPaged_NonPagedPluginUnchanged(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	if err := cli.RegisterSourcePlugin(plainSource{}); err != nil {
+		t.Fatalf("RegisterSourcePlugin error: %v", err)
+	}
+
+	res, err := cli.SearchPaged(context.Background(), "anything", 20, 5)
+	if err != nil {
+		t.Fatalf("SearchPaged error: %v", err)
+	}
+	if got := res.PrimaryDocument.Content; got != "plain body" {
+		t.Fatalf("got %q, want %q", got, "plain body")
+	}
+	if _, ok := res.PrimaryDocument.Metadata["aether.offset"]; ok {
+		t.Fatal("non-paged plugin result should not carry aether.offset")
+	}
+}
+
+func TestSearch_FromCacheFlipsOnSecondCall(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte("<html><head><title>Cached</title></head><body>hi</body></html>"))
+	}))
+	defer srv.Close()
+
+	cli, err := NewClient(WithSharedCache(NewMemoryCache(16, time.Minute)))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	first, err := cli.Search(context.Background(), srv.URL+"/page")
+	if err != nil {
+		t.Fatalf("first Search error: %v", err)
+	}
+	if first.FromCache || first.PrimaryDocument.Metadata["from_cache"] != "false" {
+		t.Fatalf("first search: FromCache=%v, metadata=%q", first.FromCache, first.PrimaryDocument.Metadata["from_cache"])
+	}
+
+	second, err := cli.Search(context.Background(), srv.URL+"/page")
+	if err != nil {
+		t.Fatalf("second Search error: %v", err)
+	}
+	if !second.FromCache || second.PrimaryDocument.Metadata["from_cache"] != "true" {
+		t.Fatalf("second search: FromCache=%v, metadata=%q", second.FromCache, second.PrimaryDocument.Metadata["from_cache"])
+	}
+	if _, ok := second.PrimaryDocument.Metadata["cache_age_seconds"]; !ok {
+		t.Fatal("expected cache_age_seconds on cached result")
+	}
+	if second.PrimaryDocument.Kind != SearchDocumentKindHTML || second.PrimaryDocument.Title != "Cached" {
+		t.Fatalf("cached document: kind %q, title %q", second.PrimaryDocument.Kind, second.PrimaryDocument.Title)
+	}
+}
+
+func TestPlanSearch_SourceOrderWithoutIO(t *testing.T) {
+	var hits int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+	}))
+	defer srv.Close()
+
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	if err := cli.RegisterSourcePlugin(plainSource{}); err != nil {
+		t.Fatalf("RegisterSourcePlugin error: %v", err)
+	}
+	if err := cli.RegisterSourcePlugin(pagedSource{}); err != nil {
+		t.Fatalf("RegisterSourcePlugin error: %v", err)
+	}
+
+	plan := cli.PlanSearch("  golang generics ")
+	if plan.Intent != SearchIntentLookup || plan.RawQuery != "golang generics" {
+		t.Fatalf("plan: got intent %q query %q", plan.Intent, plan.RawQuery)
+	}
+	if got, want := fmt.Sprint(plan.Sources), "[paged plain wikipedia]"; got != want {
+		t.Fatalf("Sources: got %s, want %s", got, want)
+	}
+
+	urlPlan := cli.PlanSearch(srv.URL + "/page")
+	if urlPlan.Intent != SearchIntentURL || fmt.Sprint(urlPlan.Sources) != "[direct_fetch]" {
+		t.Fatalf("URL plan: got intent %q sources %v", urlPlan.Intent, urlPlan.Sources)
+	}
+	if hits != 0 {
+		t.Fatalf("PlanSearch performed %d requests, want 0", hits)
+	}
+}
+
+func TestExcerpt_MultibyteStaysValidUTF8(t *testing.T) {
+	text := strings.Repeat("日本語のテキスト", 10)
+
+	for _, n := range []int{1, 2, 5, 17, 40} {
+		got := Excerpt(text, n)
+		if !utf8.ValidString(got) {
+			t.Fatalf("Excerpt(%d): invalid UTF-8 %q", n, got)
+		}
+		if c := utf8.RuneCountInString(got); c > n {
+			t.Fatalf("Excerpt(%d): got %d runes", n, c)
+		}
+	}
+
+	if got, want := Excerpt("日本語のテキスト", 5), "日本語の…"; got != want {
+		t.Fatalf("Excerpt: got %q, want %q", got, want)
+	}
+	if got, want := Excerpt("  short\n text ", 50), "short text"; got != want {
+		t.Fatalf("Excerpt: got %q, want %q", got, want)
+	}
+}
+
+// partialSource returns what it has gathered once the context expires.
+type partialSource struct{}
+
+func (partialSource) Name() string           { return "partial" }
+func (partialSource) Description() string    { return "test source cut short by its context" }
+func (partialSource) Capabilities() []string { return nil }
+
+func (partialSource) Fetch(ctx context.Context, query string) (*plugins.Document, error) {
+	<-ctx.Done()
+	return &plugins.Document{Title: "half done", Content: "first results"}, ctx.Err()
+}
+
+func TestSearch_ReturnsPartialResultOnDeadline(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	if err := cli.RegisterSourcePlugin(partialSource{}); err != nil {
+		t.Fatalf("RegisterSourcePlugin error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	res, err := cli.Search(ctx, "slow topic")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error: got %v, want deadline exceeded", err)
+	}
+	if res == nil || !res.Partial {
+		t.Fatalf("expected a partial result, got %+v", res)
+	}
+	if res.PrimaryDocument == nil || res.PrimaryDocument.Title != "half done" {
+		t.Fatalf("partial document lost: %+v", res.PrimaryDocument)
+	}
+	if res.PrimaryDocument.Metadata["aether.partial"] != "true" {
+		t.Fatalf("partial marker missing: %v", res.PrimaryDocument.Metadata)
+	}
+	if res.Plan.Source != "partial" {
+		t.Fatalf("plan source: got %q, want %q", res.Plan.Source, "partial")
+	}
+}
+
+// promptSource answers at once with a document and an error saying the
+// answer is incomplete, so Search goes on to the Wikipedia fallback.
+type promptSource struct{}
+
+func (promptSource) Name() string           { return "prompt" }
+func (promptSource) Description() string    { return "test source with an incomplete answer" }
+func (promptSource) Capabilities() []string { return nil }
+
+func (promptSource) Fetch(ctx context.Context, query string) (*plugins.Document, error) {
+	return &plugins.Document{Title: "first page", Content: "page one"}, errors.New("page two unavailable")
+}
+
+// blockingTransport holds every request until its context is done.
+type blockingTransport struct{}
+
+func (blockingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	<-r.Context().Done()
+	return nil, r.Context().Err()
+}
+
+func TestSearch_ReturnsPluginDocumentWhenFallbackTimesOut(t *testing.T) {
+	cli, err := NewClient(
+		WithTransport(blockingTransport{}),
+		WithRobotsOverride("en.wikipedia.org"),
+	)
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	if err := cli.RegisterSourcePlugin(promptSource{}); err != nil {
+		t.Fatalf("RegisterSourcePlugin error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	res, err := cli.Search(ctx, "slow fallback")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error: got %v, want deadline exceeded", err)
+	}
+	if res == nil || !res.Partial {
+		t.Fatalf("expected a partial result, got %+v", res)
+	}
+	if res.PrimaryDocument == nil || res.PrimaryDocument.Title != "first page" {
+		t.Fatalf("plugin document lost: %+v", res.PrimaryDocument)
+	}
+	if res.Plan.Source != "prompt" {
+		t.Fatalf("plan source: got %q, want %q", res.Plan.Source, "prompt")
+	}
+}
+
+// notFoundTransport answers every request with a 404.
+type notFoundTransport struct{}
+
+func (notFoundTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	return &http.Response{
+		StatusCode: http.StatusNotFound,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader("")),
+		Request:    r,
+	}, nil
+}
+
+func TestSearch_ReturnsPluginDocumentWhenFallbackFails(t *testing.T) {
+	cli, err := NewClient(
+		WithTransport(notFoundTransport{}),
+		WithRobotsOverride("en.wikipedia.org"),
+	)
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	if err := cli.RegisterSourcePlugin(promptSource{}); err != nil {
+		t.Fatalf("RegisterSourcePlugin error: %v", err)
+	}
+
+	res, err := cli.Search(context.Background(), "missing fallback")
+	if err == nil {
+		t.Fatal("expected the fallback error")
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		t.Fatalf("error: got %v, want the fallback error", err)
+	}
+	if res == nil || !res.Partial {
+		t.Fatalf("expected a partial result, got %+v", res)
+	}
+	if res.PrimaryDocument == nil || res.PrimaryDocument.Title != "first page" {
+		t.Fatalf("plugin document lost: %+v", res.PrimaryDocument)
+	}
+	if res.Plan.Source != "prompt" {
+		t.Fatalf("plan source: got %q, want %q", res.Plan.Source, "prompt")
+	}
+}
+
+func TestWithAcceptLanguage_SentAndContentLanguageRecorded(t *testing.T) {
+	var got atomic.Value
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		got.Store(r.Header.Get("Accept-Language"))
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Content-Language", "nb-NO")
+		w.Write([]byte("<html><head><title>Hei</title></head><body>Hei verden</body></html>"))
+	}))
+	defer srv.Close()
+
+	cli, err := NewClient(WithAcceptLanguage("nb-NO", " ", "en;q=0.5"))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	res, err := cli.Search(context.Background(), srv.URL+"/page")
+	if err != nil {
+		t.Fatalf("Search error: %v", err)
+	}
+	if h, _ := got.Load().(string); h != "nb-NO, en;q=0.5" {
+		t.Fatalf("Accept-Language: got %q, want %q", h, "nb-NO, en;q=0.5")
+	}
+	if lang := res.PrimaryDocument.Metadata["content_language"]; lang != "nb-NO" {
+		t.Fatalf("content_language: got %q, want %q", lang, "nb-NO")
+	}
+
+	// Normalization keeps content_language and also fills the canonical key.
+	doc := cli.NormalizeSearchResult(res)
+	if lang := doc.Metadata["content_language"]; lang != "nb-NO" {
+		t.Fatalf("normalized content_language: got %q, want %q", lang, "nb-NO")
+	}
+	if lang := doc.Metadata["language"]; lang != "nb-NO" {
+		t.Fatalf("normalized language: got %q, want %q", lang, "nb-NO")
+	}
+}
+
+func TestSearch_AcceptHeaderFollowsTargetKind(t *testing.T) {
+	var mu sync.Mutex
+	accepts := map[string]string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		mu.Lock()
+		accepts[r.URL.Path] = r.Header.Get("Accept")
+		mu.Unlock()
+		w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	cli, err := NewClient(WithAcceptHeader(SearchDocumentKindJSON, "application/vnd.api+json"))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	cases := []struct {
+		path   string
+		target SearchDocumentKind
+		accept string
+	}{
+		{"/blog/post", SearchDocumentKindHTML, defaultAcceptByKind[SearchDocumentKindHTML]},
+		{"/news/feed", SearchDocumentKindFeed, defaultAcceptByKind[SearchDocumentKindFeed]},
+		{"/index.atom", SearchDocumentKindFeed, defaultAcceptByKind[SearchDocumentKindFeed]},
+		{"/api/v1/items", SearchDocumentKindJSON, "application/vnd.api+json"},
+	}
+	for _, tc := range cases {
+		res, err := cli.Search(context.Background(), srv.URL+tc.path)
+		if err != nil {
+			t.Fatalf("Search(%s) error: %v", tc.path, err)
+		}
+		if res.Plan.Target != tc.target {
+			t.Fatalf("Plan.Target for %s: got %q, want %q", tc.path, res.Plan.Target, tc.target)
+		}
+		mu.Lock()
+		got := accepts[tc.path]
+		mu.Unlock()
+		if got != tc.accept {
+			t.Fatalf("Accept for %s: got %q, want %q", tc.path, got, tc.accept)
+		}
+	}
+	if !strings.HasPrefix(accepts["/blog/post"], "text/html") {
+		t.Fatalf("HTML Accept %q does not prefer text/html", accepts["/blog/post"])
+	}
+}
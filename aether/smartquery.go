@@ -12,6 +12,7 @@ package aether
 
 import (
 	"strings"
+	"unicode"
 
 	ismart "github.com/Nibir1/Aether/internal/smartquery"
 )
@@ -44,6 +45,17 @@ type SmartQueryPlan struct {
 	UseOpenAPIs     bool
 	UseFeeds        bool
 	UsePlugins      bool
+
+	// MatchedPlugins lists the registered source plugins whose declared
+	// capabilities match the query, with the capability tags that
+	// matched. It is empty when no plugin matches.
+	MatchedPlugins []PluginMatch
+}
+
+// PluginMatch explains why a source plugin was matched by SmartQuery.
+type PluginMatch struct {
+	Name         string
+	Capabilities []string
 }
 
 // SmartQuery analyzes a natural-language query and returns a routing plan.
@@ -82,7 +94,48 @@ func (c *Client) SmartQuery(query string) *SmartQueryPlan {
 		UseOpenAPIs:     route.UseOpenAPIs,
 		UseFeeds:        route.UseFeeds,
 		UsePlugins:      route.UsePlugins,
+		MatchedPlugins:  c.matchPlugins(internalClass.Intent, trimmed),
+	}
+}
+
+// matchPlugins returns the registered source plugins, in name order,
+// that declare a capability equal (ignoring case) to the classified
+// intent or to one of the query's words.
+func (c *Client) matchPlugins(intent ismart.Intent, query string) []PluginMatch {
+	if c.plugins == nil {
+		return nil
+	}
+
+	tags := map[string]struct{}{}
+	if intent != ismart.IntentUnknown {
+		tags[string(intent)] = struct{}{}
+	}
+	for _, w := range strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		tags[w] = struct{}{}
+	}
+
+	var out []PluginMatch
+	for _, name := range c.plugins.ListSources() {
+		p := c.plugins.GetSource(name)
+		if p == nil {
+			continue
+		}
+		var matched []string
+		seen := map[string]bool{}
+		for _, capability := range p.Capabilities() {
+			capability = strings.ToLower(strings.TrimSpace(capability))
+			if _, ok := tags[capability]; ok && !seen[capability] {
+				seen[capability] = true
+				matched = append(matched, capability)
+			}
+		}
+		if len(matched) > 0 {
+			out = append(out, PluginMatch{Name: name, Capabilities: matched})
+		}
 	}
+	return out
 }
 
 // mapIntent converts internal Intent (struct value) to public QueryIntent.
@@ -0,0 +1,32 @@
+// aether/smartquery_plugins_test.go
+package aether_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Nibir1/Aether/aether"
+	"github.com/Nibir1/Aether/plugins/examples/hn_plugin"
+)
+
+func TestSmartQuery_MatchedPlugins(t *testing.T) {
+	cli, err := aether.NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	if err := cli.RegisterSourcePlugin(hn_plugin.New(cli, 5)); err != nil {
+		t.Fatalf("RegisterSourcePlugin error: %v", err)
+	}
+
+	plan := cli.SmartQuery("hackernews front page")
+	if len(plan.MatchedPlugins) != 1 || plan.MatchedPlugins[0].Name != "hackernews" {
+		t.Fatalf("MatchedPlugins: got %+v, want the hackernews plugin", plan.MatchedPlugins)
+	}
+	if got := strings.Join(plan.MatchedPlugins[0].Capabilities, ","); got != "news,hackernews" {
+		t.Fatalf("matched capabilities: got %q, want %q", got, "news,hackernews")
+	}
+
+	if plan := cli.SmartQuery("weather in Paris tomorrow"); len(plan.MatchedPlugins) != 0 {
+		t.Fatalf("weather query: got %+v, want no matches", plan.MatchedPlugins)
+	}
+}
@@ -8,6 +8,8 @@
 //   • ToTOON(sr *SearchResult) *toon.Document
 //   • MarshalTOON(sr *SearchResult) ([]byte, error)
 //   • MarshalTOONPretty(sr *SearchResult) ([]byte, error)
+//   • MarshalTOONJSONL(sr *SearchResult) ([]byte, error)
+//   • MarshalSectionTOON(sec *model.Section) ([]byte, error)
 //
 // Pipeline:
 //   1. NormalizeSearchResult() → *model.Document
@@ -19,6 +21,7 @@ package aether
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/Nibir1/Aether/internal/model"
 	"github.com/Nibir1/Aether/internal/toon"
@@ -63,6 +66,31 @@ func (c *Client) MarshalTOONPretty(sr *SearchResult) ([]byte, error) {
 	return json.MarshalIndent(doc, "", "  ")
 }
 
+// MarshalTOONJSONL serializes a SearchResult into a single JSON Lines
+// record: the full TOON document as compact JSON on one line, ending in
+// "\n". Records can be appended to a log file or shipped as-is; read
+// them back with UnmarshalTOONJSONL.
+func (c *Client) MarshalTOONJSONL(sr *SearchResult) ([]byte, error) {
+	return c.ToTOON(sr).MarshalJSONL()
+}
+
+// MarshalTOONSummary serializes a summary-only TOON document for sr as
+// compact JSON: the title, the excerpt and one heading per section,
+// without body text or metadata. It is meant for cheap LLM prompts that
+// only need the outline of a result.
+func (c *Client) MarshalTOONSummary(sr *SearchResult) ([]byte, error) {
+	if c == nil || sr == nil {
+		return json.Marshal(&toon.Document{})
+	}
+	return json.Marshal(toon.FromModelSummary(c.NormalizeSearchResult(sr)))
+}
+
+// UnmarshalTOONJSONL parses one record written by MarshalTOONJSONL.
+// The trailing newline is optional; multi-line input is an error.
+func (c *Client) UnmarshalTOONJSONL(line []byte) (*toon.Document, error) {
+	return toon.UnmarshalJSONL(line)
+}
+
 //
 // ─────────────────────────────────────────────────────────────────────────────
 //                       DIRECT MODEL → TOON CONVERSIONS
@@ -79,6 +107,23 @@ func (c *Client) ToTOONFromModel(doc *model.Document) *toon.Document {
 	return toon.FromModel(doc)
 }
 
+// TOONConvertOptions limits ToTOONFromModelWithOptions: MaxSections
+// caps the sections converted and MaxTokens the length of the token
+// stream. Zero means no limit.
+type TOONConvertOptions = toon.FromModelOptions
+
+// ToTOONFromModelWithOptions converts doc like ToTOONFromModel but
+// applies the limits in opts during conversion, so converting a very
+// large document (a feed with thousands of items, say) never builds the
+// full token stream. A stream cut short ends in a "truncated" token whose
+// "omitted_sections" attribute counts the sections left out.
+func (c *Client) ToTOONFromModelWithOptions(doc *model.Document, opts TOONConvertOptions) *toon.Document {
+	if doc == nil {
+		return &toon.Document{}
+	}
+	return toon.FromModelWithOptions(doc, opts)
+}
+
 // MarshalTOONFromModel serializes a normalized model.Document into compact JSON.
 func (c *Client) MarshalTOONFromModel(doc *model.Document) ([]byte, error) {
 	tdoc := c.ToTOONFromModel(doc)
@@ -90,3 +135,76 @@ func (c *Client) MarshalTOONPrettyFromModel(doc *model.Document) ([]byte, error)
 	tdoc := c.ToTOONFromModel(doc)
 	return json.MarshalIndent(tdoc, "", "  ")
 }
+
+//
+// ─────────────────────────────────────────────────────────────────────────────
+//                             SECTION FRAGMENTS
+// ─────────────────────────────────────────────────────────────────────────────
+//
+
+// MarshalSectionTOON serializes a single section as a standalone TOON
+// fragment in compact JSON. The fragment's token stream runs from
+// SECTION_START to SECTION_END and uses the same tokens as
+// MarshalTOONFromModel; read it back with UnmarshalSectionTOON.
+func (c *Client) MarshalSectionTOON(sec *model.Section) ([]byte, error) {
+	if sec == nil {
+		return nil, fmt.Errorf("aether: nil section")
+	}
+	return json.Marshal(toon.FromSection(sec))
+}
+
+// UnmarshalSectionTOON parses a fragment written by MarshalSectionTOON
+// back into a section.
+func (c *Client) UnmarshalSectionTOON(data []byte) (*model.Section, error) {
+	var frag toon.Document
+	if err := json.Unmarshal(data, &frag); err != nil {
+		return nil, fmt.Errorf("aether: invalid TOON section: %w", err)
+	}
+	sec, err := toon.ToSection(&frag)
+	if err != nil {
+		return nil, fmt.Errorf("aether: %w", err)
+	}
+	return sec, nil
+}
+
+// MarshalSectionJSON serializes a single section as compact JSON with
+// the same field names the section has inside a marshaled
+// NormalizedDocument, so it decodes directly into a NormalizedSection.
+func (c *Client) MarshalSectionJSON(sec *model.Section) ([]byte, error) {
+	if sec == nil {
+		return nil, fmt.Errorf("aether: nil section")
+	}
+	return json.Marshal(sec)
+}
+
+//
+// ─────────────────────────────────────────────────────────────────────────────
+//                              TOKEN FILTERING
+// ─────────────────────────────────────────────────────────────────────────────
+//
+
+// TOONToken is the public alias for a single TOON token, so callers can
+// write their own FilterTOON predicates.
+type TOONToken = toon.Token
+
+// Predicates for FilterTOON.
+var (
+	// TOONKeepContent keeps only text/heading/title/excerpt tokens.
+	TOONKeepContent = toon.KeepContent
+
+	// TOONKeepContentAndBoundaries also keeps section start/end tokens.
+	TOONKeepContentAndBoundaries = toon.KeepContentAndBoundaries
+
+	// TOONDropMetadata removes META and DOCINFO tokens only.
+	TOONDropMetadata = toon.DropMetadata
+)
+
+// FilterTOON returns a copy of doc keeping only the tokens accepted by keep.
+// Section boundaries are always kept or dropped in matching pairs, so the
+// result remains a valid TOON stream.
+func FilterTOON(doc *toon.Document, keep func(TOONToken) bool) *toon.Document {
+	if doc == nil {
+		return &toon.Document{}
+	}
+	return toon.Filter(doc, keep)
+}
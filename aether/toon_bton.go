@@ -43,8 +43,17 @@ func (c *Client) MarshalBTON(sr *SearchResult) ([]byte, error) {
 // ─────────────────────────────────────────────────────────────────────────────
 //
 
+// ErrCorruptBTON is wrapped by UnmarshalBTON errors for truncated or
+// corrupt input; test for it with errors.Is.
+var ErrCorruptBTON = toon.ErrCorruptBTON
+
+// ErrChecksumBTON is wrapped by UnmarshalBTON errors when the stream's
+// checksum does not match its contents. It also matches ErrCorruptBTON.
+var ErrChecksumBTON = toon.ErrChecksumBTON
+
 // UnmarshalBTON parses BT0N bytes into a TOON Document.
-// If data is nil or empty, DecodeBTON handles it gracefully.
+// Input that is not BTON at all is rejected; truncated or corrupt BTON
+// returns an error wrapping ErrCorruptBTON, never a partial document.
 func (c *Client) UnmarshalBTON(data []byte) (*toon.Document, error) {
 	return toon.DecodeBTON(data)
 }
@@ -6,7 +6,14 @@
 // documents. Instead of producing one large TOON JSON, Aether emits
 // a JSONL event sequence:
 //
-//   doc_start → doc_meta → token* → doc_end
+//   doc_start → doc_meta → (token | section_start | section_end)* → doc_end
+//
+// Section boundaries are emitted as explicit section_start/section_end
+// events carrying the section's index, role and heading. Every token
+// event carries its token_index (position in the TOON token sequence)
+// and, inside a section, the section_index of the innermost open
+// section, so consumers can rebuild the section tree from the stream
+// alone.
 //
 // This enables:
 //   • streaming to LLM/RAG pipelines
@@ -75,8 +82,35 @@ func streamTOONDocument(ctx context.Context, w io.Writer, doc *toon.Document) er
 		doc = &toon.Document{}
 	}
 
-	enc := json.NewEncoder(w)
+	tw := newTOONEventWriter(ctx, w)
+	if err := tw.start(doc); err != nil {
+		return err
+	}
+	if err := tw.tokens(doc.Tokens); err != nil {
+		return err
+	}
+	return tw.end()
+}
+
+// toonEventWriter writes the event stream incrementally, so callers
+// that produce tokens piecemeal (see StreamNormalize) never hold a
+// whole token sequence. Token and section indices continue across
+// calls to tokens.
+type toonEventWriter struct {
+	ctx context.Context
+	enc *json.Encoder
+
+	open        []int // indices of currently open sections
+	nextSection int
+	nextToken   int
+}
+
+func newTOONEventWriter(ctx context.Context, w io.Writer) *toonEventWriter {
+	return &toonEventWriter{ctx: ctx, enc: json.NewEncoder(w)}
+}
 
+// start writes doc_start and, when doc has attributes, doc_meta.
+func (tw *toonEventWriter) start(doc *toon.Document) error {
 	// 1. doc_start
 	start := toonStreamEvent{
 		Event:   "doc_start",
@@ -85,7 +119,7 @@ func streamTOONDocument(ctx context.Context, w io.Writer, doc *toon.Document) er
 		Title:   doc.Title,
 		Excerpt: doc.Excerpt,
 	}
-	if err := encodeTOONEvent(ctx, enc, &start); err != nil {
+	if err := encodeTOONEvent(tw.ctx, tw.enc, &start); err != nil {
 		return err
 	}
 
@@ -98,34 +132,78 @@ func streamTOONDocument(ctx context.Context, w io.Writer, doc *toon.Document) er
 			// doc.Attributes is map[string]string → so Attrs must be map[string]string
 			Attrs: doc.Attributes,
 		}
-		if err := encodeTOONEvent(ctx, enc, &metaEv); err != nil {
+		if err := encodeTOONEvent(tw.ctx, tw.enc, &metaEv); err != nil {
 			return err
 		}
 	}
+	return nil
+}
+
+// tokens writes the token and section events for toks.
+func (tw *toonEventWriter) tokens(toks []toon.Token) error {
+	// 3. token and section events
+	for _, tok := range toks {
+		i := tw.nextToken
+		tw.nextToken++
+
+		var ev toonStreamEvent
 
-	// 3. token events
-	for _, tok := range doc.Tokens {
-		ev := toonStreamEvent{
-			Event: "token",
-			Token: &toonStreamToken{
-				Type:     string(tok.Type),
-				Category: categorizeTOONToken(tok),
-				Role:     tok.Role,
-				Text:     tok.Text,
+		switch tok.Type {
+		case toon.TokenSectionStart:
+			idx := tw.nextSection
+			tw.nextSection++
+			tw.open = append(tw.open, idx)
+			ev = toonStreamEvent{
+				Event: "section_start",
+				Section: &toonStreamSection{
+					Index:   idx,
+					Role:    tok.Role,
+					Heading: tok.Attrs["heading"],
+				},
+			}
+
+		case toon.TokenSectionEnd:
+			if len(tw.open) == 0 {
+				// Unbalanced input; nothing to close.
+				continue
+			}
+			idx := tw.open[len(tw.open)-1]
+			tw.open = tw.open[:len(tw.open)-1]
+			ev = toonStreamEvent{
+				Event:   "section_end",
+				Section: &toonStreamSection{Index: idx, Role: tok.Role},
+			}
+
+		default:
+			st := &toonStreamToken{
+				Type:       string(tok.Type),
+				Category:   categorizeTOONToken(tok),
+				Role:       tok.Role,
+				Text:       tok.Text,
+				TokenIndex: i,
 
 				// tok.Attrs is also map[string]string in your model
 				Attrs: tok.Attrs,
-			},
+			}
+			if len(tw.open) > 0 {
+				idx := tw.open[len(tw.open)-1]
+				st.SectionIndex = &idx
+			}
+			ev = toonStreamEvent{Event: "token", Token: st}
 		}
 
-		if err := encodeTOONEvent(ctx, enc, &ev); err != nil {
+		if err := encodeTOONEvent(tw.ctx, tw.enc, &ev); err != nil {
 			return err
 		}
 	}
+	return nil
+}
 
+// end writes doc_end.
+func (tw *toonEventWriter) end() error {
 	// 4. doc_end
 	end := toonStreamEvent{Event: "doc_end"}
-	return encodeTOONEvent(ctx, enc, &end)
+	return encodeTOONEvent(tw.ctx, tw.enc, &end)
 }
 
 func encodeTOONEvent(ctx context.Context, enc *json.Encoder, ev *toonStreamEvent) error {
@@ -173,6 +251,16 @@ type toonStreamEvent struct {
 	Attrs map[string]string `json:"attrs,omitempty"`
 
 	Token *toonStreamToken `json:"token,omitempty"`
+
+	Section *toonStreamSection `json:"section,omitempty"`
+}
+
+// toonStreamSection identifies a section in section_start/section_end
+// events. Index counts sections in document order starting at 0.
+type toonStreamSection struct {
+	Index   int    `json:"index"`
+	Role    string `json:"role,omitempty"`
+	Heading string `json:"heading,omitempty"`
 }
 
 type toonStreamToken struct {
@@ -183,4 +271,11 @@ type toonStreamToken struct {
 
 	// FIXED: must match toon.Token.Attrs type = map[string]string
 	Attrs map[string]string `json:"attrs,omitempty"`
+
+	// TokenIndex is the token's position in the TOON token sequence.
+	TokenIndex int `json:"token_index"`
+
+	// SectionIndex is the index of the innermost open section, or nil
+	// for tokens outside any section.
+	SectionIndex *int `json:"section_index,omitempty"`
 }
@@ -0,0 +1,85 @@
+// aether/toon_stream_test.go
+package aether
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+func TestStreamTOON_SectionEvents(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	doc := &NormalizedDocument{
+		Kind:  model.DocumentKindArticle,
+		Title: "Two parts",
+		Sections: []model.Section{
+			{Role: model.SectionRoleBody, Heading: "First", Text: "Alpha."},
+			{Role: model.SectionRoleBody, Heading: "Second", Text: "Beta."},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := cli.StreamTOON(context.Background(), &buf, doc); err != nil {
+		t.Fatalf("StreamTOON error: %v", err)
+	}
+
+	var (
+		seq      []string
+		lastTok  = -1
+		inside   = -1
+		sections = map[int]string{}
+	)
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		var ev toonStreamEvent
+		if err := json.Unmarshal([]byte(line), &ev); err != nil {
+			t.Fatalf("bad event %q: %v", line, err)
+		}
+
+		switch ev.Event {
+		case "section_start":
+			inside = ev.Section.Index
+			sections[ev.Section.Index] = ev.Section.Heading
+			seq = append(seq, fmt.Sprintf("start:%d:%s", ev.Section.Index, ev.Section.Role))
+		case "section_end":
+			if ev.Section.Index != inside {
+				t.Fatalf("section_end index: got %d, want %d", ev.Section.Index, inside)
+			}
+			inside = -1
+			seq = append(seq, fmt.Sprintf("end:%d", ev.Section.Index))
+		case "token":
+			if ev.Token.TokenIndex <= lastTok {
+				t.Fatalf("token_index not increasing: %d after %d", ev.Token.TokenIndex, lastTok)
+			}
+			lastTok = ev.Token.TokenIndex
+
+			got := -1
+			if ev.Token.SectionIndex != nil {
+				got = *ev.Token.SectionIndex
+			}
+			if got != inside {
+				t.Fatalf("token %q section_index: got %d, want %d", ev.Token.Text, got, inside)
+			}
+			if inside >= 0 && ev.Token.Type == "text" {
+				seq = append(seq, fmt.Sprintf("text:%d:%s", got, ev.Token.Text))
+			}
+		default:
+			seq = append(seq, ev.Event)
+		}
+	}
+
+	want := "doc_start,start:0:body,text:0:Alpha.,end:0,start:1:body,text:1:Beta.,end:1,doc_end"
+	if got := strings.Join(seq, ","); got != want {
+		t.Fatalf("event sequence:\n got %s\nwant %s", got, want)
+	}
+	if sections[0] != "First" || sections[1] != "Second" {
+		t.Fatalf("section headings: got %v", sections)
+	}
+}
@@ -0,0 +1,137 @@
+// aether/toon_test.go
+package aether
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/Nibir1/Aether/internal/toon"
+)
+
+func TestMarshalTOONJSONL_SingleLineRoundTrip(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	sr := &SearchResult{
+		Query: "q",
+		PrimaryDocument: &SearchDocument{
+			URL:      "https://example.com/a",
+			Kind:     SearchDocumentKindArticle,
+			Title:    "Multi\nline title",
+			Content:  "First paragraph.\n\nSecond paragraph\r\nwith CRLF and\u2028separator.",
+			Metadata: map[string]string{"author": "Ada"},
+		},
+	}
+
+	line, err := cli.MarshalTOONJSONL(sr)
+	if err != nil {
+		t.Fatalf("MarshalTOONJSONL error: %v", err)
+	}
+	if !bytes.HasSuffix(line, []byte("\n")) {
+		t.Fatalf("record does not end with a newline: %q", line)
+	}
+	if n := bytes.Count(line, []byte("\n")); n != 1 {
+		t.Fatalf("record spans %d lines, want 1", n)
+	}
+	if bytes.ContainsRune(line, '\r') || bytes.ContainsRune(line, '\u2028') {
+		t.Fatalf("record contains raw line separators: %q", line)
+	}
+
+	got, err := cli.UnmarshalTOONJSONL(line)
+	if err != nil {
+		t.Fatalf("UnmarshalTOONJSONL error: %v", err)
+	}
+	if want := cli.ToTOON(sr); !reflect.DeepEqual(got, want) {
+		t.Fatalf("round trip mismatch:\n got %+v\nwant %+v", got, want)
+	}
+
+	if _, err := cli.UnmarshalTOONJSONL(append(line, line...)); err == nil {
+		t.Fatalf("expected error for two records")
+	}
+}
+
+func TestMarshalTOONSummary_OmitsBodyText(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	sr := &SearchResult{
+		Query: "q",
+		PrimaryDocument: &SearchDocument{
+			URL:     "https://example.com/a",
+			Kind:    SearchDocumentKindArticle,
+			Title:   "Title",
+			Excerpt: "Short excerpt.",
+			Content: "A body paragraph that must not appear in the summary.",
+		},
+	}
+
+	out, err := cli.MarshalTOONSummary(sr)
+	if err != nil {
+		t.Fatalf("MarshalTOONSummary error: %v", err)
+	}
+	if bytes.Contains(out, []byte("must not appear")) {
+		t.Fatalf("summary contains body text: %s", out)
+	}
+	if !bytes.Contains(out, []byte(`"title":"Title"`)) {
+		t.Fatalf("summary lacks the title: %s", out)
+	}
+}
+
+func TestMarshalSectionTOON_RoundTrip(t *testing.T) {
+	cli, err := NewClient()
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+	sec := &NormalizedSection{
+		Role:    SectionRoleBody,
+		Heading: "Results",
+		Text:    "First paragraph.\n\nSecond paragraph.",
+		Meta:    map[string]string{"index": "2", "source": "https://example.com/a"},
+	}
+
+	data, err := cli.MarshalSectionTOON(sec)
+	if err != nil {
+		t.Fatalf("MarshalSectionTOON error: %v", err)
+	}
+	var frag toon.Document
+	if err := json.Unmarshal(data, &frag); err != nil {
+		t.Fatalf("fragment is not JSON: %v", err)
+	}
+	if n := len(frag.Tokens); n == 0 ||
+		frag.Tokens[0].Type != toon.TokenSectionStart || frag.Tokens[n-1].Type != toon.TokenSectionEnd {
+		t.Fatalf("fragment not bounded by one section: %+v", frag.Tokens)
+	}
+	for _, tok := range frag.Tokens {
+		if tok.Type == toon.TokenDocumentInfo {
+			t.Fatalf("fragment carries a DOCINFO token: %+v", frag.Tokens)
+		}
+	}
+
+	got, err := cli.UnmarshalSectionTOON(data)
+	if err != nil {
+		t.Fatalf("UnmarshalSectionTOON error: %v", err)
+	}
+	if !reflect.DeepEqual(got, sec) {
+		t.Fatalf("round trip:\ngot  %+v\nwant %+v", got, sec)
+	}
+
+	raw, err := cli.MarshalSectionJSON(sec)
+	if err != nil {
+		t.Fatalf("MarshalSectionJSON error: %v", err)
+	}
+	var back NormalizedSection
+	if err := json.Unmarshal(raw, &back); err != nil {
+		t.Fatalf("decode section JSON: %v", err)
+	}
+	if !reflect.DeepEqual(&back, sec) {
+		t.Fatalf("JSON round trip:\ngot  %+v\nwant %+v", back, *sec)
+	}
+
+	if _, err := cli.UnmarshalSectionTOON([]byte(`{"kind":"unknown","tokens":[{"type":"text","text":"x"}]}`)); err == nil {
+		t.Fatal("UnmarshalSectionTOON accepted a fragment without section boundaries")
+	}
+}
@@ -0,0 +1,51 @@
+// aether/trace.go
+//
+// Optional tracing hooks.
+//
+// A Tracer installed with WithTracer is handed one span per pipeline
+// step: each HTTP fetch, each robots.txt check, each extraction and each
+// normalization. Spans started inside a fetch (such as the robots.txt
+// check) receive the fetch span's context, so a tracer that stores its
+// span in the context gets a proper parent/child tree.
+//
+// Span names are stable and listed as the Span* constants. Without a
+// tracer the hooks reduce to a nil check.
+
+package aether
+
+import (
+	"github.com/Nibir1/Aether/internal/config"
+	"github.com/Nibir1/Aether/internal/trace"
+)
+
+// Tracer starts spans for Aether's pipeline steps. StartSpan returns the
+// context to run the step in and a function that ends the span; the end
+// function is called exactly once. Implementations must be safe for
+// concurrent use.
+type Tracer = trace.Tracer
+
+// Span names passed to Tracer.StartSpan.
+const (
+	SpanFetch     = trace.SpanFetch
+	SpanRobots    = trace.SpanRobots
+	SpanExtract   = trace.SpanExtract
+	SpanNormalize = trace.SpanNormalize
+)
+
+// WithTracer installs t to receive a span for every fetch, robots.txt
+// check, extraction and normalization. A nil tracer disables tracing,
+// which is the default.
+func WithTracer(t Tracer) Option {
+	return func(c *config.Config) {
+		c.Tracer = t
+	}
+}
+
+// tracer returns the configured tracer, or nil for a nil or
+// unconfigured client.
+func (c *Client) tracer() Tracer {
+	if c == nil || c.cfg == nil {
+		return nil
+	}
+	return c.cfg.Tracer
+}
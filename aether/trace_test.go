@@ -0,0 +1,64 @@
+// aether/trace_test.go
+package aether
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// recordingTracer records the names of started spans and how many ended.
+type recordingTracer struct {
+	mu    sync.Mutex
+	names []string
+	ended int
+}
+
+func (r *recordingTracer) StartSpan(ctx context.Context, name string) (context.Context, func()) {
+	r.mu.Lock()
+	r.names = append(r.names, name)
+	r.mu.Unlock()
+	return ctx, func() {
+		r.mu.Lock()
+		r.ended++
+		r.mu.Unlock()
+	}
+}
+
+func TestWithTracer_SearchSpanNames(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte("<html><head><title>Traced</title></head><body>hi</body></html>"))
+	}))
+	defer srv.Close()
+
+	tr := &recordingTracer{}
+	cli, err := NewClient(WithTracer(tr))
+	if err != nil {
+		t.Fatalf("NewClient error: %v", err)
+	}
+
+	res, err := cli.Search(context.Background(), srv.URL+"/page")
+	if err != nil {
+		t.Fatalf("Search error: %v", err)
+	}
+	cli.NormalizeSearchResult(res)
+
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	got := strings.Join(tr.names, ",")
+	want := strings.Join([]string{SpanFetch, SpanRobots, SpanExtract, SpanNormalize}, ",")
+	if got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if tr.ended != len(tr.names) {
+		t.Fatalf("ended %d of %d spans", tr.ended, len(tr.names))
+	}
+}
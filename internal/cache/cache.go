@@ -8,16 +8,24 @@
 package cache
 
 import (
+	"errors"
 	"time"
 
+	"github.com/Nibir1/Aether/internal/clock"
 	"github.com/Nibir1/Aether/internal/log"
 )
 
+// Cache is implemented by every cache layer and by the composite cache.
 type Cache interface {
 	Get(key string) ([]byte, bool)
 	Set(key string, value []byte, ttl time.Duration)
+	Delete(key string)
 }
 
+// errNotClearable is returned by Clear when a layer cannot enumerate
+// its keys.
+var errNotClearable = errors.New("cache: backend does not support Clear")
+
 type Config struct {
 	MemoryEnabled bool
 	MemoryTTL     time.Duration
@@ -31,6 +39,10 @@ type Config struct {
 	RedisTTL     time.Duration
 	RedisAddress string
 
+	// Clock drives TTL expiry of the memory and file layers. Nil means
+	// real time.
+	Clock clock.Clock
+
 	Logger log.Logger
 }
 
@@ -46,11 +58,11 @@ type compositeCache struct {
 func NewComposite(cfg Config) Cache {
 	var mem Cache
 	if cfg.MemoryEnabled {
-		mem = NewMemory(cfg.MemoryMax, cfg.MemoryTTL)
+		mem = NewMemoryWithClock(cfg.MemoryMax, cfg.MemoryTTL, cfg.Clock)
 	}
 	var file Cache
 	if cfg.FileEnabled {
-		file = NewFile(cfg.FileDirectory, cfg.FileTTL)
+		file = NewFileWithClock(cfg.FileDirectory, cfg.FileTTL, cfg.Clock)
 	}
 	var redis Cache
 	if cfg.RedisEnabled {
@@ -107,3 +119,34 @@ func (c *compositeCache) Set(key string, value []byte, ttl time.Duration) {
 		c.redis.Set(key, value, ttl)
 	}
 }
+
+func (c *compositeCache) Delete(key string) {
+	if c.memory != nil {
+		c.memory.Delete(key)
+	}
+	if c.file != nil {
+		c.file.Delete(key)
+	}
+	if c.redis != nil {
+		c.redis.Delete(key)
+	}
+}
+
+// Clear removes keys starting with prefix from every layer that supports
+// it. All layers are attempted; the first error is returned.
+func (c *compositeCache) Clear(prefix string) error {
+	var first error
+	for _, layer := range []Cache{c.memory, c.file, c.redis} {
+		if layer == nil {
+			continue
+		}
+		cl, ok := layer.(Clearer)
+		if !ok {
+			continue
+		}
+		if err := cl.Clear(prefix); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
@@ -5,14 +5,18 @@
 //   <cacheDir>/<sha256(key)>
 //
 // Each file contains:
-//   timestamp\n
+//   timestamp quoted-key\n
 //   raw bytes
 //
-// TTL is enforced on read.
+// The key is recorded so Clear can select entries by prefix; files
+// written without it are still readable. TTL is enforced on read.
+// Entries are written to a temporary file and renamed into place, so
+// concurrent readers never observe a torn write.
 
 package cache
 
 import (
+	"bufio"
 	"crypto/sha256"
 	"encoding/hex"
 	"os"
@@ -20,19 +24,28 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/Nibir1/Aether/internal/clock"
 )
 
 type fileCache struct {
-	dir string
-	ttl time.Duration
+	dir   string
+	ttl   time.Duration
+	clock clock.Clock
 }
 
 func NewFile(dir string, ttl time.Duration) Cache {
+	return NewFileWithClock(dir, ttl, nil)
+}
+
+// NewFileWithClock is NewFile with entry timestamps and expiry taken
+// from clk (nil means real time).
+func NewFileWithClock(dir string, ttl time.Duration, clk clock.Clock) Cache {
 	if ttl <= 0 {
 		ttl = 30 * time.Second
 	}
 	os.MkdirAll(dir, 0o755)
-	return &fileCache{dir: dir, ttl: ttl}
+	return &fileCache{dir: dir, ttl: ttl, clock: clock.Or(clk)}
 }
 
 func (f *fileCache) Get(key string) ([]byte, bool) {
@@ -47,12 +60,13 @@ func (f *fileCache) Get(key string) ([]byte, bool) {
 		return nil, false
 	}
 
-	ts, err := strconv.ParseInt(parts[0], 10, 64)
+	header, _, _ := strings.Cut(parts[0], " ")
+	ts, err := strconv.ParseInt(header, 10, 64)
 	if err != nil {
 		return nil, false
 	}
 
-	if time.Now().After(time.Unix(ts, 0).Add(f.ttl)) {
+	if f.clock.Now().After(time.Unix(ts, 0).Add(f.ttl)) {
 		os.Remove(path) // expired
 		return nil, false
 	}
@@ -65,9 +79,80 @@ func (f *fileCache) Set(key string, value []byte, ttl time.Duration) {
 		ttl = f.ttl
 	}
 
-	ts := strconv.FormatInt(time.Now().Unix(), 10)
-	content := []byte(ts + "\n" + string(value))
-	os.WriteFile(f.filePath(key), content, 0o644)
+	ts := strconv.FormatInt(f.clock.Now().Unix(), 10)
+	content := []byte(ts + " " + strconv.Quote(key) + "\n" + string(value))
+	writeFileAtomic(f.filePath(key), content)
+}
+
+// writeFileAtomic writes data to a temporary file in the same directory
+// and renames it over path, so a concurrent Get sees either the old
+// entry or the new one, never a partially written file.
+func writeFileAtomic(path string, data []byte) {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return
+	}
+	_, werr := tmp.Write(data)
+	cerr := tmp.Close()
+	if werr != nil || cerr != nil || os.Chmod(tmp.Name(), 0o644) != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+func (f *fileCache) Delete(key string) {
+	os.Remove(f.filePath(key))
+}
+
+func (f *fileCache) Clear(prefix string) error {
+	entries, err := os.ReadDir(f.dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	for _, e := range entries {
+		if e.IsDir() || strings.HasPrefix(e.Name(), ".tmp-") {
+			continue
+		}
+		path := filepath.Join(f.dir, e.Name())
+		key, ok := readFileKey(path)
+		if !ok || !strings.HasPrefix(key, prefix) {
+			continue
+		}
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// readFileKey returns the key recorded in a cache file's header line.
+func readFileKey(path string) (string, bool) {
+	fh, err := os.Open(path)
+	if err != nil {
+		return "", false
+	}
+	defer fh.Close()
+
+	line, err := bufio.NewReader(fh).ReadString('\n')
+	if err != nil {
+		return "", false
+	}
+	_, quoted, ok := strings.Cut(strings.TrimSuffix(line, "\n"), " ")
+	if !ok {
+		return "", false
+	}
+	key, err := strconv.Unquote(quoted)
+	if err != nil {
+		return "", false
+	}
+	return key, true
 }
 
 func (f *fileCache) filePath(key string) string {
@@ -6,8 +6,11 @@ package cache
 
 import (
 	"container/list"
+	"strings"
 	"sync"
 	"time"
+
+	"github.com/Nibir1/Aether/internal/clock"
 )
 
 type memoryCache struct {
@@ -16,6 +19,7 @@ type memoryCache struct {
 	mu      sync.Mutex
 	ll      *list.List
 	entries map[string]*list.Element
+	clock   clock.Clock
 }
 
 type entry struct {
@@ -25,6 +29,12 @@ type entry struct {
 }
 
 func NewMemory(max int, ttl time.Duration) Cache {
+	return NewMemoryWithClock(max, ttl, nil)
+}
+
+// NewMemoryWithClock is NewMemory with expiry measured against clk
+// (nil means real time).
+func NewMemoryWithClock(max int, ttl time.Duration, clk clock.Clock) Cache {
 	if max <= 0 {
 		max = 128
 	}
@@ -37,6 +47,7 @@ func NewMemory(max int, ttl time.Duration) Cache {
 		ttl:     ttl,
 		ll:      list.New(),
 		entries: make(map[string]*list.Element),
+		clock:   clock.Or(clk),
 	}
 }
 
@@ -50,7 +61,7 @@ func (m *memoryCache) Get(key string) ([]byte, bool) {
 	}
 
 	ent := ele.Value.(*entry)
-	if time.Now().After(ent.expires) {
+	if m.clock.Now().After(ent.expires) {
 		m.removeElement(ele)
 		return nil, false
 	}
@@ -76,14 +87,14 @@ func (m *memoryCache) Set(key string, value []byte, ttl time.Duration) {
 		m.ll.MoveToFront(ele)
 		ent := ele.Value.(*entry)
 		ent.value = cloneBytes(value)
-		ent.expires = time.Now().Add(ttl)
+		ent.expires = m.clock.Now().Add(ttl)
 		return
 	}
 
 	ent := &entry{
 		key:     key,
 		value:   cloneBytes(value),
-		expires: time.Now().Add(ttl),
+		expires: m.clock.Now().Add(ttl),
 	}
 
 	ele := m.ll.PushFront(ent)
@@ -94,6 +105,27 @@ func (m *memoryCache) Set(key string, value []byte, ttl time.Duration) {
 	}
 }
 
+func (m *memoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if ele, ok := m.entries[key]; ok {
+		m.removeElement(ele)
+	}
+}
+
+func (m *memoryCache) Clear(prefix string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for key, ele := range m.entries {
+		if strings.HasPrefix(key, prefix) {
+			m.removeElement(ele)
+		}
+	}
+	return nil
+}
+
 func (m *memoryCache) removeOldest() {
 	ele := m.ll.Back()
 	if ele != nil {
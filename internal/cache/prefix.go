@@ -0,0 +1,52 @@
+// internal/cache/prefix.go
+//
+// Key namespacing and scoped clearing. A prefixed cache rewrites every
+// key before handing it to the wrapped cache, so several applications
+// (or several Aether clients) can share one backend without colliding,
+// and a Clear only touches the keys that belong to that namespace.
+
+package cache
+
+import "time"
+
+// Clearer is implemented by caches that can remove every entry whose
+// key starts with a given prefix. Implementations must never touch keys
+// outside that prefix.
+type Clearer interface {
+	Clear(prefix string) error
+}
+
+type prefixedCache struct {
+	inner  Cache
+	prefix string
+}
+
+// WithPrefix returns a Cache that stores every key as prefix+key in c.
+// An empty prefix returns c unchanged.
+func WithPrefix(c Cache, prefix string) Cache {
+	if c == nil || prefix == "" {
+		return c
+	}
+	return &prefixedCache{inner: c, prefix: prefix}
+}
+
+func (p *prefixedCache) Get(key string) ([]byte, bool) {
+	return p.inner.Get(p.prefix + key)
+}
+
+func (p *prefixedCache) Set(key string, value []byte, ttl time.Duration) {
+	p.inner.Set(p.prefix+key, value, ttl)
+}
+
+func (p *prefixedCache) Delete(key string) {
+	p.inner.Delete(p.prefix + key)
+}
+
+// Clear removes the entries under prefix within this cache's namespace.
+func (p *prefixedCache) Clear(prefix string) error {
+	cl, ok := p.inner.(Clearer)
+	if !ok {
+		return errNotClearable
+	}
+	return cl.Clear(p.prefix + prefix)
+}
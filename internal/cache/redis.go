@@ -1,7 +1,7 @@
 // internal/cache/redis.go
 //
 // A minimal Redis cache adapter. It uses raw TCP connections and
-// RESP protocol for GET/SET with EX.
+// RESP protocol for GET, SET with PX, DEL, and SCAN (for Clear).
 //
 // This avoids external dependencies while remaining fully functional.
 
@@ -12,6 +12,8 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -77,4 +79,154 @@ func (r *redisCache) Set(key string, value []byte, ttl time.Duration) {
 		len(value), value,
 		len(fmt.Sprint(ttl.Milliseconds())), ttl.Milliseconds(),
 	)
+
+	// Wait for the status reply so the write is applied before returning.
+	bufio.NewReader(conn).ReadString('\n')
+}
+
+func (r *redisCache) Delete(key string) {
+	conn, err := net.Dial("tcp", r.addr)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	fmt.Fprintf(conn, "*2\r\n$3\r\nDEL\r\n$%d\r\n%s\r\n", len(key), key)
+
+	// Wait for the integer reply so the delete is applied before returning.
+	bufio.NewReader(conn).ReadString('\n')
+}
+
+// Clear deletes the keys matching prefix using SCAN with a MATCH pattern,
+// so keys outside the prefix (e.g. other applications sharing the
+// server) are never touched. An empty prefix is refused for the same
+// reason.
+func (r *redisCache) Clear(prefix string) error {
+	if prefix == "" {
+		return fmt.Errorf("cache: refusing to clear redis without a key prefix")
+	}
+
+	conn, err := net.DialTimeout("tcp", r.addr, 5*time.Second)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	reader := bufio.NewReader(conn)
+	pattern := globEscape(prefix) + "*"
+	cursor := "0"
+
+	for {
+		if err := writeCommand(conn, "SCAN", cursor, "MATCH", pattern, "COUNT", "100"); err != nil {
+			return err
+		}
+		reply, err := readReply(reader)
+		if err != nil {
+			return err
+		}
+		parts, ok := reply.([]any)
+		if !ok || len(parts) != 2 {
+			return fmt.Errorf("cache: unexpected SCAN reply %v", reply)
+		}
+		next, _ := parts[0].(string)
+		keys, _ := parts[1].([]any)
+
+		var batch []string
+		for _, k := range keys {
+			// Double-check: MATCH is a glob, the contract is a prefix.
+			if s, ok := k.(string); ok && strings.HasPrefix(s, prefix) {
+				batch = append(batch, s)
+			}
+		}
+		if len(batch) > 0 {
+			if err := writeCommand(conn, append([]string{"DEL"}, batch...)...); err != nil {
+				return err
+			}
+			if _, err := readReply(reader); err != nil {
+				return err
+			}
+		}
+
+		if next == "" || next == "0" {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+// writeCommand sends args as a RESP array of bulk strings.
+func writeCommand(w io.Writer, args ...string) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "*%d\r\n", len(args))
+	for _, a := range args {
+		fmt.Fprintf(&b, "$%d\r\n%s\r\n", len(a), a)
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// readReply decodes one RESP value. Bulk and simple strings become
+// string, integers int64, arrays []any, and nil bulks nil.
+func readReply(r *bufio.Reader) (any, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	line = strings.TrimRight(line, "\r\n")
+	if line == "" {
+		return nil, fmt.Errorf("cache: empty redis reply")
+	}
+
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return nil, fmt.Errorf("cache: redis error: %s", line[1:])
+	case ':':
+		return strconv.ParseInt(line[1:], 10, 64)
+	case '$':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, err
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		buf := make([]byte, n+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		return string(buf[:n]), nil
+	case '*':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, err
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		out := make([]any, 0, n)
+		for i := 0; i < n; i++ {
+			v, err := readReply(r)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, v)
+		}
+		return out, nil
+	}
+	return nil, fmt.Errorf("cache: unexpected redis reply %q", line)
+}
+
+// globEscape escapes the redis glob metacharacters in s.
+func globEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch r {
+		case '*', '?', '[', ']', '\\':
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
 }
@@ -0,0 +1,137 @@
+// internal/cache/redis_test.go
+package cache
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeRedis speaks just enough RESP for GET, SET, DEL and SCAN.
+type fakeRedis struct {
+	mu   sync.Mutex
+	data map[string]string
+}
+
+func startFakeRedis(t *testing.T) (*fakeRedis, string) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	f := &fakeRedis{data: map[string]string{}}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go f.serve(conn)
+		}
+	}()
+	return f, ln.Addr().String()
+}
+
+func (f *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		v, err := readReply(r)
+		if err != nil {
+			return
+		}
+		parts, _ := v.([]any)
+		args := make([]string, len(parts))
+		for i, p := range parts {
+			args[i], _ = p.(string)
+		}
+		if len(args) == 0 {
+			return
+		}
+
+		f.mu.Lock()
+		switch strings.ToUpper(args[0]) {
+		case "GET":
+			if val, ok := f.data[args[1]]; ok {
+				writeCommand(conn, val)
+			} else {
+				conn.Write([]byte("$-1\r\n"))
+			}
+		case "SET":
+			f.data[args[1]] = args[2]
+			conn.Write([]byte("+OK\r\n"))
+		case "DEL":
+			for _, k := range args[1:] {
+				delete(f.data, k)
+			}
+			conn.Write([]byte(":1\r\n"))
+		case "SCAN":
+			// Single page; MATCH is "<prefix>*" with no other globs here.
+			prefix := strings.TrimSuffix(args[3], "*")
+			var keys []string
+			for k := range f.data {
+				if strings.HasPrefix(k, prefix) {
+					keys = append(keys, k)
+				}
+			}
+			conn.Write([]byte("*2\r\n$1\r\n0\r\n"))
+			writeCommand(conn, keys...)
+		}
+		f.mu.Unlock()
+	}
+}
+
+func TestRedisClear_OnlyTouchesPrefix(t *testing.T) {
+	f, addr := startFakeRedis(t)
+	f.data["otherapp:user:1"] = "keep"
+
+	c := WithPrefix(NewRedis(addr, time.Minute), "aether:")
+	c.Set("http:https://example.com/", []byte("page"), 0)
+
+	f.mu.Lock()
+	_, ok := f.data["aether:http:https://example.com/"]
+	f.mu.Unlock()
+	if !ok {
+		t.Fatal("expected key stored under aether: prefix")
+	}
+
+	if err := c.(Clearer).Clear(""); err != nil {
+		t.Fatalf("Clear error: %v", err)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.data["aether:http:https://example.com/"]; ok {
+		t.Fatal("expected prefixed key to be cleared")
+	}
+	if f.data["otherapp:user:1"] != "keep" {
+		t.Fatal("foreign key was removed")
+	}
+}
+
+func TestRedisClear_RefusesEmptyPrefix(t *testing.T) {
+	if err := NewRedis("127.0.0.1:0", 0).(Clearer).Clear(""); err == nil {
+		t.Fatal("expected error for empty prefix")
+	}
+}
+
+func TestFileClear_OnlyTouchesPrefix(t *testing.T) {
+	c := NewFile(t.TempDir(), time.Minute)
+	c.Set("aether:http:a", []byte("a"), 0)
+	c.Set("other:b", []byte("b"), 0)
+
+	if err := c.(Clearer).Clear("aether:"); err != nil {
+		t.Fatalf("Clear error: %v", err)
+	}
+	if _, ok := c.Get("aether:http:a"); ok {
+		t.Fatal("expected prefixed entry to be cleared")
+	}
+	if v, ok := c.Get("other:b"); !ok || string(v) != "b" {
+		t.Fatalf("foreign entry: got %q (found=%v)", v, ok)
+	}
+}
@@ -0,0 +1,31 @@
+// internal/cache/ttl_test.go
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Nibir1/Aether/internal/clock"
+)
+
+func TestTTL_FakeClockExpiresEntries(t *testing.T) {
+	clk := clock.NewFake(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	layers := map[string]Cache{
+		"memory": NewMemoryWithClock(8, time.Minute, clk),
+		"file":   NewFileWithClock(t.TempDir(), time.Minute, clk),
+	}
+	for name, c := range layers {
+		c.Set("k", []byte("v"), time.Minute)
+
+		clk.Advance(59 * time.Second)
+		if got, ok := c.Get("k"); !ok || string(got) != "v" {
+			t.Fatalf("%s: before expiry: got %q, %v; want %q, true", name, got, ok, "v")
+		}
+
+		clk.Advance(2 * time.Second)
+		if got, ok := c.Get("k"); ok {
+			t.Fatalf("%s: after expiry: got %q, want miss", name, got)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// internal/clock/clock.go
+//
+// Package clock abstracts the passage of time so that time-dependent
+// behavior (cache TTL expiry, per-host throttling, fetch timestamps) can
+// be tested deterministically.
+//
+// Production code uses Real, which defers to the time package. Tests use
+// a Fake, whose time only moves when Advance (or Sleep) is called, so a
+// TTL can be made to expire instantly without sleeping.
+
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock is the source of the current time for Aether's internals.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// Sleep pauses the calling goroutine for at least d.
+	Sleep(d time.Duration)
+}
+
+// Real is the Clock backed by the system time.
+var Real Clock = realClock{}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time        { return time.Now() }
+func (realClock) Sleep(d time.Duration) { time.Sleep(d) }
+
+// Or returns c, or Real when c is nil.
+func Or(c Clock) Clock {
+	if c == nil {
+		return Real
+	}
+	return c
+}
+
+// Fake is a manually driven Clock. It is safe for concurrent use.
+type Fake struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFake returns a Fake clock set to start.
+func NewFake(start time.Time) *Fake {
+	return &Fake{now: start}
+}
+
+// Now returns the fake current time.
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// Sleep advances the fake clock by d and returns immediately.
+func (f *Fake) Sleep(d time.Duration) {
+	f.Advance(d)
+}
+
+// Advance moves the fake clock forward by d.
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+}
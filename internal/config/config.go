@@ -7,7 +7,15 @@
 
 package config
 
-import "time"
+import (
+	"net/http"
+	"time"
+
+	"github.com/Nibir1/Aether/internal/cache"
+	"github.com/Nibir1/Aether/internal/clock"
+	"github.com/Nibir1/Aether/internal/log"
+	"github.com/Nibir1/Aether/internal/trace"
+)
 
 // Config holds core configuration values used across Aether.
 //
@@ -17,16 +25,32 @@ type Config struct {
 
 	// HTTP settings
 	UserAgent          string
+	AcceptLanguage     string            // Accept-Language sent on every request; "" omits it
+	AcceptByKind       map[string]string // Accept for URL searches by expected document kind; overrides the defaults
 	RequestTimeout     time.Duration
+	Transport          http.RoundTripper // performs outbound requests; nil means http.DefaultTransport
 	MaxConcurrentHosts int
 	MaxRequestsPerHost int
+	PerHostConcurrency map[string]int // per-host overrides of MaxRequestsPerHost, keyed by lowercase host
+
+	// PerRequestTimeout bounds each Fetch as a whole (concurrency wait,
+	// robots.txt check and retries) with its own deadline, applied on
+	// top of the caller's context. Zero means no extra deadline.
+	PerRequestTimeout time.Duration
 
 	// Logging
 	EnableDebugLogging bool
 
+	// Logger, when non-nil, receives all of Aether's log output instead
+	// of the built-in stderr logger. EnableDebugLogging only affects the
+	// built-in logger; a custom Logger does its own level filtering.
+	Logger log.Logger
+
 	// --- Caching settings (Stage 3) ---
 
-	// CacheTTL is the default time-to-live for all cache layers.
+	// CacheTTL is the default time-to-live for all cache layers. For
+	// fetched responses it is the fallback when the server sends no
+	// Cache-Control max-age or Expires, and the cap when it does.
 	CacheTTL time.Duration
 
 	// MaxCacheEntries affects memory LRU capacity.
@@ -47,6 +71,28 @@ type Config struct {
 	// Redis server address, e.g. "localhost:6379"
 	RedisAddress string
 
+	// SharedCache, when non-nil, is used as-is instead of building a
+	// per-client composite cache, so several clients can share one
+	// cache instance.
+	SharedCache cache.Cache
+
+	// CachePrefix namespaces every cache key (e.g. "aether:" turns
+	// "http:..." into "aether:http:..."). Empty means no prefix.
+	CachePrefix string
+
+	// Offline serves every fetch from the cache and fails cache misses
+	// with a KindOffline error instead of touching the network.
+	// robots.txt is not consulted, since nothing is fetched.
+	Offline bool
+
+	// Clock is the time source for cache TTLs, crawl throttling and
+	// fetch timestamps. Nil means real time.
+	Clock clock.Clock
+
+	// Tracer, when non-nil, receives a span for each fetch, robots.txt
+	// check, extraction and normalization. Nil disables tracing.
+	Tracer trace.Tracer
+
 	// --- Robots.txt behavior (Option A: host-level override) ---
 
 	// RobotsOverrideList contains hostnames for which Aether will
@@ -61,6 +107,39 @@ type Config struct {
 	// --- robots override system ---
 	RobotsOverrideEnabled bool
 	RobotsAllowedHosts    []string
+
+	// OpenAPITimeouts overrides the per-request timeout of individual
+	// OpenAPI endpoints, keyed by endpoint name ("wikipedia", "weather",
+	// ...). A zero duration disables the endpoint timeout.
+	OpenAPITimeouts map[string]time.Duration
+
+	// OpenAPIMirrors lists fallback origins per OpenAPI endpoint, keyed
+	// like OpenAPITimeouts. A request whose primary host fails is retried
+	// against each mirror in order. A present key replaces the built-in
+	// list; an empty list disables fallback for that endpoint.
+	OpenAPIMirrors map[string][]string
+
+	// ValidatePlugins, when true, rejects malformed Documents returned
+	// by source and transform plugins instead of passing them on.
+	ValidatePlugins bool
+
+	// MinArticleLength is the minimum extracted content length, in
+	// characters, for article extraction to report success. Zero
+	// accepts any length.
+	MinArticleLength int
+
+	// ExtractionAggressiveness selects how readily article extraction
+	// drops boilerplate (see extract.Aggressiveness). Zero means the
+	// extractor's default level.
+	ExtractionAggressiveness int
+
+	// PreferAMP makes ExtractArticle extract a page's AMP variant, when
+	// it declares one, instead of the page itself.
+	PreferAMP bool
+
+	// RenderCacheSize is the number of rendered outputs kept by the
+	// render cache. Zero disables it.
+	RenderCacheSize int
 }
 
 // Default constructs a Config with safe, conservative defaults.
@@ -90,5 +169,7 @@ func Default() *Config {
 
 		RobotsOverrideEnabled: false,
 		RobotsAllowedHosts:    []string{},
+
+		MinArticleLength: DefaultMinArticleLength,
 	}
 }
@@ -33,6 +33,12 @@ const (
 	// defaultMaxCacheEntries is the default capacity of the in-memory
 	// LRU cache layer.
 	defaultMaxCacheEntries = 128
+
+	// --- Extraction defaults ---
+
+	// DefaultMinArticleLength is the minimum number of characters of
+	// extracted main content for a page to count as an article.
+	DefaultMinArticleLength = 200
 )
 
 // applyDefaults populates zero-valued fields in Config with library defaults.
@@ -18,6 +18,9 @@
 //     (frontier, throttle, visit map) are thread-safe and ready for a
 //     future multi-worker version without changing the public API.
 //
+//   • When Options.Extract is set, pages are handed to a pool of
+//     extraction workers (see pipeline.go) so parsing overlaps fetching.
+//
 //   • Delegates robots.txt compliance, caching, retries, and rate limiting
 //     to the internal httpclient.Client used by Aether.
 //
@@ -29,10 +32,14 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/Nibir1/Aether/internal/clock"
 	"github.com/Nibir1/Aether/internal/httpclient"
+	xhtml "golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
 )
 
 // Page represents a single crawled page, as seen by the visitor callback.
@@ -51,6 +58,14 @@ type Page struct {
 
 	// Metadata holds additional simple metadata such as content type.
 	Metadata map[string]string
+
+	// Extracted holds whatever Options.Extract produced for this page.
+	// It is opaque to the crawler.
+	Extracted any
+
+	// ExtractErr records a failure of Options.Extract. The page is still
+	// delivered to the Visitor.
+	ExtractErr error
 }
 
 // Visitor is invoked for each successfully fetched page.
@@ -79,7 +94,8 @@ type Options struct {
 	MaxPages int
 
 	// SameHostOnly restricts all crawled URLs to the same host as the
-	// starting URL.
+	// starting URL. With several start URLs (RunMulti) a URL is allowed
+	// when its host matches the host of any start URL.
 	SameHostOnly bool
 
 	// AllowedDomains, if non-empty, restricts crawling to these hostnames.
@@ -89,15 +105,77 @@ type Options struct {
 	// DisallowedDomains, if non-empty, blocks crawling for these hostnames.
 	DisallowedDomains []string
 
+	// AllowPaths, if non-empty, restricts discovered links to URLs whose
+	// path (plus query) matches one of these patterns. Patterns are plain
+	// prefixes or globs where "*" matches any characters; see PathFilter.
+	// The start URL is always fetched.
+	AllowPaths []string
+
+	// DenyPaths blocks discovered links whose path (plus query) matches
+	// any of these patterns. Deny wins over AllowPaths.
+	DenyPaths []string
+
+	// IgnoreNofollow follows links marked rel="nofollow" and pages whose
+	// <meta name="robots"> says "nofollow". By default (false) such links
+	// are not enqueued.
+	IgnoreNofollow bool
+
 	// FetchDelay is a soft politeness delay enforced between successive
 	// requests to the same host. A value of zero disables per-host delay.
 	FetchDelay time.Duration
 
+	// HeadBeforeGet issues a HEAD request before each GET and skips URLs
+	// whose Content-Type is not HTML or whose Content-Length exceeds
+	// MaxContentLength. When the HEAD fails or returns a non-2xx status
+	// (servers that do not support HEAD), the URL is fetched normally.
+	HeadBeforeGet bool
+
+	// MaxContentLength is the largest Content-Length, in bytes, accepted
+	// by the HEAD check. Zero means no size limit.
+	MaxContentLength int64
+
+	// RetryAfterAttempts bounds how many times a URL answered with 429 or
+	// 503 and a Retry-After header is re-enqueued. Zero uses
+	// DefaultRetryAfterAttempts; a negative value disables retrying and
+	// delivers such responses as ordinary pages.
+	RetryAfterAttempts int
+
+	// RetryAfterMax is the longest Retry-After delay the crawler honours.
+	// Responses asking for a longer wait are delivered as-is. Zero uses
+	// DefaultRetryAfterMax.
+	RetryAfterMax time.Duration
+
+	// Clock drives the per-host throttle. Nil means real time.
+	Clock clock.Clock
+
 	// Concurrency is reserved for a future multi-worker version of the
 	// crawler. The current implementation uses a single worker, but keeps
 	// this field for API stability.
 	Concurrency int
 
+	// Priority optionally assigns a priority to each discovered URL.
+	// Higher priorities are crawled first; URLs with equal priority keep
+	// discovery (FIFO) order. A nil Priority crawls breadth-first.
+	Priority PriorityFunc
+
+	// DedupByContent skips the Visitor for pages whose visible text is
+	// identical to a page already delivered. Links on duplicate pages are
+	// still followed. Delivered pages carry a "content_hash" metadata key.
+	DedupByContent bool
+
+	// Extract, when non-nil, processes each fetched page on a pool of
+	// ExtractWorkers goroutines before the Visitor sees it, so extraction
+	// overlaps fetching.
+	Extract ExtractFunc
+
+	// ExtractWorkers sets the extraction pool size. Values <= 0 use the
+	// number of CPUs.
+	ExtractWorkers int
+
+	// UnorderedVisits delivers extracted pages as soon as they are ready
+	// instead of in fetch order.
+	UnorderedVisits bool
+
 	// Visitor is invoked for each fetched page. It must not be nil.
 	Visitor Visitor
 }
@@ -113,9 +191,15 @@ type Crawler struct {
 	depthLimit DepthLimit
 	frontier   *FrontierQueue
 	visited    *VisitMap
+	contents   *ContentIndex
 	throttle   *PerHostThrottle
+	paths      *PathFilter
+	clock      clock.Clock
+
+	retryAttempts int
+	retryMax      time.Duration
 
-	startHost         string
+	startHosts        map[string]struct{}
 	allowedDomains    map[string]struct{}
 	disallowedDomains map[string]struct{}
 }
@@ -136,8 +220,12 @@ func NewCrawler(fetcher *httpclient.Client, opts Options) (*Crawler, error) {
 		depthLimit: NewDepthLimit(opts.MaxDepth),
 		frontier:   NewFrontierQueue(),
 		visited:    NewVisitMap(),
-		throttle:   NewPerHostThrottle(opts.FetchDelay),
+		contents:   NewContentIndex(),
+		throttle:   NewPerHostThrottleWithClock(opts.FetchDelay, opts.Clock),
+		paths:      NewPathFilter(opts.AllowPaths, opts.DenyPaths),
+		clock:      clock.Or(opts.Clock),
 	}
+	c.retryAttempts, c.retryMax = retryAfterLimits(opts)
 
 	c.allowedDomains = make(map[string]struct{})
 	for _, d := range opts.AllowedDomains {
@@ -158,6 +246,20 @@ func NewCrawler(fetcher *httpclient.Client, opts Options) (*Crawler, error) {
 	return c, nil
 }
 
+// HostStats reports, per host, how many requests the crawl has made and
+// when the next one may go out. It is safe to call while Run is in
+// progress.
+func (c *Crawler) HostStats() map[string]HostState {
+	return c.throttle.Snapshot()
+}
+
+// Duplicates maps every page skipped by DedupByContent to the URL of the
+// first page with the same content. It is safe to call while Run is in
+// progress.
+func (c *Crawler) Duplicates() map[string]string {
+	return c.contents.Duplicates()
+}
+
 // Run executes the crawl starting from startURL.
 //
 // The crawl stops when:
@@ -169,23 +271,64 @@ func NewCrawler(fetcher *httpclient.Client, opts Options) (*Crawler, error) {
 // The current implementation is single-threaded (one worker), but all
 // underlying components are safe for future multi-worker expansion.
 func (c *Crawler) Run(ctx context.Context, startURL string) error {
-	norm, host, err := c.normalizeStartURL(startURL)
-	if err != nil {
-		return err
+	return c.RunMulti(ctx, []string{startURL})
+}
+
+// RunMulti executes one crawl seeded with every URL in startURLs at
+// depth 0. The seeds share the visited set, frontier, per-host
+// throttling and MaxPages budget, so a link reachable from several
+// seeds is fetched once. Seeds are fetched in the order given; duplicate
+// seeds are ignored. Every seed is validated before anything is fetched.
+func (c *Crawler) RunMulti(ctx context.Context, startURLs []string) error {
+	if len(startURLs) == 0 {
+		return fmt.Errorf("crawl: no start URLs")
+	}
+
+	seeds := make([]string, 0, len(startURLs))
+	c.startHosts = make(map[string]struct{}, len(startURLs))
+	for _, raw := range startURLs {
+		norm, host, err := c.normalizeStartURL(raw)
+		if err != nil {
+			return err
+		}
+		seeds = append(seeds, norm)
+		c.startHosts[host] = struct{}{}
+	}
+	for host := range c.startHosts {
+		if !c.hostAllowed(host) {
+			return fmt.Errorf("crawl: start host %q is not allowed", host)
+		}
+	}
+
+	// Seed frontier with the root URLs at depth 0.
+	for _, norm := range seeds {
+		if c.visited.MarkVisited(norm) {
+			c.frontier.Enqueue(FrontierItem{
+				URL:   norm,
+				Depth: 0,
+			})
+		}
 	}
-	c.startHost = host
 
-	if !c.hostAllowed(host) {
-		return fmt.Errorf("crawl: start host %q is not allowed", host)
+	if c.opts.Extract == nil {
+		return c.loop(ctx, func(page *Page) error {
+			return c.opts.Visitor.VisitPage(ctx, page)
+		})
 	}
 
-	// Seed frontier with the root URL at depth 0.
-	c.frontier.Enqueue(FrontierItem{
-		URL:   norm,
-		Depth: 0,
+	pipe, pctx := newExtractPipeline(ctx, c.opts)
+	loopErr := c.loop(pctx, func(page *Page) error {
+		return pipe.submit(pctx, page)
 	})
-	c.visited.MarkVisited(norm)
+	if err := pipe.close(); err != nil {
+		return err
+	}
+	return loopErr
+}
 
+// loop drains the frontier, fetching each URL and passing the resulting
+// page to deliver.
+func (c *Crawler) loop(ctx context.Context, deliver func(*Page) error) error {
 	pagesFetched := 0
 
 	for {
@@ -212,6 +355,10 @@ func (c *Crawler) Run(ctx context.Context, startURL string) error {
 			return nil
 		}
 
+		if c.opts.HeadBeforeGet && c.skipAfterHead(ctx, item.URL) {
+			continue
+		}
+
 		// Politeness delay per host.
 		c.throttle.Wait(item.URL)
 
@@ -220,6 +367,17 @@ func (c *Crawler) Run(ctx context.Context, startURL string) error {
 			return err
 		}
 
+		delay, retryable := retryAfterDelay(resp, c.clock.Now())
+		if retryable && item.Retries < c.retryAttempts && delay <= c.retryMax {
+			// Come back once the server says it is ready; other
+			// URLs in the frontier are served in the meantime.
+			c.throttle.Defer(item.URL, delay)
+			item.Retries++
+			item.retryDelay = delay
+			c.frontier.Enqueue(item)
+			continue
+		}
+
 		pagesFetched++
 
 		contentType := ""
@@ -237,20 +395,61 @@ func (c *Crawler) Run(ctx context.Context, startURL string) error {
 				"content_type": contentType,
 			},
 		}
+		if item.Retries > 0 {
+			page.Metadata["retry_count"] = strconv.Itoa(item.Retries)
+			page.Metadata["retry_after_seconds"] = strconv.Itoa(int(item.retryDelay / time.Second))
+		}
+		if retryable {
+			// Still throttled after the retries we allow.
+			page.Metadata["retry_after_exhausted"] = "true"
+		}
 
 		// Extract child links only for HTML content.
 		if strings.Contains(strings.ToLower(contentType), "html") {
 			baseURL, _ := url.Parse(item.URL)
-			links := extractLinks(baseURL, body)
+			links := extractLinks(baseURL, body, c.opts.IgnoreNofollow)
 			page.Links = c.filterAndEnqueueChildren(links, item.Depth)
 		}
 
-		if err := c.opts.Visitor.VisitPage(ctx, page); err != nil {
+		if c.opts.DedupByContent {
+			hash := contentFingerprint(contentType, body)
+			page.Metadata["content_hash"] = hash
+			// Claim records the page as a duplicate of the first
+			// URL with this content (see Duplicates).
+			if _, dup := c.contents.Claim(hash, page.URL); dup {
+				continue
+			}
+		}
+
+		if err := deliver(page); err != nil {
 			return err
 		}
 	}
 }
 
+// skipAfterHead issues a HEAD for rawURL and reports whether the URL
+// should be skipped: it is not HTML or is larger than MaxContentLength.
+// Any failure to learn the metadata keeps the URL.
+func (c *Crawler) skipAfterHead(ctx context.Context, rawURL string) bool {
+	c.throttle.Wait(rawURL)
+
+	resp, err := c.fetcher.Head(ctx, rawURL)
+	if err != nil || resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return false
+	}
+
+	if ct := resp.Header.Get("Content-Type"); ct != "" && !strings.Contains(strings.ToLower(ct), "html") {
+		return true
+	}
+	if c.opts.MaxContentLength > 0 {
+		n, err := strconv.ParseInt(resp.Header.Get("Content-Length"), 10, 64)
+		if err == nil && n > c.opts.MaxContentLength {
+			return true
+		}
+	}
+	return false
+}
+
 // normalizeStartURL normalizes the starting URL into an absolute, canonical form
 // and returns (normalizedURL, host, error).
 func (c *Crawler) normalizeStartURL(raw string) (string, string, error) {
@@ -284,8 +483,10 @@ func (c *Crawler) hostAllowed(host string) bool {
 		return false
 	}
 
-	if c.opts.SameHostOnly && c.startHost != "" && host != c.startHost {
-		return false
+	if c.opts.SameHostOnly && len(c.startHosts) > 0 {
+		if _, ok := c.startHosts[host]; !ok {
+			return false
+		}
 	}
 
 	if _, blocked := c.disallowedDomains[host]; blocked {
@@ -302,7 +503,7 @@ func (c *Crawler) hostAllowed(host string) bool {
 }
 
 // filterAndEnqueueChildren normalizes discovered links, applies host/domain
-// rules, depth rules, and visited-set checks, enqueues valid children into
+// rules, path rules, depth rules, and visited-set checks, enqueues valid children into
 // the frontier, and returns the list of accepted child URLs.
 func (c *Crawler) filterAndEnqueueChildren(links []string, parentDepth int) []string {
 	if len(links) == 0 {
@@ -324,13 +525,17 @@ func (c *Crawler) filterAndEnqueueChildren(links []string, parentDepth int) []st
 		if !c.hostAllowed(host) {
 			continue
 		}
+		if !c.paths.Allowed(norm) {
+			continue
+		}
 		if !c.visited.MarkVisited(norm) {
 			continue
 		}
 
 		c.frontier.Enqueue(FrontierItem{
-			URL:   norm,
-			Depth: nextDepth,
+			URL:      norm,
+			Depth:    nextDepth,
+			Priority: c.priority(norm, nextDepth),
 		})
 		accepted = append(accepted, norm)
 	}
@@ -341,6 +546,15 @@ func (c *Crawler) filterAndEnqueueChildren(links []string, parentDepth int) []st
 	return accepted
 }
 
+// priority returns the frontier priority for url at depth, using the
+// configured PriorityFunc when present.
+func (c *Crawler) priority(url string, depth int) int {
+	if c.opts.Priority == nil {
+		return 0
+	}
+	return c.opts.Priority(url, depth)
+}
+
 // normalizeURLForChild normalizes a child URL string into an absolute form
 // when it is already absolute. Relative resolution is handled by extractLinks.
 // Here we simply parse and canonicalize scheme, host, and fragment.
@@ -366,55 +580,86 @@ func normalizeURLForChild(raw string) (string, string) {
 	return u.String(), u.Host
 }
 
-// extractLinks performs a simple, conservative extraction of links from
-// an HTML document by scanning for href="..." or href='...' attributes.
+// ExtractLinks returns every http(s) link in htmlBody, resolved against
+// baseURL, without fragments and de-duplicated in document order. Unlike
+// the crawler's own link discovery it ignores nofollow hints, since it
+// reports what a page links to rather than what should be crawled.
+func ExtractLinks(baseURL, htmlBody string) []string {
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return nil
+	}
+	return extractLinks(base, htmlBody, true)
+}
+
+// ResolveLink resolves href against base the way link extraction does:
+// the result is absolute, http or https, lowercased in its host and
+// free of any fragment. It returns "" for other schemes and bad URLs.
+func ResolveLink(base *url.URL, href string) string {
+	abs, _ := resolveRelativeURL(base, strings.TrimSpace(href))
+	return abs
+}
+
+// extractLinks tokenizes an HTML document and collects the href of
+// every element that carries one, resolved against base and
+// de-duplicated in document order.
 //
-// It resolves relative links against the provided base URL and returns
-// a de-duplicated slice of absolute URLs.
+// Unless ignoreNofollow is set, links marked rel="nofollow" are skipped,
+// and a page-level <meta name="robots"> containing "nofollow" or "none"
+// yields no links at all.
 //
-// This is intentionally minimal and does not attempt to be a perfect
-// HTML parser; Aether's more advanced HTML parsing pipeline is used
-// elsewhere when deep extraction is required.
-func extractLinks(base *url.URL, htmlBody string) []string {
+// This is intentionally minimal and does not build a DOM; Aether's more
+// advanced HTML parsing pipeline is used elsewhere when deep extraction
+// is required.
+func extractLinks(base *url.URL, htmlBody string, ignoreNofollow bool) []string {
 	if base == nil {
 		return nil
 	}
 
 	var links []string
-	s := htmlBody
-	i := 0
+	z := xhtml.NewTokenizer(strings.NewReader(htmlBody))
 
 	for {
-		idx := strings.Index(s[i:], "href=")
-		if idx < 0 {
-			break
-		}
-		idx += i
-		if idx+6 > len(s) {
+		tt := z.Next()
+		if tt == xhtml.ErrorToken {
 			break
 		}
-
-		quote := s[idx+5]
-		if quote != '"' && quote != '\'' {
-			i = idx + 5
+		if tt != xhtml.StartTagToken && tt != xhtml.SelfClosingTagToken {
 			continue
 		}
 
-		start := idx + 6
-		end := strings.IndexByte(s[start:], quote)
-		if end < 0 {
-			break
+		tag := z.Token()
+		var href, rel, name, content string
+		for _, a := range tag.Attr {
+			switch strings.ToLower(a.Key) {
+			case "href":
+				href = strings.TrimSpace(a.Val)
+			case "rel":
+				rel = a.Val
+			case "name":
+				name = a.Val
+			case "content":
+				content = a.Val
+			}
 		}
-		end = start + end
 
-		href := strings.TrimSpace(s[start:end])
-		if href != "" {
-			if abs, _ := resolveRelativeURL(base, href); abs != "" {
-				links = append(links, abs)
+		if tag.DataAtom == atom.Meta {
+			if !ignoreNofollow && strings.EqualFold(strings.TrimSpace(name), "robots") &&
+				(hasToken(content, "nofollow") || hasToken(content, "none")) {
+				return nil
 			}
+			continue
 		}
 
-		i = end + 1
+		if href == "" {
+			continue
+		}
+		if !ignoreNofollow && hasToken(rel, "nofollow") {
+			continue
+		}
+		if abs, _ := resolveRelativeURL(base, href); abs != "" {
+			links = append(links, abs)
+		}
 	}
 
 	if len(links) == 0 {
@@ -435,6 +680,17 @@ func extractLinks(base *url.URL, htmlBody string) []string {
 	return out
 }
 
+// hasToken reports whether the space- or comma-separated list s contains
+// tok, ignoring case.
+func hasToken(s, tok string) bool {
+	for _, f := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' || r == '\n' }) {
+		if strings.EqualFold(f, tok) {
+			return true
+		}
+	}
+	return false
+}
+
 // resolveRelativeURL resolves href against base and returns an absolute,
 // canonicalized URL and its host.
 func resolveRelativeURL(base *url.URL, href string) (string, string) {
@@ -0,0 +1,370 @@
+// internal/crawl/crawler_test.go
+package crawl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Nibir1/Aether/internal/clock"
+	"github.com/Nibir1/Aether/internal/config"
+	"github.com/Nibir1/Aether/internal/httpclient"
+	"github.com/Nibir1/Aether/internal/log"
+)
+
+// newTestSite serves pages whose bodies link to the given paths.
+func newTestSite(t *testing.T, pages map[string][]string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		links, ok := pages[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		var b strings.Builder
+		b.WriteString("<html><body>")
+		for _, l := range links {
+			fmt.Fprintf(&b, `<a href="%s">%s</a>`, l, l)
+		}
+		b.WriteString("</body></html>")
+		w.Write([]byte(b.String()))
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newTestFetcher() *httpclient.Client {
+	return httpclient.New(config.Default(), log.New(false), nil)
+}
+
+func TestCrawler_PriorityVisitsHighFirst(t *testing.T) {
+	srv := newTestSite(t, map[string][]string{
+		"/":           {"/blog/a", "/blog/b", "/docs/intro"},
+		"/blog/a":     nil,
+		"/blog/b":     nil,
+		"/docs/intro": nil,
+	})
+
+	var visited []string
+	opts := Options{
+		MaxDepth: 1,
+		Priority: func(u string, depth int) int {
+			if strings.Contains(u, "/docs/") {
+				return 10
+			}
+			return 0
+		},
+		Visitor: VisitorFunc(func(ctx context.Context, p *Page) error {
+			visited = append(visited, strings.TrimPrefix(p.URL, srv.URL))
+			return nil
+		}),
+	}
+
+	c, err := NewCrawler(newTestFetcher(), opts)
+	if err != nil {
+		t.Fatalf("NewCrawler error: %v", err)
+	}
+	if err := c.Run(context.Background(), srv.URL+"/"); err != nil {
+		t.Fatalf("Run error: %v", err)
+	}
+
+	want := []string{"/", "/docs/intro", "/blog/a", "/blog/b"}
+	if strings.Join(visited, ",") != strings.Join(want, ",") {
+		t.Fatalf("got %v, want %v", visited, want)
+	}
+}
+
+func TestCrawler_DedupByContent(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		switch r.URL.Path {
+		case "/":
+			w.Write([]byte(`<a href="/story">s</a><a href="/story/print">p</a><a href="/other">o</a>`))
+		case "/story":
+			w.Write([]byte(`<html><body><p>Same   story text.</p><a href="/deep">d</a></body></html>`))
+		case "/story/print":
+			w.Write([]byte(`<html><body class="print"><p>Same story
+text.</p><script>track()</script><a href="/deep">d</a></body></html>`))
+		case "/other":
+			w.Write([]byte(`<p>Different text.</p>`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	var visited []string
+	c, err := NewCrawler(newTestFetcher(), Options{
+		MaxDepth:       1,
+		DedupByContent: true,
+		Visitor: VisitorFunc(func(ctx context.Context, p *Page) error {
+			if p.Metadata["content_hash"] == "" {
+				t.Errorf("%s: missing content_hash", p.URL)
+			}
+			visited = append(visited, strings.TrimPrefix(p.URL, srv.URL))
+			return nil
+		}),
+	})
+	if err != nil {
+		t.Fatalf("NewCrawler error: %v", err)
+	}
+	if err := c.Run(context.Background(), srv.URL+"/"); err != nil {
+		t.Fatalf("Run error: %v", err)
+	}
+
+	want := []string{"/", "/story", "/other"}
+	if strings.Join(visited, ",") != strings.Join(want, ",") {
+		t.Fatalf("got %v, want %v", visited, want)
+	}
+
+	dups := c.Duplicates()
+	if len(dups) != 1 {
+		t.Fatalf("duplicates: got %v, want one entry", dups)
+	}
+	if got, want := dups[srv.URL+"/story/print"], srv.URL+"/story"; got != want {
+		t.Fatalf("duplicate_of: got %q, want %q", got, want)
+	}
+}
+
+func TestCrawler_AllowDenyPaths(t *testing.T) {
+	srv := newTestSite(t, map[string][]string{
+		"/":                   {"/docs/intro", "/docs/internal/secrets", "/blog/post", "/docs/search?q=go", "/docs/search"},
+		"/docs/intro":         nil,
+		"/docs/internal/keys": nil,
+		"/blog/post":          nil,
+		"/docs/search":        nil,
+	})
+
+	var visited []string
+	c, err := NewCrawler(newTestFetcher(), Options{
+		MaxDepth:   1,
+		AllowPaths: []string{"/docs/"},
+		DenyPaths:  []string{"/docs/internal/", "/docs/search?*"},
+		Visitor: VisitorFunc(func(ctx context.Context, p *Page) error {
+			visited = append(visited, strings.TrimPrefix(p.URL, srv.URL))
+			return nil
+		}),
+	})
+	if err != nil {
+		t.Fatalf("NewCrawler error: %v", err)
+	}
+	if err := c.Run(context.Background(), srv.URL+"/"); err != nil {
+		t.Fatalf("Run error: %v", err)
+	}
+
+	want := []string{"/", "/docs/intro", "/docs/search"}
+	if strings.Join(visited, ",") != strings.Join(want, ",") {
+		t.Fatalf("got %v, want %v", visited, want)
+	}
+}
+
+func TestCrawler_HeadBeforeGet(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		gets []string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Method == http.MethodHead && r.URL.Path == "/nohead" {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		ctype, body := "text/html; charset=utf-8", `<p>page</p>`
+		switch r.URL.Path {
+		case "/":
+			body = `<a href="/doc">d</a><a href="/file.pdf">f</a><a href="/big">b</a><a href="/nohead">n</a>`
+		case "/file.pdf":
+			ctype = "application/pdf"
+		case "/big":
+			if r.Method == http.MethodHead {
+				w.Header().Set("Content-Length", "5000000")
+			}
+		}
+		w.Header().Set("Content-Type", ctype)
+		if r.Method == http.MethodHead {
+			return
+		}
+		mu.Lock()
+		gets = append(gets, r.URL.Path)
+		mu.Unlock()
+		w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	var visited []string
+	c, err := NewCrawler(newTestFetcher(), Options{
+		MaxDepth:         1,
+		HeadBeforeGet:    true,
+		MaxContentLength: 1 << 20,
+		Visitor: VisitorFunc(func(ctx context.Context, p *Page) error {
+			visited = append(visited, strings.TrimPrefix(p.URL, srv.URL))
+			return nil
+		}),
+	})
+	if err != nil {
+		t.Fatalf("NewCrawler error: %v", err)
+	}
+	if err := c.Run(context.Background(), srv.URL+"/"); err != nil {
+		t.Fatalf("Run error: %v", err)
+	}
+
+	want := "/,/doc,/nohead"
+	if got := strings.Join(visited, ","); got != want {
+		t.Fatalf("visited: got %q, want %q", got, want)
+	}
+	if got := strings.Join(gets, ","); got != want {
+		t.Fatalf("GET requests: got %q, want %q", got, want)
+	}
+}
+
+func TestCrawler_RetryAfterThenSuccess(t *testing.T) {
+	var hits int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		hits++
+		if hits == 1 {
+			w.Header().Set("Retry-After", "5")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<p>ok</p>`))
+	}))
+	defer srv.Close()
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clk := clock.NewFake(start)
+
+	var pages []*Page
+	c, err := NewCrawler(newTestFetcher(), Options{
+		MaxDepth: 0,
+		Clock:    clk,
+		Visitor: VisitorFunc(func(ctx context.Context, p *Page) error {
+			pages = append(pages, p)
+			return nil
+		}),
+	})
+	if err != nil {
+		t.Fatalf("NewCrawler error: %v", err)
+	}
+	if err := c.Run(context.Background(), srv.URL+"/"); err != nil {
+		t.Fatalf("Run error: %v", err)
+	}
+
+	if len(pages) != 1 {
+		t.Fatalf("pages: got %d, want 1", len(pages))
+	}
+	p := pages[0]
+	if p.StatusCode != http.StatusOK {
+		t.Fatalf("status: got %d, want %d", p.StatusCode, http.StatusOK)
+	}
+	if got := p.Metadata["retry_count"]; got != "1" {
+		t.Fatalf("retry_count: got %q, want %q", got, "1")
+	}
+	if got := p.Metadata["retry_after_seconds"]; got != "5" {
+		t.Fatalf("retry_after_seconds: got %q, want %q", got, "5")
+	}
+	if waited := clk.Now().Sub(start); waited < 5*time.Second {
+		t.Fatalf("retry did not wait for Retry-After: waited %v", waited)
+	}
+}
+
+func TestCrawler_RetryAfterExhausted(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Retry-After", "1")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	var pages []*Page
+	c, err := NewCrawler(newTestFetcher(), Options{
+		MaxDepth:           0,
+		Clock:              clock.NewFake(time.Now()),
+		RetryAfterAttempts: 1,
+		Visitor: VisitorFunc(func(ctx context.Context, p *Page) error {
+			pages = append(pages, p)
+			return nil
+		}),
+	})
+	if err != nil {
+		t.Fatalf("NewCrawler error: %v", err)
+	}
+	if err := c.Run(context.Background(), srv.URL+"/"); err != nil {
+		t.Fatalf("Run error: %v", err)
+	}
+
+	if len(pages) != 1 || pages[0].StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected one 503 page, got %d pages", len(pages))
+	}
+	if got := pages[0].Metadata["retry_after_exhausted"]; got != "true" {
+		t.Fatalf("retry_after_exhausted: got %q, want %q", got, "true")
+	}
+}
+
+func TestCrawler_RespectsNofollow(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		switch r.URL.Path {
+		case "/":
+			w.Write([]byte(`<a href="/followed">f</a>
+<a rel="nofollow" href="/skipped">s</a>
+<a href="/sponsored" rel="sponsored NoFollow">p</a>
+<a href='/also-followed' rel="noopener">a</a>`))
+		case "/meta":
+			w.Write([]byte(`<head><meta name="robots" content="noindex, nofollow"></head><a href="/hidden">h</a>`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	run := func(start string, ignore bool) []string {
+		var links []string
+		c, err := NewCrawler(newTestFetcher(), Options{
+			MaxDepth:       1,
+			IgnoreNofollow: ignore,
+			Visitor: VisitorFunc(func(ctx context.Context, p *Page) error {
+				if p.URL == srv.URL+start {
+					for _, l := range p.Links {
+						links = append(links, strings.TrimPrefix(l, srv.URL))
+					}
+				}
+				return nil
+			}),
+		})
+		if err != nil {
+			t.Fatalf("NewCrawler error: %v", err)
+		}
+		if err := c.Run(context.Background(), srv.URL+start); err != nil {
+			t.Fatalf("Run error: %v", err)
+		}
+		return links
+	}
+
+	if got, want := strings.Join(run("/", false), ","), "/followed,/also-followed"; got != want {
+		t.Fatalf("followed links: got %q, want %q", got, want)
+	}
+	if got := run("/meta", false); len(got) != 0 {
+		t.Fatalf("meta nofollow: got %v, want none", got)
+	}
+	if got, want := strings.Join(run("/", true), ","), "/followed,/skipped,/sponsored,/also-followed"; got != want {
+		t.Fatalf("IgnoreNofollow: got %q, want %q", got, want)
+	}
+}
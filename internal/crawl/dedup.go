@@ -0,0 +1,119 @@
+// internal/crawl/dedup.go
+//
+// This file implements content-based deduplication for the crawl
+// subsystem. Many sites serve the same content under several URLs
+// (print versions, pagination shells, tracking parameters); with
+// Options.DedupByContent the crawler fingerprints each page's visible
+// text and delivers only the first page seen with a given fingerprint;
+// every later page is recorded as a duplicate of that first URL.
+//
+// Design notes:
+//   - exact-duplicate detection on the visible text, so markup-only
+//     differences (attributes, comments, whitespace) do not matter
+//   - script/style/noscript contents are ignored
+//   - thread-safe: protected by a mutex
+
+package crawl
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+
+	xhtml "golang.org/x/net/html"
+)
+
+// ContentIndex maps content fingerprints to the first URL that had them,
+// and each duplicate URL to that first URL.
+type ContentIndex struct {
+	mu    sync.Mutex
+	first map[string]string
+	dups  map[string]string
+}
+
+// NewContentIndex constructs an empty ContentIndex.
+func NewContentIndex() *ContentIndex {
+	return &ContentIndex{
+		first: make(map[string]string),
+		dups:  make(map[string]string),
+	}
+}
+
+// Claim records url as the owner of hash if the hash is new. For an
+// already-seen hash it records url as a duplicate and returns the owning
+// URL and true.
+func (ci *ContentIndex) Claim(hash, url string) (string, bool) {
+	ci.mu.Lock()
+	defer ci.mu.Unlock()
+
+	if orig, ok := ci.first[hash]; ok {
+		ci.dups[url] = orig
+		return orig, true
+	}
+	ci.first[hash] = url
+	return "", false
+}
+
+// Duplicates returns a copy of the duplicates recorded so far, mapping
+// each duplicate URL to the URL of the first page with its content.
+func (ci *ContentIndex) Duplicates() map[string]string {
+	ci.mu.Lock()
+	defer ci.mu.Unlock()
+
+	out := make(map[string]string, len(ci.dups))
+	for k, v := range ci.dups {
+		out[k] = v
+	}
+	return out
+}
+
+// contentFingerprint hashes the visible text of an HTML body, or the
+// whitespace-normalized body for other content types.
+func contentFingerprint(contentType, body string) string {
+	text := body
+	if strings.Contains(strings.ToLower(contentType), "html") {
+		text = visibleText(body)
+	}
+	text = strings.Join(strings.Fields(text), " ")
+
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])
+}
+
+// visibleText returns the text nodes of an HTML document, skipping
+// non-rendered elements.
+func visibleText(body string) string {
+	z := xhtml.NewTokenizer(strings.NewReader(body))
+
+	var b strings.Builder
+	skip := 0
+	for {
+		switch z.Next() {
+		case xhtml.ErrorToken:
+			return b.String()
+		case xhtml.StartTagToken:
+			if isHiddenTag(z) {
+				skip++
+			}
+		case xhtml.EndTagToken:
+			if isHiddenTag(z) && skip > 0 {
+				skip--
+			}
+		case xhtml.TextToken:
+			if skip == 0 {
+				b.Write(z.Text())
+				b.WriteByte(' ')
+			}
+		}
+	}
+}
+
+func isHiddenTag(z *xhtml.Tokenizer) bool {
+	name, _ := z.TagName()
+	switch string(name) {
+	case "script", "style", "noscript", "template":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+// internal/crawl/paths.go
+//
+// This file implements path-level crawl scoping. Host rules decide which
+// sites are crawled; PathFilter decides which URLs within them are.
+//
+// Pattern syntax:
+//   - a pattern without "*" is a plain prefix ("/docs/" matches
+//     "/docs/intro" and "/docs/a/b")
+//   - "*" matches any run of characters, including "/" and "?"
+//   - every other character is literal, so "/search?*" matches
+//     "/search?q=go" but not "/search"
+//
+// Patterns are matched against the URL path followed by "?" and the raw
+// query when a query is present.
+
+package crawl
+
+import (
+	"net/url"
+	"strings"
+)
+
+// PathFilter decides whether a URL is in scope based on allow and deny
+// path patterns. Deny patterns win over allow patterns; an empty allow
+// list admits every path.
+type PathFilter struct {
+	allow []string
+	deny  []string
+}
+
+// NewPathFilter constructs a PathFilter. Blank patterns are ignored.
+func NewPathFilter(allow, deny []string) *PathFilter {
+	return &PathFilter{
+		allow: cleanPatterns(allow),
+		deny:  cleanPatterns(deny),
+	}
+}
+
+// Allowed reports whether rawURL passes the filter. URLs that cannot be
+// parsed are rejected.
+func (f *PathFilter) Allowed(rawURL string) bool {
+	if f == nil || (len(f.allow) == 0 && len(f.deny) == 0) {
+		return true
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+	target := u.Path
+	if target == "" {
+		target = "/"
+	}
+	if u.RawQuery != "" {
+		target += "?" + u.RawQuery
+	}
+
+	for _, p := range f.deny {
+		if matchPathPattern(p, target) {
+			return false
+		}
+	}
+	if len(f.allow) == 0 {
+		return true
+	}
+	for _, p := range f.allow {
+		if matchPathPattern(p, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// cleanPatterns trims patterns and drops empty ones.
+func cleanPatterns(in []string) []string {
+	var out []string
+	for _, p := range in {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// matchPathPattern reports whether target matches pattern. Patterns
+// without "*" are prefixes; otherwise the pattern must match the whole
+// target, with each "*" matching any (possibly empty) substring.
+func matchPathPattern(pattern, target string) bool {
+	if !strings.Contains(pattern, "*") {
+		return strings.HasPrefix(target, pattern)
+	}
+
+	parts := strings.Split(pattern, "*")
+	if !strings.HasPrefix(target, parts[0]) {
+		return false
+	}
+	target = target[len(parts[0]):]
+
+	last := len(parts) - 1
+	for _, part := range parts[1:last] {
+		i := strings.Index(target, part)
+		if i < 0 {
+			return false
+		}
+		target = target[i+len(part):]
+	}
+	return strings.HasSuffix(target, parts[last])
+}
@@ -16,6 +16,7 @@
 //   • Mutex-protected for concurrency
 //   • A configurable FetchDelay (min time gap between requests)
 //   • Waits (sleep) when needed before allowing the caller to continue
+//   • Per-host request counts, readable as a consistent Snapshot
 
 package crawl
 
@@ -23,6 +24,8 @@ import (
 	"net/url"
 	"sync"
 	"time"
+
+	"github.com/Nibir1/Aether/internal/clock"
 )
 
 // PerHostThrottle enforces a minimum delay between requests to the same host.
@@ -33,16 +36,28 @@ import (
 type PerHostThrottle struct {
 	mu         sync.Mutex
 	lastAccess map[string]time.Time
+	notBefore  map[string]time.Time
+	requests   map[string]int
 	minDelay   time.Duration
+	clock      clock.Clock
 }
 
 // NewPerHostThrottle constructs a new throttle enforcer.
 //
 // minDelay = 0 means "no throttling".
 func NewPerHostThrottle(minDelay time.Duration) *PerHostThrottle {
+	return NewPerHostThrottleWithClock(minDelay, nil)
+}
+
+// NewPerHostThrottleWithClock is NewPerHostThrottle with delays measured
+// and slept on clk (nil means real time).
+func NewPerHostThrottleWithClock(minDelay time.Duration, clk clock.Clock) *PerHostThrottle {
 	return &PerHostThrottle{
 		lastAccess: make(map[string]time.Time),
+		notBefore:  make(map[string]time.Time),
+		requests:   make(map[string]int),
 		minDelay:   minDelay,
+		clock:      clock.Or(clk),
 	}
 }
 
@@ -50,13 +65,9 @@ func NewPerHostThrottle(minDelay time.Duration) *PerHostThrottle {
 //
 // The caller should invoke Wait() *immediately before* performing a network
 // fetch. This method blocks only the worker hitting this specific host.
-// Workers hitting other hosts proceed unhindered.
+// Workers hitting other hosts proceed unhindered. A deferral recorded with
+// Defer is honoured even when throttling is otherwise disabled.
 func (p *PerHostThrottle) Wait(rawURL string) {
-	if p.minDelay <= 0 {
-		// Throttling disabled.
-		return
-	}
-
 	host := extractHost(rawURL)
 	if host == "" {
 		// Unknown host → treat as no-throttle.
@@ -64,28 +75,84 @@ func (p *PerHostThrottle) Wait(rawURL string) {
 	}
 
 	p.mu.Lock()
-	last, ok := p.lastAccess[host]
-	now := time.Now()
-
-	if ok {
-		elapsed := now.Sub(last)
-		if elapsed < p.minDelay {
-			sleepFor := p.minDelay - elapsed
-			p.mu.Unlock()
-			time.Sleep(sleepFor)
-			// After sleeping, update last-access timestamp.
-			p.mu.Lock()
-			p.lastAccess[host] = time.Now()
-			p.mu.Unlock()
-			return
+	now := p.clock.Now()
+	p.requests[host]++
+
+	var sleepFor time.Duration
+	if until, ok := p.notBefore[host]; ok {
+		delete(p.notBefore, host)
+		sleepFor = until.Sub(now)
+	}
+	if p.minDelay > 0 {
+		if last, ok := p.lastAccess[host]; ok {
+			if d := p.minDelay - now.Sub(last); d > sleepFor {
+				sleepFor = d
+			}
 		}
 	}
+	p.mu.Unlock()
+
+	if sleepFor > 0 {
+		p.clock.Sleep(sleepFor)
+	}
+
+	if p.minDelay > 0 {
+		p.mu.Lock()
+		p.lastAccess[host] = p.clock.Now()
+		p.mu.Unlock()
+	}
+}
+
+// Defer holds back the next request to rawURL's host until d has
+// elapsed, as asked for by a server's Retry-After header. Later calls
+// extend but never shorten an existing deferral.
+func (p *PerHostThrottle) Defer(rawURL string, d time.Duration) {
+	host := extractHost(rawURL)
+	if host == "" || d <= 0 {
+		return
+	}
 
-	// No previous record or delay satisfied.
-	p.lastAccess[host] = now
+	p.mu.Lock()
+	until := p.clock.Now().Add(d)
+	if cur, ok := p.notBefore[host]; !ok || until.After(cur) {
+		p.notBefore[host] = until
+	}
 	p.mu.Unlock()
 }
 
+// HostState is the politeness state of one host.
+type HostState struct {
+	// Requests counts the requests let through Wait for the host.
+	Requests int
+
+	// NextAllowed is the earliest time Wait lets the next request to
+	// the host proceed without sleeping; zero when it is not held back.
+	NextAllowed time.Time
+}
+
+// Snapshot returns the state of every host seen so far. All hosts are
+// read under one lock, so the result is consistent; it is a copy and
+// safe to use while the throttle keeps running.
+func (p *PerHostThrottle) Snapshot() map[string]HostState {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	out := make(map[string]HostState, len(p.requests))
+	for host, n := range p.requests {
+		st := HostState{Requests: n}
+		if p.minDelay > 0 {
+			if last, ok := p.lastAccess[host]; ok {
+				st.NextAllowed = last.Add(p.minDelay)
+			}
+		}
+		if until, ok := p.notBefore[host]; ok && until.After(st.NextAllowed) {
+			st.NextAllowed = until
+		}
+		out[host] = st
+	}
+	return out
+}
+
 // extractHost parses the URL and returns the host portion.
 //
 // This helper is isolated here so it can evolve without affecting crawler.go.
@@ -0,0 +1,156 @@
+// internal/crawl/pipeline.go
+//
+// The extraction pipeline decouples CPU-heavy page processing (article
+// extraction) from fetching. The fetch loop hands each page to a pool of
+// extraction workers and immediately moves on to the next URL; a single
+// emitter goroutine then delivers processed pages to the Visitor, either
+// in fetch order (the default) or as soon as each page is ready.
+//
+// Extraction failures are recorded on the Page and never stop the crawl.
+// A Visitor error cancels the crawl; the pipeline drains cleanly before
+// Run returns, so no goroutines outlive the crawl.
+
+package crawl
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// ExtractFunc processes a fetched page before it reaches the Visitor,
+// typically storing its result in page.Extracted. It runs on extraction
+// worker goroutines, concurrently with fetching and with other pages.
+type ExtractFunc func(ctx context.Context, page *Page) error
+
+type extractJob struct {
+	seq  uint64
+	page *Page
+}
+
+type extractPipeline struct {
+	extract   ExtractFunc
+	visitor   Visitor
+	unordered bool
+
+	jobs    chan extractJob
+	results chan extractJob
+	workers sync.WaitGroup
+	done    chan struct{}
+
+	cancel context.CancelFunc
+	next   uint64
+	err    error // first Visitor error; read after done is closed
+}
+
+// newExtractPipeline starts the workers and emitter. The returned context
+// is canceled when the Visitor fails; the fetch loop should run under it.
+func newExtractPipeline(ctx context.Context, opts Options) (*extractPipeline, context.Context) {
+	workers := opts.ExtractWorkers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	p := &extractPipeline{
+		extract:   opts.Extract,
+		visitor:   opts.Visitor,
+		unordered: opts.UnorderedVisits,
+		jobs:      make(chan extractJob, workers),
+		results:   make(chan extractJob, workers),
+		done:      make(chan struct{}),
+		cancel:    cancel,
+	}
+
+	for i := 0; i < workers; i++ {
+		p.workers.Add(1)
+		go p.work(ctx)
+	}
+	go p.emit(ctx)
+
+	return p, ctx
+}
+
+// submit queues page for extraction, blocking while all workers are busy.
+func (p *extractPipeline) submit(ctx context.Context, page *Page) error {
+	job := extractJob{seq: p.next, page: page}
+	p.next++
+
+	select {
+	case p.jobs <- job:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// close stops accepting pages, waits for in-flight pages to be extracted
+// and delivered, and returns the first Visitor error, if any.
+func (p *extractPipeline) close() error {
+	close(p.jobs)
+	p.workers.Wait()
+	close(p.results)
+	<-p.done
+	p.cancel()
+	return p.err
+}
+
+func (p *extractPipeline) work(ctx context.Context) {
+	defer p.workers.Done()
+	for job := range p.jobs {
+		if ctx.Err() == nil {
+			job.page.ExtractErr = p.runExtract(ctx, job.page)
+		}
+		p.results <- job
+	}
+}
+
+// runExtract calls the ExtractFunc, converting a panic into an error so a
+// single malformed page cannot take down the crawl.
+func (p *extractPipeline) runExtract(ctx context.Context, page *Page) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("crawl: extract panic on %s: %v", page.URL, r)
+		}
+	}()
+	return p.extract(ctx, page)
+}
+
+// emit delivers pages to the Visitor. In ordered mode, pages that finish
+// early are held until every earlier page has been delivered. After a
+// Visitor error or cancellation, remaining results are drained unvisited.
+func (p *extractPipeline) emit(ctx context.Context) {
+	defer close(p.done)
+
+	pending := make(map[uint64]*Page)
+	var want uint64
+
+	for job := range p.results {
+		if p.err != nil || ctx.Err() != nil {
+			continue
+		}
+		if p.unordered {
+			p.visit(ctx, job.page)
+			continue
+		}
+
+		pending[job.seq] = job.page
+		for p.err == nil {
+			page, ok := pending[want]
+			if !ok {
+				break
+			}
+			delete(pending, want)
+			want++
+			p.visit(ctx, page)
+		}
+	}
+}
+
+func (p *extractPipeline) visit(ctx context.Context, page *Page) {
+	if err := p.visitor.VisitPage(ctx, page); err != nil {
+		p.err = err
+		p.cancel()
+	}
+}
@@ -0,0 +1,120 @@
+// internal/crawl/pipeline_test.go
+package crawl
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func pipelineSite(t *testing.T) string {
+	return newTestSite(t, map[string][]string{
+		"/":  {"/a", "/b", "/c", "/d"},
+		"/a": nil,
+		"/b": nil,
+		"/c": nil,
+		"/d": nil,
+	}).URL
+}
+
+func TestPipeline_OrderedVisitsAndExtractErrors(t *testing.T) {
+	base := pipelineSite(t)
+
+	var visited []string
+	opts := Options{
+		MaxDepth:       1,
+		ExtractWorkers: 3,
+		Extract: func(ctx context.Context, p *Page) error {
+			// Earlier pages finish last to exercise reordering.
+			if strings.HasSuffix(p.URL, "/a") {
+				time.Sleep(20 * time.Millisecond)
+			}
+			if strings.HasSuffix(p.URL, "/c") {
+				return errors.New("boom")
+			}
+			if strings.HasSuffix(p.URL, "/d") {
+				panic("bad page")
+			}
+			p.Extracted = len(p.Content)
+			return nil
+		},
+		Visitor: VisitorFunc(func(ctx context.Context, p *Page) error {
+			path := strings.TrimPrefix(p.URL, base)
+			visited = append(visited, path)
+			switch path {
+			case "/c", "/d":
+				if p.ExtractErr == nil {
+					t.Errorf("%s: expected ExtractErr", path)
+				}
+			default:
+				if p.ExtractErr != nil || p.Extracted == nil {
+					t.Errorf("%s: got err %v, extracted %v", path, p.ExtractErr, p.Extracted)
+				}
+			}
+			return nil
+		}),
+	}
+
+	c, err := NewCrawler(newTestFetcher(), opts)
+	if err != nil {
+		t.Fatalf("NewCrawler error: %v", err)
+	}
+	if err := c.Run(context.Background(), base+"/"); err != nil {
+		t.Fatalf("Run error: %v", err)
+	}
+
+	want := "/,/a,/b,/c,/d"
+	if got := strings.Join(visited, ","); got != want {
+		t.Fatalf("visit order: got %q, want %q", got, want)
+	}
+}
+
+func TestPipeline_UnorderedVisitsAll(t *testing.T) {
+	base := pipelineSite(t)
+
+	var count atomic.Int32
+	opts := Options{
+		MaxDepth:        1,
+		UnorderedVisits: true,
+		Extract:         func(ctx context.Context, p *Page) error { return nil },
+		Visitor: VisitorFunc(func(ctx context.Context, p *Page) error {
+			count.Add(1)
+			return nil
+		}),
+	}
+
+	c, err := NewCrawler(newTestFetcher(), opts)
+	if err != nil {
+		t.Fatalf("NewCrawler error: %v", err)
+	}
+	if err := c.Run(context.Background(), base+"/"); err != nil {
+		t.Fatalf("Run error: %v", err)
+	}
+	if got := count.Load(); got != 5 {
+		t.Fatalf("visits: got %d, want 5", got)
+	}
+}
+
+func TestPipeline_VisitorErrorStopsCrawl(t *testing.T) {
+	base := pipelineSite(t)
+	stop := errors.New("stop")
+
+	opts := Options{
+		MaxDepth: 1,
+		Extract:  func(ctx context.Context, p *Page) error { return nil },
+		Visitor: VisitorFunc(func(ctx context.Context, p *Page) error {
+			return stop
+		}),
+	}
+
+	c, err := NewCrawler(newTestFetcher(), opts)
+	if err != nil {
+		t.Fatalf("NewCrawler error: %v", err)
+	}
+	if err := c.Run(context.Background(), base+"/"); !errors.Is(err, stop) {
+		t.Fatalf("Run error: got %v, want %v", err, stop)
+	}
+}
@@ -4,40 +4,67 @@
 // This file defines the frontier queue used by the crawler to manage URLs
 // pending visitation.
 //
-// The queue is a simple FIFO structure storing (URL, depth) pairs. It is
-// safe for concurrent use by the crawler workers via a mutex, but it is
-// deliberately minimal: there is no blocking or condition variable logic
+// The queue is a priority queue storing (URL, depth, priority) entries.
+// Higher priorities are served first; items with equal priority are served
+// in FIFO (insertion) order, so a crawl without a priority function behaves
+// exactly like a plain FIFO queue.
+//
+// It is safe for concurrent use by the crawler workers via a mutex, but it
+// is deliberately minimal: there is no blocking or condition variable logic
 // here. The crawler orchestrator controls when to poll or stop.
 
 package crawl
 
-import "sync"
+import (
+	"container/heap"
+	"sync"
+	"time"
+)
 
 // FrontierItem represents a single entry in the crawl frontier.
 // Depth is measured from the starting URL (depth 0).
 type FrontierItem struct {
 	URL   string
 	Depth int
+
+	// Priority orders items in the frontier; higher values are
+	// dequeued first. The zero value is the default priority.
+	Priority int
+
+	// Retries counts how often this URL was re-enqueued after a
+	// Retry-After response.
+	Retries int
+
+	// retryDelay is the Retry-After delay honoured for the last retry.
+	retryDelay time.Duration
+
+	// seq records insertion order to keep equal priorities FIFO.
+	seq uint64
 }
 
-// FrontierQueue is a thread-safe FIFO queue of FrontierItem values.
+// PriorityFunc assigns a priority to a URL discovered at the given depth.
+// Higher values are crawled first.
+type PriorityFunc func(url string, depth int) int
+
+// FrontierQueue is a thread-safe priority queue of FrontierItem values.
 //
 // The queue is used by the crawler to schedule which URLs to visit next.
 // It does not perform any URL normalization or filtering; those concerns
 // are handled by higher-level components (rules, visit map, etc.).
 type FrontierQueue struct {
-	mu    sync.Mutex
-	items []FrontierItem
+	mu      sync.Mutex
+	items   frontierHeap
+	nextSeq uint64
 }
 
 // NewFrontierQueue constructs an empty frontier queue.
 func NewFrontierQueue() *FrontierQueue {
 	return &FrontierQueue{
-		items: make([]FrontierItem, 0),
+		items: make(frontierHeap, 0),
 	}
 }
 
-// Enqueue adds a new item to the end of the queue.
+// Enqueue adds a new item to the queue according to its Priority.
 // It is safe to call from multiple goroutines.
 func (q *FrontierQueue) Enqueue(item FrontierItem) {
 	if item.URL == "" {
@@ -47,11 +74,14 @@ func (q *FrontierQueue) Enqueue(item FrontierItem) {
 	}
 
 	q.mu.Lock()
-	q.items = append(q.items, item)
+	item.seq = q.nextSeq
+	q.nextSeq++
+	heap.Push(&q.items, item)
 	q.mu.Unlock()
 }
 
-// Dequeue removes and returns the oldest item from the queue.
+// Dequeue removes and returns the highest-priority item from the queue.
+// Among items of equal priority the oldest is returned first.
 // The boolean return value is false if the queue is empty.
 func (q *FrontierQueue) Dequeue() (FrontierItem, bool) {
 	q.mu.Lock()
@@ -61,11 +91,7 @@ func (q *FrontierQueue) Dequeue() (FrontierItem, bool) {
 		return FrontierItem{}, false
 	}
 
-	it := q.items[0]
-	// shift slice
-	copy(q.items[0:], q.items[1:])
-	q.items = q.items[:len(q.items)-1]
-
+	it := heap.Pop(&q.items).(FrontierItem)
 	return it, true
 }
 
@@ -84,3 +110,35 @@ func (q *FrontierQueue) Len() int {
 func (q *FrontierQueue) Empty() bool {
 	return q.Len() == 0
 }
+
+//
+// ────────────────────────────────────────────────────────────────────────
+//                         HEAP IMPLEMENTATION
+// ────────────────────────────────────────────────────────────────────────
+//
+
+// frontierHeap implements heap.Interface ordered by (Priority desc, seq asc).
+type frontierHeap []FrontierItem
+
+func (h frontierHeap) Len() int { return len(h) }
+
+func (h frontierHeap) Less(i, j int) bool {
+	if h[i].Priority != h[j].Priority {
+		return h[i].Priority > h[j].Priority
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h frontierHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *frontierHeap) Push(x any) {
+	*h = append(*h, x.(FrontierItem))
+}
+
+func (h *frontierHeap) Pop() any {
+	old := *h
+	n := len(old)
+	it := old[n-1]
+	*h = old[:n-1]
+	return it
+}
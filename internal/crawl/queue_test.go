@@ -0,0 +1,40 @@
+// internal/crawl/queue_test.go
+package crawl
+
+import "testing"
+
+func TestFrontierQueue_EqualPrioritiesStayFIFO(t *testing.T) {
+	q := NewFrontierQueue()
+	for _, u := range []string{"a", "b", "c", "d"} {
+		q.Enqueue(FrontierItem{URL: u})
+	}
+
+	for _, want := range []string{"a", "b", "c", "d"} {
+		it, ok := q.Dequeue()
+		if !ok {
+			t.Fatalf("queue empty, want %q", want)
+		}
+		if it.URL != want {
+			t.Fatalf("got %q, want %q", it.URL, want)
+		}
+	}
+	if !q.Empty() {
+		t.Fatal("expected empty queue")
+	}
+}
+
+func TestFrontierQueue_HigherPriorityFirst(t *testing.T) {
+	q := NewFrontierQueue()
+	q.Enqueue(FrontierItem{URL: "low-1"})
+	q.Enqueue(FrontierItem{URL: "low-2"})
+	q.Enqueue(FrontierItem{URL: "high-1", Priority: 10})
+	q.Enqueue(FrontierItem{URL: "high-2", Priority: 10})
+
+	want := []string{"high-1", "high-2", "low-1", "low-2"}
+	for _, w := range want {
+		it, _ := q.Dequeue()
+		if it.URL != w {
+			t.Fatalf("got %q, want %q", it.URL, w)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+// internal/crawl/retryafter.go
+//
+// Retry-After handling for the crawl engine.
+//
+// A server that answers 429 Too Many Requests or 503 Service Unavailable
+// with a Retry-After header is telling the crawler when to come back.
+// Rather than delivering the error page (or giving up on the URL), the
+// crawler defers the host on its PerHostThrottle and re-enqueues the URL,
+// up to a bounded number of attempts and a bounded delay.
+
+package crawl
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Nibir1/Aether/internal/httpclient"
+)
+
+const (
+	// DefaultRetryAfterAttempts is used when Options.RetryAfterAttempts
+	// is zero.
+	DefaultRetryAfterAttempts = 2
+
+	// DefaultRetryAfterMax is used when Options.RetryAfterMax is zero.
+	DefaultRetryAfterMax = 60 * time.Second
+)
+
+// retryAfterDelay reports whether resp asks to be retried later and, if
+// so, how long to wait. Only 429 and 503 responses carrying a parseable
+// Retry-After header qualify.
+func retryAfterDelay(resp *httpclient.Response, now time.Time) (time.Duration, bool) {
+	if resp == nil || resp.Header == nil {
+		return 0, false
+	}
+	if resp.StatusCode != http.StatusTooManyRequests && resp.StatusCode != http.StatusServiceUnavailable {
+		return 0, false
+	}
+	return parseRetryAfter(resp.Header.Get("Retry-After"), now)
+}
+
+// parseRetryAfter parses a Retry-After value given either as delay
+// seconds or as an HTTP date. Dates in the past yield a zero delay.
+func parseRetryAfter(v string, now time.Time) (time.Duration, bool) {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return max(t.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// retryAfterLimits resolves the configured attempt count and maximum
+// delay, applying defaults for zero values.
+func retryAfterLimits(opts Options) (int, time.Duration) {
+	attempts := opts.RetryAfterAttempts
+	if attempts == 0 {
+		attempts = DefaultRetryAfterAttempts
+	}
+	maxDelay := opts.RetryAfterMax
+	if maxDelay == 0 {
+		maxDelay = DefaultRetryAfterMax
+	}
+	return attempts, maxDelay
+}
@@ -0,0 +1,71 @@
+// internal/detect/charset.go
+//
+// Character-set detection. The declared charset is taken, in order of
+// precedence, from the Content-Type header, a byte-order mark, or, for
+// HTML, a <meta charset> or http-equiv declaration near the start of
+// the document, as browsers do.
+
+package detect
+
+import (
+	"bytes"
+	"mime"
+	"strings"
+)
+
+// metaPrescanLimit is how far into an HTML document a charset
+// declaration is looked for (the HTML spec's prescan window).
+const metaPrescanLimit = 1024
+
+// detectCharset returns the lowercase charset declared for body, or ""
+// when none is declared.
+func detectCharset(contentType string, body []byte, html bool) string {
+	if _, params, err := mime.ParseMediaType(contentType); err == nil {
+		if cs := strings.TrimSpace(params["charset"]); cs != "" {
+			return strings.ToLower(cs)
+		}
+	}
+
+	switch {
+	case bytes.HasPrefix(body, []byte("\xEF\xBB\xBF")):
+		return "utf-8"
+	case bytes.HasPrefix(body, []byte("\xFE\xFF")):
+		return "utf-16be"
+	case bytes.HasPrefix(body, []byte("\xFF\xFE")):
+		return "utf-16le"
+	}
+
+	if html {
+		return metaCharset(body)
+	}
+	return ""
+}
+
+// metaCharset finds charset="x" (from <meta charset> or the content
+// attribute of an http-equiv Content-Type meta) in the head of an HTML
+// document.
+func metaCharset(body []byte) string {
+	head := strings.ToLower(string(body[:min(len(body), metaPrescanLimit)]))
+	for rest := head; ; {
+		i := strings.Index(rest, "<meta")
+		if i < 0 {
+			return ""
+		}
+		rest = rest[i+len("<meta"):]
+		tag := rest
+		if end := strings.IndexByte(tag, '>'); end >= 0 {
+			tag = tag[:end]
+		}
+		j := strings.Index(tag, "charset=")
+		if j < 0 {
+			continue
+		}
+		v := strings.TrimLeft(tag[j+len("charset="):], `"' `)
+		if end := strings.IndexAny(v, "\"'; />"); end >= 0 {
+			v = v[:end]
+		}
+		if v != "" {
+			return v
+		}
+	}
+}
@@ -81,6 +81,8 @@ func Detect(body []byte, headers http.Header) *Result {
 		r.SubType = classifyHTML(body)
 	}
 
+	r.Charset = detectCharset(headers.Get("Content-Type"), body, r.RawType == TypeHTML)
+
 	return r
 }
 
@@ -100,7 +102,7 @@ func sniff(body []byte) Type {
 	}
 
 	// HTML?
-	s := strings.ToLower(string(b[:64]))
+	s := strings.ToLower(string(b[:min(len(b), 64)]))
 	if strings.Contains(s, "<!doctype html") || strings.Contains(s, "<html") {
 		return TypeHTML
 	}
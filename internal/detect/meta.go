@@ -39,5 +39,16 @@ func ExtractBasicMeta(doc *ihtml.Document) map[string]string {
 		meta["canonical_url"] = m
 	}
 
+	// Normalized OpenGraph / Twitter Card keys (og_title, og_image, ...)
+	social := ihtml.SocialMeta(meta)
+	for k, v := range social {
+		meta[k] = v
+	}
+	if m := social["og_image"]; m != "" {
+		meta["image"] = m
+	} else if m := social["twitter_image"]; m != "" {
+		meta["image"] = m
+	}
+
 	return meta
 }
@@ -11,6 +11,9 @@
 // Color usage is controlled by:
 //   • Theme.Color (ColorModeAuto, ColorModeAlways, ColorModeNever)
 //   • Environment variables (e.g. NO_COLOR, TERM)
+//   • Whether the destination is a terminal: ColorModeAuto never emits
+//     escape codes when stdout (or the io.Writer given to ThemeForWriter)
+//     is redirected to a file or pipe.
 //
 // The display subsystem should always respect these rules so that
 // applications embedding Aether can safely use the output in terminals,
@@ -19,6 +22,7 @@
 package display
 
 import (
+	"io"
 	"os"
 	"strings"
 	"sync"
@@ -64,6 +68,7 @@ var (
 //
 //   - If NO_COLOR is set → no color.
 //   - If TERM is empty or "dumb" → no color.
+//   - If stdout is not a terminal (piped or redirected) → no color.
 //   - Otherwise → assume color is supported.
 //
 // Applications that need stricter or richer logic can wrap Display
@@ -82,6 +87,12 @@ func detectColorSupport() {
 			return
 		}
 
+		// `prog > file.txt` must produce clean text.
+		if !isTerminal(os.Stdout.Fd()) {
+			colorSupported = false
+			return
+		}
+
 		// Default: assume color is available.
 		colorSupported = true
 	})
@@ -104,6 +115,75 @@ func isColorEnabled(t Theme) bool {
 	}
 }
 
+// IsTerminalWriter reports whether w writes to a terminal. Only writers
+// exposing a file descriptor (such as *os.File) can qualify; buffers,
+// pipes wrapped in other writers and network connections report false.
+func IsTerminalWriter(w io.Writer) bool {
+	f, ok := w.(interface{ Fd() uintptr })
+	if !ok {
+		return false
+	}
+	return isTerminal(f.Fd())
+}
+
+// ThemeForWriter adapts t to the destination w. Under ColorModeAuto,
+// color is switched off when w is not a terminal; explicit modes are
+// left untouched. A theme without a MaxWidth is sized to w (see
+// WriterWidth).
+func ThemeForWriter(t Theme, w io.Writer) Theme {
+	if t.Color == ColorModeAuto && !IsTerminalWriter(w) {
+		t.Color = ColorModeNever
+	}
+	if t.MaxWidth <= 0 {
+		t.MaxWidth = WriterWidth(w)
+	}
+	return t
+}
+
+// StripANSI removes ANSI escape sequences (CSI sequences such as colors
+// and cursor movement, and OSC sequences such as hyperlinks) from s.
+func StripANSI(s string) string {
+	if !strings.Contains(s, "\x1b") {
+		return s
+	}
+
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(s); i++ {
+		if s[i] != 0x1b {
+			b.WriteByte(s[i])
+			continue
+		}
+		if i+1 >= len(s) {
+			break
+		}
+		switch s[i+1] {
+		case '[':
+			// CSI: parameters and intermediates up to a final
+			// byte in 0x40–0x7E.
+			j := i + 2
+			for j < len(s) && (s[j] < 0x40 || s[j] > 0x7e) {
+				j++
+			}
+			i = j
+		case ']':
+			// OSC: terminated by BEL or ESC \.
+			j := i + 2
+			for j < len(s) && s[j] != 0x07 && !(s[j] == 0x1b && j+1 < len(s) && s[j+1] == '\\') {
+				j++
+			}
+			if j < len(s) && s[j] == 0x1b {
+				j++
+			}
+			i = j
+		default:
+			// Two-byte escape.
+			i++
+		}
+	}
+	return b.String()
+}
+
 // applyStyle applies a given ansiStyle to text if color is enabled
 // for the provided Theme. Otherwise, it returns the text unchanged.
 //
@@ -0,0 +1,68 @@
+// internal/display/color_test.go
+package display
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+func TestThemeForWriter_NonTTYDisablesAutoColor(t *testing.T) {
+	var buf bytes.Buffer
+
+	th := ThemeForWriter(DefaultTheme(), &buf)
+	if th.Color != ColorModeNever {
+		t.Fatalf("auto theme on buffer: got color mode %v, want ColorModeNever", th.Color)
+	}
+
+	doc := &model.Document{
+		Title:    "Title",
+		Sections: []model.Section{{Role: model.SectionRoleBody, Heading: "Heading", Text: "Body **text**"}},
+	}
+	buf.WriteString(NewRenderer(th).RenderDocument(doc))
+	if strings.Contains(buf.String(), "\x1b") {
+		t.Fatalf("ANSI escape in non-TTY output: %q", buf.String())
+	}
+
+	// Explicit modes are the caller's choice and are kept.
+	always := DefaultTheme()
+	always.Color = ColorModeAlways
+	if got := ThemeForWriter(always, &buf).Color; got != ColorModeAlways {
+		t.Fatalf("explicit mode: got %v, want ColorModeAlways", got)
+	}
+}
+
+func TestIsTerminalWriter_RegularFile(t *testing.T) {
+	f, err := os.Create(filepath.Join(t.TempDir(), "out.txt"))
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	defer f.Close()
+
+	if IsTerminalWriter(f) {
+		t.Fatalf("regular file reported as terminal")
+	}
+	if IsTerminalWriter(&bytes.Buffer{}) {
+		t.Fatalf("buffer reported as terminal")
+	}
+}
+
+func TestStripANSI(t *testing.T) {
+	cases := map[string]string{
+		"plain":                              "plain",
+		"\x1b[1m\x1b[34mHead\x1b[39m\x1b[0m": "Head",
+		"a\x1b[2Kb":                          "ab",
+		"\x1b]8;;https://x.y\x07link\x1b]8;;\x07": "link",
+		"\x1b]8;;u\x1b\\t\x1b]8;;\x1b\\":          "t",
+		"trailing\x1b":                            "trailing",
+	}
+	for in, want := range cases {
+		if got := StripANSI(in); got != want {
+			t.Fatalf("StripANSI(%q): got %q, want %q", in, got, want)
+		}
+	}
+}
@@ -38,8 +38,10 @@
 //	width.go         → terminal width detection + text wrapping
 //	markdown.go      → generic Markdown formatting
 //	model_render.go  → render model.Document into Markdown
+//	section_renderers.go → per-role render hooks for custom section roles
 //	table.go         → flexible Unicode/ASCII table renderer
 //	preview.go       → short previews (title + excerpt)
+//	toc.go           → tables of contents + HTML heading anchors
 //
 // The package is intentionally decoupled from:
 //   - async fetcher
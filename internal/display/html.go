@@ -0,0 +1,235 @@
+// internal/display/html.go
+//
+// HTML rendering of normalized documents.
+//
+// RenderHTML produces a self-contained <article> fragment suitable for
+// embedding in a page. By default every piece of document text is
+// escaped, so markup that leaked into extracted content shows up as
+// literal text. With HTMLOptions.Sanitize set, section text that
+// contains markup is instead passed through an allowlist sanitizer
+// (internal/html.Sanitize): permitted tags such as <b> or <a href> are
+// kept, everything else is stripped, and <script> content and
+// javascript: URLs are always removed.
+//
+// Section headings carry id anchors (see toc.go); HTMLOptions.TOC adds a
+// table of contents linking to them.
+
+package display
+
+import (
+	"sort"
+	"strings"
+
+	ihtml "github.com/Nibir1/Aether/internal/html"
+	"github.com/Nibir1/Aether/internal/model"
+	xhtml "golang.org/x/net/html"
+)
+
+// HTMLOptions controls RenderHTML.
+type HTMLOptions struct {
+	// Sanitize keeps allowlisted markup in section text instead of
+	// escaping it.
+	Sanitize bool
+
+	// Policy is the allowlist used when Sanitize is set. A policy with
+	// no tags falls back to ihtml.DefaultPolicy().
+	Policy ihtml.Policy
+
+	// TOC emits a table of contents of the section headings before the
+	// first section.
+	TOC bool
+}
+
+// RenderHTML renders doc as an HTML fragment. Theme.MaxSections is
+// honoured the same way as in RenderDocument.
+func (r Renderer) RenderHTML(doc *model.Document, opts HTMLOptions) string {
+	if doc == nil {
+		return ""
+	}
+	if opts.Sanitize && len(opts.Policy.Tags) == 0 {
+		opts.Policy = ihtml.DefaultPolicy()
+	}
+
+	var b strings.Builder
+	b.WriteString("<article>\n")
+
+	title := strings.TrimSpace(doc.Title)
+	if title == "" {
+		title = strings.TrimSpace(doc.SourceURL)
+	}
+	if title != "" {
+		b.WriteString("<h1>" + xhtml.EscapeString(title) + "</h1>\n")
+	}
+
+	if ex := strings.TrimSpace(doc.Excerpt); ex != "" {
+		b.WriteString(`<p class="excerpt"><em>` + xhtml.EscapeString(ex) + "</em></p>\n")
+	}
+
+	b.WriteString(htmlMetadata(doc.Metadata))
+
+	if content := strings.TrimSpace(doc.Content); content != "" && len(doc.Sections) == 0 {
+		b.WriteString(htmlText(content, opts))
+	}
+
+	shown, omitted := TruncateSections(doc, r.Theme.MaxSections)
+	entries := tocEntries(shown.Sections)
+	anchors := make(map[int]string, len(entries))
+	for _, e := range entries {
+		anchors[e.index] = e.anchor
+	}
+	if opts.TOC {
+		b.WriteString(htmlTOC(entries))
+	}
+	for i := range shown.Sections {
+		b.WriteString(htmlSection(&shown.Sections[i], anchors[i], opts))
+	}
+	if note := OverflowNote(omitted); note != "" {
+		b.WriteString(`<p class="overflow">` + xhtml.EscapeString(note) + "</p>\n")
+	}
+
+	if src := strings.TrimSpace(doc.SourceURL); src != "" {
+		b.WriteString(`<p class="source">Source: ` + htmlLink(src, src) + "</p>\n")
+	}
+
+	b.WriteString("</article>")
+	return b.String()
+}
+
+func htmlSection(s *model.Section, anchor string, opts HTMLOptions) string {
+	var b strings.Builder
+	b.WriteString(`<section class="role-` + xhtml.EscapeString(string(s.Role)) + `">` + "\n")
+
+	heading := strings.TrimSpace(s.Heading)
+	text := strings.TrimSpace(s.Text)
+	id := ""
+	if anchor != "" {
+		id = ` id="` + xhtml.EscapeString(anchor) + `"`
+	}
+
+	switch s.Role {
+	case model.SectionRoleCode:
+		if heading != "" {
+			b.WriteString("<h3" + id + ">" + xhtml.EscapeString(heading) + "</h3>\n")
+		}
+		if text != "" && s.Meta[model.MetaVerbatim] == model.VerbatimMath {
+			b.WriteString(`<div class="math">` + xhtml.EscapeString(model.TrimBlankLines(s.Text)) + "</div>\n")
+		} else if text != "" {
+			class := ""
+			if lang := codeLang(s.Meta); lang != "" {
+				class = ` class="language-` + xhtml.EscapeString(lang) + `"`
+			}
+			b.WriteString("<pre><code" + class + ">" + xhtml.EscapeString(model.TrimBlankLines(s.Text)) + "</code></pre>\n")
+		}
+
+	case model.SectionRoleTable:
+		if heading != "" {
+			b.WriteString("<h3" + id + ">" + xhtml.EscapeString(heading) + "</h3>\n")
+		}
+		if header, rows, ok := model.ParseTable(text); ok {
+			b.WriteString(htmlTable(header, rows))
+		} else if text != "" {
+			b.WriteString(htmlText(text, opts))
+		}
+
+	case model.SectionRoleMetadata:
+		if heading != "" {
+			b.WriteString("<h3" + id + ">" + xhtml.EscapeString(heading) + "</h3>\n")
+		}
+		if text != "" {
+			b.WriteString(htmlText(text, opts))
+		}
+		b.WriteString(htmlMetadata(s.Meta))
+
+	case model.SectionRoleFeedItem, model.SectionRoleEntity:
+		if heading != "" {
+			b.WriteString("<h2" + id + ">" + htmlLink(heading, sectionURL(s.Meta)) + "</h2>\n")
+		}
+		if text != "" {
+			b.WriteString(htmlText(text, opts))
+		}
+		if s.Role == model.SectionRoleEntity {
+			b.WriteString(htmlMetadata(s.Meta))
+		}
+
+	default:
+		if heading != "" {
+			b.WriteString("<h2" + id + ">" + xhtml.EscapeString(heading) + "</h2>\n")
+		}
+		if text != "" {
+			b.WriteString(htmlText(text, opts))
+		}
+	}
+
+	b.WriteString("</section>\n")
+	return b.String()
+}
+
+// htmlText renders text as paragraphs split on blank lines. In sanitize
+// mode, text containing markup is sanitized rather than escaped.
+func htmlText(text string, opts HTMLOptions) string {
+	if opts.Sanitize && strings.Contains(text, "<") {
+		return ihtml.Sanitize(text, opts.Policy) + "\n"
+	}
+
+	var b strings.Builder
+	for _, para := range strings.Split(text, "\n\n") {
+		para = strings.TrimSpace(para)
+		if para == "" {
+			continue
+		}
+		b.WriteString("<p>" + xhtml.EscapeString(para) + "</p>\n")
+	}
+	return b.String()
+}
+
+// htmlTable renders a header row and body rows as a <table>.
+func htmlTable(header []string, rows [][]string) string {
+	var b strings.Builder
+	b.WriteString("<table>\n<thead><tr>")
+	for _, h := range header {
+		b.WriteString("<th>" + xhtml.EscapeString(h) + "</th>")
+	}
+	b.WriteString("</tr></thead>\n<tbody>\n")
+	for _, r := range rows {
+		b.WriteString("<tr>")
+		for _, c := range r {
+			b.WriteString("<td>" + xhtml.EscapeString(c) + "</td>")
+		}
+		b.WriteString("</tr>\n")
+	}
+	b.WriteString("</tbody>\n</table>\n")
+	return b.String()
+}
+
+// htmlMetadata renders meta as a definition list with sorted keys.
+func htmlMetadata(meta map[string]string) string {
+	keys := make([]string, 0, len(meta))
+	for k, v := range meta {
+		if strings.TrimSpace(k) != "" && strings.TrimSpace(v) != "" {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return ""
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString(`<dl class="metadata">` + "\n")
+	for _, k := range keys {
+		b.WriteString("<dt>" + xhtml.EscapeString(strings.TrimSpace(k)) + "</dt><dd>" +
+			xhtml.EscapeString(strings.TrimSpace(meta[k])) + "</dd>\n")
+	}
+	b.WriteString("</dl>\n")
+	return b.String()
+}
+
+// htmlLink renders an anchor for url, or the escaped text alone when the
+// URL is empty or uses an unsafe scheme.
+func htmlLink(text, url string) string {
+	text = xhtml.EscapeString(text)
+	if url == "" || !ihtml.SafeURL(url) {
+		return text
+	}
+	return `<a href="` + xhtml.EscapeString(url) + `">` + text + "</a>"
+}
@@ -25,7 +25,8 @@ import (
 )
 
 // RenderHeading renders a Markdown heading (level 1–6) using the theme.
-// It applies:
+// The level is shifted by Theme.HeadingLevelOffset before the style is
+// chosen. It applies:
 //   - optional ANSI styling (color.go)
 //   - configurable heading style (prefix, underline, uppercase)
 //   - safe trimming of whitespace
@@ -34,12 +35,7 @@ func RenderHeading(t Theme, level int, text string) string {
 	if text == "" {
 		return ""
 	}
-	if level < 1 {
-		level = 1
-	}
-	if level > 6 {
-		level = 6
-	}
+	level = t.HeadingLevel(level)
 
 	style := t.HeadingForLevel(level)
 
@@ -137,8 +133,31 @@ func RenderBulletList(t Theme, items []string) string {
 // line2
 // ```
 func RenderCodeBlock(code string) string {
+	return RenderCodeBlockWithLang(code, "")
+}
+
+// RenderCodeBlockWithLang wraps code in a fenced block tagged with lang
+// (e.g. ```go) so downstream viewers can highlight it. The code is kept
+// verbatim; the fence is lengthened if the code itself contains "```".
+func RenderCodeBlockWithLang(code, lang string) string {
 	code = strings.TrimRight(code, "\n")
-	return "```\n" + code + "\n```"
+	fence := codeFenceFor(code)
+	return fence + strings.TrimSpace(lang) + "\n" + code + "\n" + fence
+}
+
+// codeFenceFor returns a backtick fence longer than any backtick run in
+// code (minimum three), per CommonMark.
+func codeFenceFor(code string) string {
+	longest, run := 0, 0
+	for _, r := range code {
+		if r == '`' {
+			run++
+			longest = max(longest, run)
+		} else {
+			run = 0
+		}
+	}
+	return strings.Repeat("`", max(3, longest+1))
 }
 
 // collapseSpaces normalizes internal whitespace sequences.
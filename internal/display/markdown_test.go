@@ -0,0 +1,63 @@
+// internal/display/markdown_test.go
+package display
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+func TestRenderDocument_HeadingLevelOffset(t *testing.T) {
+	theme := DefaultTheme()
+	theme.Color = ColorModeNever
+	theme.HeadingLevelOffset = 1
+
+	out := NewRenderer(theme).RenderDocument(&model.Document{Title: "Title"})
+	if first := strings.SplitN(out, "\n", 2)[0]; first != "## Title" {
+		t.Fatalf("title line: got %q, want %q", first, "## Title")
+	}
+}
+
+func TestRenderHeading_OffsetPicksUnderlineStyle(t *testing.T) {
+	theme := MinimalTheme()
+	theme.HeadingLevelOffset = 1
+
+	if got, want := RenderHeading(theme, 1, "Intro"), "Intro\n-----"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	theme = DefaultTheme()
+	theme.Color = ColorModeNever
+	theme.HeadingLevelOffset = 10
+	if got, want := RenderHeading(theme, 1, "Deep"), "###### Deep"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestRenderDocument_CodeSectionVerbatim(t *testing.T) {
+	code := "func main() {\n\tif x {\n\t\tfmt.Println(\"a very long line that would certainly be wrapped by the paragraph renderer\")\n\t}\n}"
+	theme := DefaultTheme()
+	theme.Color = ColorModeNever
+	theme.MaxWidth = 30
+
+	doc := &model.Document{
+		Sections: []model.Section{{
+			Role: model.SectionRoleCode,
+			Text: "\n" + code + "\n\n",
+			Meta: map[string]string{"lang": "go"},
+		}},
+	}
+
+	want := "```go\n" + code + "\n```"
+	if got := NewRenderer(theme).RenderDocument(doc); got != want {
+		t.Fatalf("got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestRenderCodeBlockWithLang_LongerFence(t *testing.T) {
+	got := RenderCodeBlockWithLang("x := \"```\"", "go")
+	if want := "````go\nx := \"```\"\n````"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
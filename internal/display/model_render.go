@@ -60,8 +60,9 @@ func (r Renderer) RenderDocument(doc *model.Document) string {
 		b.WriteByte('\n')
 	}
 
-	// Excerpt (if present)
-	if strings.TrimSpace(doc.Excerpt) != "" {
+	// Excerpt (if present, and not the opening of the lead section that
+	// follows, as with parsed Markdown)
+	if strings.TrimSpace(doc.Excerpt) != "" && !leadRepeatsExcerpt(doc) {
 		excerpt := wrapTextToWidth(strings.TrimSpace(doc.Excerpt), width)
 		excerpt = styleEm(r.Theme, excerpt)
 		b.WriteString(excerpt)
@@ -83,21 +84,34 @@ func (r Renderer) RenderDocument(doc *model.Document) string {
 	if strings.TrimSpace(doc.Content) != "" && len(doc.Sections) == 0 {
 		body := wrapTextToWidth(strings.TrimSpace(doc.Content), width)
 		b.WriteString(body)
-		return strings.TrimRight(b.String(), "\n")
 	}
 
-	// Sections
-	for i, s := range doc.Sections {
+	// Sections (capped by Theme.MaxSections)
+	shown, omitted := TruncateSections(doc, r.Theme.MaxSections)
+	for i, s := range shown.Sections {
 		sec := r.renderSection(&s, width)
 		if sec == "" {
 			continue
 		}
 		b.WriteString(sec)
-		if i < len(doc.Sections)-1 {
+		if i < len(shown.Sections)-1 {
 			b.WriteByte('\n')
 			b.WriteByte('\n')
 		}
 	}
+	if note := OverflowNote(omitted); note != "" {
+		b.WriteString("\n\n")
+		b.WriteString(styleMeta(r.Theme, note))
+	}
+
+	// Trailing source line so quoted output stays citable.
+	if src := strings.TrimSpace(doc.SourceURL); src != "" {
+		out := strings.TrimRight(b.String(), "\n")
+		b.Reset()
+		b.WriteString(out)
+		b.WriteString("\n\n")
+		b.WriteString(styleMeta(r.Theme, "Source: "+src))
+	}
 
 	return strings.TrimRight(b.String(), "\n")
 }
@@ -129,6 +143,12 @@ func (r Renderer) renderSection(s *model.Section, width int) string {
 		b.WriteByte('\n')
 	}
 
+	// Registered renderers take precedence over the built-in layouts.
+	if fn, ok := lookupSectionRenderer(s.Role); ok {
+		b.WriteString(fn(*s, r.Theme, width))
+		return strings.TrimRight(b.String(), "\n")
+	}
+
 	heading := strings.TrimSpace(s.Heading)
 	text := strings.TrimSpace(s.Text)
 
@@ -152,7 +172,7 @@ func (r Renderer) renderSection(s *model.Section, width int) string {
 		if line == "" {
 			line = "(feed item)"
 		}
-		line = styleStrong(r.Theme, line)
+		line = styleStrong(r.Theme, markdownLink(line, sectionURL(s.Meta)))
 		line = r.Theme.Bullet + " " + line
 		line = wrapTextToWidth(line, width)
 		b.WriteString(line)
@@ -166,7 +186,7 @@ func (r Renderer) renderSection(s *model.Section, width int) string {
 	case model.SectionRoleEntity:
 		// Entity: heading as strong, summary body, plus metadata.
 		if heading != "" {
-			h := styleStrong(r.Theme, heading)
+			h := styleStrong(r.Theme, markdownLink(heading, sectionURL(s.Meta)))
 			h = wrapTextToWidth(h, width)
 			b.WriteString(h)
 			b.WriteByte('\n')
@@ -185,6 +205,38 @@ func (r Renderer) renderSection(s *model.Section, width int) string {
 			}
 		}
 
+	case model.SectionRoleCode:
+		// Code: fenced and verbatim — never trimmed inside or reflowed.
+		if heading != "" {
+			h := r.renderHeading(3, heading)
+			b.WriteString(h)
+			b.WriteByte('\n')
+			b.WriteByte('\n')
+		}
+		switch {
+		case text == "":
+		case s.Meta[model.MetaVerbatim] == model.VerbatimMath:
+			// Display math keeps its own $$ delimiters.
+			b.WriteString(model.TrimBlankLines(s.Text))
+		default:
+			b.WriteString(RenderCodeBlockWithLang(model.TrimBlankLines(s.Text), codeLang(s.Meta)))
+		}
+
+	case model.SectionRoleTable:
+		// Table: drawn with RenderTable; text that does not parse as a
+		// pipe table is shown as is.
+		if heading != "" {
+			h := r.renderHeading(3, heading)
+			b.WriteString(h)
+			b.WriteByte('\n')
+			b.WriteByte('\n')
+		}
+		if header, rows, ok := model.ParseTable(text); ok {
+			b.WriteString(RenderTable(r.Theme, Table{Header: header, Rows: rows}))
+		} else if text != "" {
+			b.WriteString(text)
+		}
+
 	case model.SectionRoleMetadata:
 		// Pure metadata section.
 		if heading != "" {
@@ -216,6 +268,34 @@ func (r Renderer) renderSection(s *model.Section, width int) string {
 	return strings.TrimRight(b.String(), "\n")
 }
 
+// sectionURL returns the link recorded on a section: "url" (entities)
+// or "link" (feed items).
+func sectionURL(meta map[string]string) string {
+	if u := strings.TrimSpace(meta["url"]); u != "" {
+		return u
+	}
+	return strings.TrimSpace(meta["link"])
+}
+
+// markdownLink renders [text](url), or text alone when url is empty.
+// Brackets in text and spaces/parentheses in url are escaped.
+func markdownLink(text, url string) string {
+	if url == "" {
+		return text
+	}
+	text = strings.NewReplacer("[", `\[`, "]", `\]`).Replace(text)
+	url = strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29").Replace(url)
+	return "[" + text + "](" + url + ")"
+}
+
+// codeLang returns the language recorded on a code section, if any.
+func codeLang(meta map[string]string) string {
+	if lang := meta["lang"]; lang != "" {
+		return lang
+	}
+	return meta["language"]
+}
+
 //
 // ────────────────────────────────────────────────────────────────────────
 //                            METADATA RENDERING
@@ -258,7 +338,7 @@ func (r Renderer) renderHeading(level int, text string) string {
 		return ""
 	}
 
-	style := r.Theme.HeadingForLevel(level)
+	style := r.Theme.HeadingForLevel(r.Theme.HeadingLevel(level))
 
 	headingText := text
 	if style.Uppercase {
@@ -294,3 +374,18 @@ func (r Renderer) renderHeading(level int, text string) string {
 
 	return rendered
 }
+
+// leadRepeatsExcerpt reports whether doc's first section is an unheaded
+// body section whose first paragraph is the excerpt, so rendering the
+// excerpt as well would print the same text twice.
+func leadRepeatsExcerpt(doc *model.Document) bool {
+	if len(doc.Sections) == 0 {
+		return false
+	}
+	s := doc.Sections[0]
+	if s.Role != model.SectionRoleBody || s.Heading != "" {
+		return false
+	}
+	first, _, _ := strings.Cut(strings.TrimSpace(s.Text), "\n\n")
+	return strings.Join(strings.Fields(first), " ") == strings.Join(strings.Fields(doc.Excerpt), " ")
+}
@@ -0,0 +1,123 @@
+// internal/display/model_render_test.go
+package display
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+func plainTheme() Theme {
+	t := DefaultTheme()
+	t.Color = ColorModeNever
+	return t
+}
+
+func TestRenderDocument_FeedItemLinks(t *testing.T) {
+	doc := &model.Document{
+		Title:     "Feed",
+		SourceURL: "https://example.com/feed.xml",
+		Sections: []model.Section{
+			{Role: model.SectionRoleFeedItem, Heading: "Title", Meta: map[string]string{"link": "https://example.com/a"}},
+			{Role: model.SectionRoleFeedItem, Heading: "No link"},
+		},
+	}
+
+	out := NewRenderer(plainTheme()).RenderDocument(doc)
+
+	for _, want := range []string{
+		"[Title](https://example.com/a)",
+		"- No link",
+		"Source: https://example.com/feed.xml",
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("output missing %q:\n%s", want, out)
+		}
+	}
+	if !strings.HasSuffix(out, "Source: https://example.com/feed.xml") {
+		t.Fatalf("source line should be last:\n%s", out)
+	}
+}
+
+func TestMarkdownLink_Escaping(t *testing.T) {
+	got := markdownLink("a [b]", "https://x.test/p (1)")
+	if want := `[a \[b\]](https://x.test/p%20%281%29)`; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestRenderDocument_MaxSections(t *testing.T) {
+	doc := &model.Document{Title: "Feed"}
+	for i := 1; i <= 10; i++ {
+		doc.Sections = append(doc.Sections, model.Section{
+			Role:    model.SectionRoleFeedItem,
+			Heading: fmt.Sprintf("Item %d", i),
+		})
+	}
+
+	theme := plainTheme()
+	theme.MaxSections = 3
+	out := NewRenderer(theme).RenderDocument(doc)
+
+	if !strings.Contains(out, "Item 3") || strings.Contains(out, "Item 4") {
+		t.Fatalf("expected exactly items 1-3:\n%s", out)
+	}
+	if !strings.HasSuffix(out, "… and 7 more items") {
+		t.Fatalf("missing overflow note:\n%s", out)
+	}
+	if len(doc.Sections) != 10 {
+		t.Fatalf("input document was modified: %d sections", len(doc.Sections))
+	}
+
+	preview := NewPreviewRenderer(theme)
+	if got := preview.RenderPreview(preview.MakePreview(doc)); !strings.HasSuffix(got, "… and 7 more items") {
+		t.Fatalf("preview missing overflow note: %q", got)
+	}
+
+	theme.MaxSections = 0
+	if out := NewRenderer(theme).RenderDocument(doc); !strings.Contains(out, "Item 10") || strings.Contains(out, "more items") {
+		t.Fatalf("zero should mean unlimited:\n%s", out)
+	}
+}
+
+func TestOverflowNote_GroupsThousands(t *testing.T) {
+	if got, want := OverflowNote(4980), "… and 4,980 more items"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if got, want := OverflowNote(1234567), "… and 1,234,567 more items"; got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	if got := OverflowNote(0); got != "" {
+		t.Fatalf("got %q, want empty", got)
+	}
+}
+
+func TestRenderDocument_CustomSectionRenderer(t *testing.T) {
+	const quote model.SectionRole = "quote"
+	doc := &model.Document{
+		Sections: []model.Section{
+			{Role: quote, Text: "To be or not to be.", Meta: map[string]string{"by": "Hamlet"}},
+			{Role: "unregistered", Heading: "Plain", Text: "Default layout."},
+		},
+	}
+
+	RegisterSectionRenderer(quote, func(s model.Section, theme Theme, width int) string {
+		return "> " + s.Text + "\n> — " + s.Meta["by"]
+	})
+	defer RegisterSectionRenderer(quote, nil)
+
+	out := NewRenderer(plainTheme()).RenderDocument(doc)
+	if !strings.Contains(out, "> To be or not to be.\n> — Hamlet") {
+		t.Fatalf("custom renderer not used:\n%s", out)
+	}
+	if !strings.Contains(out, "Plain") || !strings.Contains(out, "Default layout.") {
+		t.Fatalf("unregistered role lost default rendering:\n%s", out)
+	}
+
+	RegisterSectionRenderer(quote, nil)
+	if out := NewRenderer(plainTheme()).RenderDocument(doc); strings.Contains(out, "> To be") {
+		t.Fatalf("renderer still active after removal:\n%s", out)
+	}
+}
@@ -27,6 +27,7 @@ package display
 
 import (
 	"strings"
+	"unicode"
 
 	"github.com/Nibir1/Aether/internal/model"
 )
@@ -36,6 +37,10 @@ import (
 type Preview struct {
 	Title   string
 	Summary string
+
+	// Omitted counts sections beyond Theme.MaxSections; when positive
+	// the rendered preview ends with an overflow note.
+	Omitted int
 }
 
 // PreviewRenderer renders Preview structs using a Theme.
@@ -55,7 +60,7 @@ func NewPreviewRenderer(t Theme) PreviewRenderer {
 //  2. Summary = doc.Excerpt OR first non-empty paragraph from sections or content.
 //
 // This struct-level function does not perform formatting; renderers do.
-func (PreviewRenderer) MakePreview(doc *model.Document) Preview {
+func (r PreviewRenderer) MakePreview(doc *model.Document) Preview {
 	if doc == nil {
 		return Preview{}
 	}
@@ -75,16 +80,37 @@ func (PreviewRenderer) MakePreview(doc *model.Document) Preview {
 		summary = firstNonEmptyParagraph(doc)
 	}
 
+	_, omitted := TruncateSections(doc, r.Theme.MaxSections)
+
 	return Preview{
 		Title:   title,
 		Summary: summary,
+		Omitted: omitted,
 	}
 }
 
 // RenderPreview produces a human-readable, theme-aware single-block preview
 // suitable for CLI or UI list displays.
 func (r PreviewRenderer) RenderPreview(p Preview) string {
-	if p.Title == "" && p.Summary == "" {
+	return r.renderPreview(p, func(sum string) string {
+		return styleEm(r.Theme, sum)
+	})
+}
+
+// RenderPreviewHighlight renders p like RenderPreview, additionally
+// emphasizing every occurrence of the given terms in the summary with
+// the theme's strong style. Matching is case-insensitive and on word
+// boundaries; overlapping or adjacent matches are merged into a single
+// highlighted run. Without color, the output equals RenderPreview's.
+func (r PreviewRenderer) RenderPreviewHighlight(p Preview, terms []string) string {
+	return r.renderPreview(p, func(sum string) string {
+		return highlightTerms(r.Theme, sum, terms)
+	})
+}
+
+// renderPreview lays out title, styled summary and overflow note.
+func (r PreviewRenderer) renderPreview(p Preview, styleSummary func(string) string) string {
+	if p.Title == "" && p.Summary == "" && p.Omitted <= 0 {
 		return ""
 	}
 
@@ -103,11 +129,18 @@ func (r PreviewRenderer) RenderPreview(p Preview) string {
 			b.WriteByte('\n')
 		}
 		sum := strings.TrimSpace(p.Summary)
-		sum = styleEm(r.Theme, sum)
+		sum = styleSummary(sum)
 		sum = wrapTextToWidth(sum, EffectiveWidth(r.Theme))
 		b.WriteString(sum)
 	}
 
+	if note := OverflowNote(p.Omitted); note != "" {
+		if b.Len() > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString(styleMeta(r.Theme, note))
+	}
+
 	return b.String()
 }
 
@@ -157,3 +190,54 @@ func firstParagraph(text string) string {
 	}
 	return ""
 }
+
+// highlightTerms styles text as emphasized, except for runs matching
+// any of terms, which are styled strong. Styles are applied per run
+// because every ANSI close sequence resets all attributes.
+func highlightTerms(t Theme, text string, terms []string) string {
+	runes := []rune(text)
+	marked := make([]bool, len(runes))
+
+	for _, term := range terms {
+		tr := []rune(strings.TrimSpace(term))
+		if len(tr) == 0 {
+			continue
+		}
+		for i := 0; i+len(tr) <= len(runes); i++ {
+			end := i + len(tr)
+			if i > 0 && isWordRune(runes[i-1]) {
+				continue
+			}
+			if end < len(runes) && isWordRune(runes[end]) {
+				continue
+			}
+			if !strings.EqualFold(string(runes[i:end]), string(tr)) {
+				continue
+			}
+			for j := i; j < end; j++ {
+				marked[j] = true
+			}
+		}
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(runes); {
+		j := i
+		for j < len(runes) && marked[j] == marked[i] {
+			j++
+		}
+		run := string(runes[i:j])
+		if marked[i] {
+			b.WriteString(styleStrong(t, run))
+		} else {
+			b.WriteString(styleEm(t, run))
+		}
+		i = j
+	}
+	return b.String()
+}
+
+// isWordRune reports whether r is part of a word for term matching.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
@@ -0,0 +1,46 @@
+// internal/display/preview_test.go
+package display
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+func TestRenderPreviewHighlight_WrapsTerms(t *testing.T) {
+	theme := DefaultTheme()
+	theme.Color = ColorModeAlways
+	theme.MaxWidth = 40
+
+	pr := NewPreviewRenderer(theme)
+	p := pr.MakePreview(&model.Document{
+		Excerpt: "Go is fun. Gophers love GO; going is not go-ing.",
+	})
+	out := pr.RenderPreviewHighlight(p, []string{"go", "GO is", ""})
+
+	bold := func(s string) string { return ansiBold.Open + s + ansiBold.Close }
+
+	// Overlapping terms "go" and "go is" merge into one run.
+	if !strings.Contains(out, bold("Go is")) {
+		t.Fatalf("overlapping match not highlighted: %q", out)
+	}
+	// Repeated matches are all highlighted, case-insensitively.
+	if !strings.Contains(out, bold("GO")) || !strings.Contains(out, bold("go")) {
+		t.Fatalf("repeated match not highlighted: %q", out)
+	}
+	// Word boundaries: "Gophers" and "going" are not matches.
+	if strings.Contains(out, bold("Go")+"phers") || strings.Contains(out, bold("go")+"ing is") {
+		t.Fatalf("partial word highlighted: %q", out)
+	}
+
+	// Styling must not count toward the wrap width.
+	for _, line := range strings.Split(out, "\n") {
+		if n := displayLen(line); n > 40 {
+			t.Fatalf("line wider than theme: %d: %q", n, line)
+		}
+	}
+	if got, want := StripANSI(out), pr.RenderPreview(p); StripANSI(want) != got {
+		t.Fatalf("plain text differs from RenderPreview:\ngot  %q\nwant %q", got, StripANSI(want))
+	}
+}
@@ -0,0 +1,59 @@
+// internal/display/section_renderers.go
+//
+// Registry of render functions for custom section roles.
+//
+// The renderer knows how to lay out the built-in SectionRoles. Plugins
+// may emit sections with roles of their own ("quote", "recipe_step",
+// ...), which by default render like body text. RegisterSectionRenderer
+// lets the plugin author supply the text layout for such a role instead;
+// the registry is consulted before the built-in switch.
+
+package display
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+// SectionRenderFunc renders one section as plain text. width is the
+// wrapping width the renderer is using; theme is the active Theme so
+// the function can honour color and bullet settings.
+type SectionRenderFunc func(s model.Section, theme Theme, width int) string
+
+var (
+	sectionRenderersMu  sync.RWMutex
+	sectionRenderers    = map[model.SectionRole]SectionRenderFunc{}
+	sectionRenderersGen atomic.Uint64
+)
+
+// SectionRenderersGeneration returns a counter that changes every time
+// the registry does, so caches of rendered output can key on it.
+func SectionRenderersGeneration() uint64 {
+	return sectionRenderersGen.Load()
+}
+
+// RegisterSectionRenderer installs fn as the renderer for sections with
+// the given role, replacing any previous registration. A nil fn removes
+// the registration, restoring the default rendering.
+func RegisterSectionRenderer(role model.SectionRole, fn SectionRenderFunc) {
+	sectionRenderersMu.Lock()
+	defer sectionRenderersMu.Unlock()
+	defer sectionRenderersGen.Add(1)
+
+	if fn == nil {
+		delete(sectionRenderers, role)
+		return
+	}
+	sectionRenderers[role] = fn
+}
+
+// lookupSectionRenderer returns the registered renderer for role, if any.
+func lookupSectionRenderer(role model.SectionRole) (SectionRenderFunc, bool) {
+	sectionRenderersMu.RLock()
+	defer sectionRenderersMu.RUnlock()
+
+	fn, ok := sectionRenderers[role]
+	return fn, ok
+}
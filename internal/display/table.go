@@ -4,6 +4,10 @@
 //
 // This renderer supports:
 //   • Unicode or ASCII borders (Theme.AsciiOnly)
+//   • Optional full grids: row separators and an outer box
+//     (Theme.TableRowSeparators, Theme.TableOuterBorder)
+//   • Cell whitespace normalization (tabs, stray newlines, control
+//     characters) before measuring, so source data cannot skew widths
 //   • Column width calculation based on Theme.TablePadding
 //   • Word wrapping according to Theme.EffectiveWidth()
 //   • Header and body styling via TableStyle (bold, faint, colorize)
@@ -16,6 +20,7 @@ package display
 
 import (
 	"strings"
+	"unicode"
 	"unicode/utf8"
 )
 
@@ -29,6 +34,10 @@ import (
 type Table struct {
 	Header []string
 	Rows   [][]string
+
+	// Multiline keeps newlines inside cells as line breaks. By default a
+	// newline is treated like any other whitespace and collapsed.
+	Multiline bool
 }
 
 //
@@ -45,11 +54,13 @@ func RenderTable(t Theme, tbl Table) string {
 		return ""
 	}
 
-	// Determine usable width.
-	totalWidth := t.EffectiveWidth(80)
-	if totalWidth < 20 {
-		totalWidth = 20
+	// Clean cell whitespace before anything is measured.
+	tbl.Header = normalizeCells(tbl.Header, tbl.Multiline)
+	rows := make([][]string, len(tbl.Rows))
+	for i, r := range tbl.Rows {
+		rows[i] = normalizeCells(r, tbl.Multiline)
 	}
+	tbl.Rows = rows
 
 	// Build unified list of rows.
 	all := [][]string{}
@@ -58,30 +69,146 @@ func RenderTable(t Theme, tbl Table) string {
 	}
 	all = append(all, tbl.Rows...)
 
+	edges := tableEdgesFor(t)
+
+	// Determine usable width, leaving room for vertical rules.
+	totalWidth := t.EffectiveWidth(80)
+	if cols := len(all[0]); cols > 0 {
+		totalWidth -= edges.width(cols)
+	}
+	if totalWidth < 20 {
+		totalWidth = 20
+	}
+
 	// Calculate column widths.
 	colWidths := computeColumnWidths(all, totalWidth, t.TablePadding)
 
-	var b strings.Builder
+	var lines []string
+
+	if t.TableOuterBorder {
+		lines = append(lines, renderTableRule(t, colWidths, ruleTop, true))
+	}
 
 	// Header row.
 	if len(tbl.Header) > 0 {
-		b.WriteString(renderTableRow(t, tbl.Header, colWidths, t.TableHeaderStyle))
-		b.WriteByte('\n')
-		b.WriteString(renderTableSeparator(t, colWidths))
-		b.WriteByte('\n')
+		lines = append(lines, renderTableRow(t, tbl.Header, colWidths, t.TableHeaderStyle, edges))
+		lines = append(lines, renderTableSeparator(t, colWidths))
 	}
 
 	// Body rows.
 	for i, r := range tbl.Rows {
-		b.WriteString(renderTableRow(t, r, colWidths, t.TableBodyStyle))
-		if i < len(tbl.Rows)-1 {
-			b.WriteByte('\n')
+		if i > 0 && t.TableRowSeparators {
+			lines = append(lines, renderTableSeparator(t, colWidths))
 		}
+		lines = append(lines, renderTableRow(t, r, colWidths, t.TableBodyStyle, edges))
+	}
+
+	if t.TableOuterBorder {
+		lines = append(lines, renderTableRule(t, colWidths, ruleBottom, true))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// tableEdges holds the vertical rules drawn around and between cells.
+// All three are empty for the classic borderless layout.
+type tableEdges struct {
+	left, sep, right string
+}
+
+// tableEdgesFor returns the vertical rules for t: none unless grid mode
+// (row separators or an outer border) is enabled.
+func tableEdgesFor(t Theme) tableEdges {
+	if !t.TableRowSeparators && !t.TableOuterBorder {
+		return tableEdges{}
 	}
+	bar := "│"
+	if t.AsciiOnly {
+		bar = "|"
+	}
+	e := tableEdges{sep: bar}
+	if t.TableOuterBorder {
+		e.left, e.right = bar, bar
+	}
+	return e
+}
+
+// width returns the display columns the rules take for a cols-wide table.
+func (e tableEdges) width(cols int) int {
+	return displayLen(e.left) + displayLen(e.right) + displayLen(e.sep)*(cols-1)
+}
+
+// normalizeCells returns a copy of row with each cell's whitespace
+// normalized by normalizeCell.
+func normalizeCells(row []string, multiline bool) []string {
+	if row == nil {
+		return nil
+	}
+	out := make([]string, len(row))
+	for i, cell := range row {
+		out[i] = normalizeCell(cell, multiline)
+	}
+	return out
+}
+
+// normalizeCell collapses every run of whitespace in s to one space and
+// drops other control characters, so "a\tb" becomes "a b". Complete ANSI
+// CSI sequences (ESC [ ... final byte), such as the styling of a cell
+// that is already colored, are kept unchanged; displayLen ignores them
+// when sizing columns. With multiline set, newlines survive as line
+// breaks and each line is trimmed; blank lines are dropped.
+func normalizeCell(s string, multiline bool) string {
+	var b strings.Builder
+	b.Grow(len(s))
 
+	pending := "" // whitespace seen since the last visible rune
+	for i := 0; i < len(s); {
+		if n := csiLen(s[i:]); n > 0 {
+			b.WriteString(s[i : i+n])
+			i += n
+			continue
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+
+		switch {
+		case r == '\n' && multiline:
+			if b.Len() > 0 {
+				pending = "\n"
+			}
+		case unicode.IsSpace(r):
+			if b.Len() > 0 && pending == "" {
+				pending = " "
+			}
+		case unicode.IsControl(r):
+			// Dropped without separating the surrounding text.
+		default:
+			b.WriteString(pending)
+			pending = ""
+			b.WriteRune(r)
+		}
+	}
 	return b.String()
 }
 
+// csiLen returns the length of the ANSI CSI sequence at the start of s,
+// or 0 when s does not start with a complete one.
+func csiLen(s string) int {
+	if len(s) < 3 || s[0] != 0x1b || s[1] != '[' {
+		return 0
+	}
+	for j := 2; j < len(s); j++ {
+		switch c := s[j]; {
+		case c >= 0x40 && c <= 0x7e:
+			return j + 1
+		case c < 0x20 || c > 0x3f:
+			// Not a parameter or intermediate byte.
+			return 0
+		}
+	}
+	return 0
+}
+
 //
 // ─────────────────────────────────────────────────────────────────────────────
 //                       COLUMN WIDTH CALCULATION
@@ -104,9 +231,10 @@ func computeColumnWidths(rows [][]string, totalWidth int, pad int) []int {
 			if c < len(row) {
 				cell = row[c]
 			}
-			n := displayLen(cell)
-			if n > w[c] {
-				w[c] = n
+			for _, line := range strings.Split(cell, "\n") {
+				if n := displayLen(line); n > w[c] {
+					w[c] = n
+				}
 			}
 		}
 	}
@@ -139,8 +267,10 @@ func computeColumnWidths(rows [][]string, totalWidth int, pad int) []int {
 // ─────────────────────────────────────────────────────────────────────────────
 //
 
-// renderTableRow renders a single row with wrapping and styling.
-func renderTableRow(th Theme, row []string, widths []int, style TableStyle) string {
+// renderTableRow renders a single row with wrapping and styling. Cells
+// that wrap onto several lines are padded to full width on every line,
+// so the vertical rules in edges stay aligned.
+func renderTableRow(th Theme, row []string, widths []int, style TableStyle, edges tableEdges) string {
 	var lines [][]string
 
 	// Wrap each cell to width.
@@ -165,7 +295,11 @@ func renderTableRow(th Theme, row []string, widths []int, style TableStyle) stri
 
 	// Build lines top-aligned.
 	for line := 0; line < maxH; line++ {
+		b.WriteString(edges.left)
 		for c := 0; c < len(widths); c++ {
+			if c > 0 {
+				b.WriteString(edges.sep)
+			}
 			cell := ""
 			if line < len(lines[c]) {
 				cell = lines[c][line]
@@ -178,6 +312,7 @@ func renderTableRow(th Theme, row []string, widths []int, style TableStyle) stri
 			cell = padRight(cell, widths[c])
 			b.WriteString(cell)
 		}
+		b.WriteString(edges.right)
 		if line < maxH-1 {
 			b.WriteByte('\n')
 		}
@@ -211,7 +346,8 @@ func applyTableStyle(t Theme, s string, st TableStyle) string {
 // ─────────────────────────────────────────────────────────────────────────────
 //
 
-// renderTableSeparator renders a horizontal separator.
+// renderTableSeparator renders a horizontal separator between rows,
+// closed with edge glyphs when the theme draws an outer border.
 //
 // Unicode example:
 //
@@ -221,23 +357,51 @@ func applyTableStyle(t Theme, s string, st TableStyle) string {
 //
 //	-----+----------+------
 func renderTableSeparator(t Theme, widths []int) string {
-	var b strings.Builder
+	return renderTableRule(t, widths, ruleMiddle, t.TableOuterBorder)
+}
 
-	sep := "─"
-	joint := "┼"
+// rulePos selects the corner glyphs of a horizontal rule.
+type rulePos int
+
+const (
+	ruleTop rulePos = iota
+	ruleMiddle
+	ruleBottom
+)
+
+// ruleGlyphs lists left corner, column joint and right corner for each
+// rule position in Unicode box drawing; ASCII uses "+" throughout.
+var ruleGlyphs = map[rulePos][3]string{
+	ruleTop:    {"┌", "┬", "┐"},
+	ruleMiddle: {"├", "┼", "┤"},
+	ruleBottom: {"└", "┴", "┘"},
+}
 
+// renderTableRule renders a horizontal rule across widths. With edges,
+// the rule is closed by corner glyphs for an outer border:
+//
+//	┌─────┬──────┐   ├─────┼──────┤   └─────┴──────┘
+func renderTableRule(t Theme, widths []int, pos rulePos, edges bool) string {
+	fill := "─"
+	g := ruleGlyphs[pos]
 	if t.AsciiOnly {
-		sep = "-"
-		joint = "+"
+		fill = "-"
+		g = [3]string{"+", "+", "+"}
 	}
 
+	var b strings.Builder
+	if edges {
+		b.WriteString(g[0])
+	}
 	for i, w := range widths {
-		b.WriteString(strings.Repeat(sep, w))
+		b.WriteString(strings.Repeat(fill, w))
 		if i < len(widths)-1 {
-			b.WriteString(joint)
+			b.WriteString(g[1])
 		}
 	}
-
+	if edges {
+		b.WriteString(g[2])
+	}
 	return b.String()
 }
 
@@ -249,7 +413,7 @@ func renderTableSeparator(t Theme, widths []int) string {
 
 // displayLen returns printable width ignoring ANSI escape codes.
 func displayLen(s string) int {
-	return utf8.RuneCountInString(stripANSI(s))
+	return utf8.RuneCountInString(StripANSI(s))
 }
 
 // padRight pads to the right with spaces.
@@ -260,29 +424,3 @@ func padRight(s string, width int) string {
 	}
 	return s + strings.Repeat(" ", width-n)
 }
-
-// stripANSI removes ANSI codes for width calculation.
-func stripANSI(s string) string {
-	var out strings.Builder
-	inEsc := false
-
-	for i := 0; i < len(s); i++ {
-		c := s[i]
-
-		if c == 0x1b { // ESC
-			inEsc = true
-			continue
-		}
-
-		if inEsc {
-			if (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') {
-				inEsc = false
-			}
-			continue
-		}
-
-		out.WriteByte(c)
-	}
-
-	return out.String()
-}
@@ -0,0 +1,134 @@
+// internal/display/table_test.go
+package display
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestRenderTable_BorderedWrappedCell(t *testing.T) {
+	th := plainTheme()
+	th.MaxWidth = 40
+	th.TableOuterBorder = true
+	th.TableRowSeparators = true
+
+	out := RenderTable(th, Table{
+		Header: []string{"Name", "Notes"},
+		Rows: [][]string{
+			{"a", "short"},
+			{"b", "a much longer note that needs to wrap across lines"},
+		},
+	})
+	lines := strings.Split(out, "\n")
+
+	if !strings.HasPrefix(lines[0], "┌") || !strings.HasSuffix(lines[0], "┐") {
+		t.Fatalf("top border: got %q", lines[0])
+	}
+	last := lines[len(lines)-1]
+	if !strings.HasPrefix(last, "└") || !strings.HasSuffix(last, "┘") {
+		t.Fatalf("bottom border: got %q", last)
+	}
+
+	want := utf8.RuneCountInString(lines[0])
+	rules := 0
+	for _, l := range lines {
+		if n := utf8.RuneCountInString(l); n != want {
+			t.Fatalf("line %q: got width %d, want %d", l, n, want)
+		}
+		if strings.HasPrefix(l, "├") {
+			rules++
+		}
+	}
+	// One rule under the header plus one between the two body rows.
+	if rules != 2 {
+		t.Fatalf("middle rules: got %d, want 2\n%s", rules, out)
+	}
+	// The wrapped cell spans more than one line, each closed by the border.
+	if len(lines) < 8 {
+		t.Fatalf("expected wrapped row, got:\n%s", out)
+	}
+}
+
+func TestRenderTable_DefaultUnchanged(t *testing.T) {
+	out := RenderTable(plainTheme(), Table{
+		Header: []string{"A", "B"},
+		Rows:   [][]string{{"1", "2"}},
+	})
+	if strings.ContainsAny(out, "┌└│") {
+		t.Fatalf("default table should not draw a grid: got %q", out)
+	}
+}
+
+func TestRenderTable_NormalizesCellWhitespace(t *testing.T) {
+	if got := normalizeCell("a\tb", false); got != "a b" {
+		t.Fatalf("normalizeCell: got %q, want %q", got, "a b")
+	}
+
+	tbl := Table{
+		Header: []string{"Key", "Value"},
+		Rows: [][]string{
+			{"a\tb", "first\nline\x00"},
+			{"  long \t\t key\r\n", "v"},
+		},
+	}
+	out := RenderTable(plainTheme(), tbl)
+	lines := strings.Split(out, "\n")
+	if len(lines) != 4 {
+		t.Fatalf("got %d lines, want header, separator and 2 rows:\n%s", len(lines), out)
+	}
+	if strings.ContainsAny(out, "\t\r\x00") {
+		t.Fatalf("control characters left in output: %q", out)
+	}
+	if !strings.Contains(lines[2], "a b") || !strings.Contains(lines[2], "first line") ||
+		!strings.Contains(lines[3], "long key") {
+		t.Fatalf("cells not normalized:\n%s", out)
+	}
+	want := utf8.RuneCountInString(lines[0])
+	for _, l := range []string{lines[2], lines[3]} {
+		if n := utf8.RuneCountInString(l); n != want {
+			t.Fatalf("line %q: got width %d, want %d", l, n, want)
+		}
+	}
+
+	tbl.Multiline = true
+	lines = strings.Split(RenderTable(plainTheme(), tbl), "\n")
+	if len(lines) != 5 || !strings.Contains(lines[2], "first") || !strings.Contains(lines[3], "line") {
+		t.Fatalf("multiline cell: got %q", lines)
+	}
+}
+
+func TestRenderTable_KeepsANSIStyledCells(t *testing.T) {
+	bold := "\x1b[1mBold\x1b[0m"
+	if got := normalizeCell(bold+"\x1b\x07 x", false); got != bold+" x" {
+		t.Fatalf("normalizeCell: got %q, want %q", got, bold+" x")
+	}
+
+	out := RenderTable(plainTheme(), Table{
+		Header: []string{"Name", "Value"},
+		Rows: [][]string{
+			{bold, "1"},
+			{"Plain", "2"},
+		},
+	})
+	if !strings.Contains(out, bold) {
+		t.Fatalf("styled cell not kept: %q", out)
+	}
+	if strings.Contains(StripANSI(out), "[1m") {
+		t.Fatalf("escape sequence leaked as text: %q", out)
+	}
+
+	lines := strings.Split(out, "\n")
+	if len(lines) != 4 {
+		t.Fatalf("got %d lines, want 4:\n%s", len(lines), out)
+	}
+	want := displayLen(lines[0])
+	for _, l := range lines[2:] {
+		if n := displayLen(l); n != want {
+			t.Fatalf("line %q: got width %d, want %d", l, n, want)
+		}
+	}
+	if a, b := strings.Index(StripANSI(lines[2]), "1"), strings.Index(lines[3], "2"); a != b {
+		t.Fatalf("value column misaligned: %q vs %q", StripANSI(lines[2]), lines[3])
+	}
+}
@@ -79,11 +79,27 @@ type Theme struct {
 
 	ShowSectionRoles bool
 
+	// HeadingLevelOffset shifts every rendered heading down by this many
+	// levels (1 turns H1 into H2), clamped at 6. Use it when embedding
+	// output under an existing page title.
+	HeadingLevelOffset int
+
+	// MaxSections caps how many sections are rendered; the rest are
+	// summarized by an overflow note ("… and 4,980 more items").
+	// Zero means unlimited.
+	MaxSections int
+
 	// ─── Table rendering extensions ────────────────────────────────
 	TablePadding     int
 	TableHeaderStyle TableStyle
 	TableBodyStyle   TableStyle
 	AsciiOnly        bool
+
+	// TableRowSeparators draws a rule between every body row, and
+	// TableOuterBorder boxes the table with corner glyphs. Either flag
+	// switches tables to grid mode, with a vertical rule between columns.
+	TableRowSeparators bool
+	TableOuterBorder   bool
 }
 
 //
@@ -106,6 +122,9 @@ func DefaultTheme() Theme {
 			1: {Prefix: "# "},
 			2: {Prefix: "## "},
 			3: {Prefix: "### "},
+			4: {Prefix: "#### "},
+			5: {Prefix: "##### "},
+			6: {Prefix: "###### "},
 		},
 
 		Emphasis: EmphasisStyle{
@@ -251,6 +270,19 @@ func (t Theme) HeadingForLevel(level int) HeadingStyle {
 	return HeadingStyle{Prefix: "# "}
 }
 
+// HeadingLevel applies HeadingLevelOffset to level and clamps the
+// result to 1–6.
+func (t Theme) HeadingLevel(level int) int {
+	level += t.HeadingLevelOffset
+	if level < 1 {
+		level = 1
+	}
+	if level > 6 {
+		level = 6
+	}
+	return level
+}
+
 // EffectiveWidth resolves usable width.
 func (t Theme) EffectiveWidth(fallback int) int {
 	if t.MaxWidth > 0 {
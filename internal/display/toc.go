@@ -0,0 +1,170 @@
+// internal/display/toc.go
+//
+// Tables of contents built from section headings.
+//
+// RenderTOC lists every section that has a heading as a nested bullet
+// list. Nesting follows the heading level: model.MetaHeadingLevel when a
+// section records one, otherwise the level the renderer uses for the
+// section's role. A jump of more than one level (h2 straight to h4)
+// nests only one step deeper, so the outline never skips a depth.
+//
+// The HTML renderer gives each such heading an id anchor derived from
+// its text; with HTMLOptions.TOC set it also emits the outline as a
+// <nav> of links to those anchors.
+
+package display
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/Nibir1/Aether/internal/model"
+	xhtml "golang.org/x/net/html"
+)
+
+// tocEntry is one heading in the outline.
+type tocEntry struct {
+	index  int // position in the section slice
+	depth  int // nesting depth, 0 for the outermost level
+	text   string
+	anchor string
+}
+
+// RenderTOC renders the section headings of doc as a nested bullet list
+// using theme's bullet marker. Sections without a heading are skipped;
+// a document with no headings yields "".
+func RenderTOC(doc *model.Document, theme Theme) string {
+	if doc == nil {
+		return ""
+	}
+	bullet := theme.Bullet
+	if bullet == "" {
+		bullet = "-"
+	}
+
+	var b strings.Builder
+	for _, e := range tocEntries(doc.Sections) {
+		b.WriteString(strings.Repeat("  ", e.depth))
+		b.WriteString(bullet)
+		b.WriteByte(' ')
+		b.WriteString(e.text)
+		b.WriteByte('\n')
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// tocEntries builds the outline of sections, assigning each heading a
+// depth and a unique anchor.
+func tocEntries(sections []model.Section) []tocEntry {
+	var entries []tocEntry
+	var levels []int
+	minLevel := 0
+	for i := range sections {
+		text := strings.TrimSpace(sections[i].Heading)
+		if text == "" {
+			continue
+		}
+		level := sectionHeadingLevel(&sections[i])
+		if minLevel == 0 || level < minLevel {
+			minLevel = level
+		}
+		entries = append(entries, tocEntry{index: i, text: text})
+		levels = append(levels, level)
+	}
+
+	seen := make(map[string]int, len(entries))
+	prev := -1
+	for i := range entries {
+		depth := levels[i] - minLevel
+		if depth > prev+1 {
+			depth = prev + 1
+		}
+		entries[i].depth = depth
+		prev = depth
+
+		// A suffixed anchor may collide with a later heading's plain
+		// one ("A", "A", "A 2"), so every assigned anchor is recorded
+		// and the suffix grows until it is unused.
+		base := headingAnchor(entries[i].text)
+		anchor := base
+		for n := seen[base]; seen[anchor] > 0; {
+			n++
+			seen[base] = n
+			anchor = base + "-" + strconv.Itoa(n)
+		}
+		seen[anchor] = 1
+		entries[i].anchor = anchor
+	}
+	return entries
+}
+
+// sectionHeadingLevel returns the recorded heading level of s, or the
+// level the renderer uses for its role.
+func sectionHeadingLevel(s *model.Section) int {
+	if v, err := strconv.Atoi(strings.TrimSpace(s.Meta[model.MetaHeadingLevel])); err == nil && v >= 1 && v <= 6 {
+		return v
+	}
+	switch s.Role {
+	case model.SectionRoleCode, model.SectionRoleMetadata, model.SectionRoleTable:
+		return 3
+	default:
+		return 2
+	}
+}
+
+// headingAnchor turns heading text into a fragment identifier: letters
+// and digits are lowercased and kept, every other run of characters
+// becomes a single hyphen.
+func headingAnchor(text string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(text) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+	if b.Len() == 0 {
+		return "section"
+	}
+	return b.String()
+}
+
+// htmlTOC renders entries as a <nav> of nested lists linking to the
+// heading anchors.
+func htmlTOC(entries []tocEntry) string {
+	if len(entries) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(`<nav class="toc">` + "\n")
+	depth := -1
+	for _, e := range entries {
+		if e.depth > depth {
+			if depth >= 0 {
+				b.WriteByte('\n')
+			}
+			b.WriteString("<ul>\n")
+		} else {
+			b.WriteString("</li>\n")
+			for ; depth > e.depth; depth-- {
+				b.WriteString("</ul>\n</li>\n")
+			}
+		}
+		depth = e.depth
+		b.WriteString(`<li><a href="#` + xhtml.EscapeString(e.anchor) + `">` + xhtml.EscapeString(e.text) + "</a>")
+	}
+	b.WriteString("</li>\n")
+	for ; depth > 0; depth-- {
+		b.WriteString("</ul>\n</li>\n")
+	}
+	b.WriteString("</ul>\n</nav>\n")
+	return b.String()
+}
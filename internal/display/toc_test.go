@@ -0,0 +1,65 @@
+// internal/display/toc_test.go
+package display
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+func tocDocument() *model.Document {
+	return &model.Document{
+		Title: "Guide",
+		Sections: []model.Section{
+			{Role: model.SectionRoleBody, Heading: "Getting Started", Text: "a",
+				Meta: map[string]string{model.MetaHeadingLevel: "1"}},
+			{Role: model.SectionRoleBody, Text: "no heading"},
+			{Role: model.SectionRoleBody, Heading: "Install & Setup", Text: "b"},
+			{Role: model.SectionRoleBody, Heading: "Next Steps", Text: "c",
+				Meta: map[string]string{model.MetaHeadingLevel: "1"}},
+		},
+	}
+}
+
+func TestRenderTOC_NestsByHeadingLevel(t *testing.T) {
+	got := RenderTOC(tocDocument(), plainTheme())
+	want := "- Getting Started\n  - Install & Setup\n- Next Steps"
+	if got != want {
+		t.Fatalf("RenderTOC:\ngot  %q\nwant %q", got, want)
+	}
+}
+
+func TestRenderHTML_TOCLinksToAnchors(t *testing.T) {
+	got := NewRenderer(plainTheme()).RenderHTML(tocDocument(), HTMLOptions{TOC: true})
+
+	for _, want := range []string{
+		`<li><a href="#getting-started">Getting Started</a>`,
+		`<li><a href="#install-setup">Install &amp; Setup</a></li>`,
+		`<h2 id="install-setup">Install &amp; Setup</h2>`,
+		`<h2 id="next-steps">Next Steps</h2>`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Fatalf("RenderHTML missing %q:\n%s", want, got)
+		}
+	}
+	if strings.Count(got, "<ul>") != strings.Count(got, "</ul>") {
+		t.Fatalf("unbalanced TOC lists:\n%s", got)
+	}
+}
+
+func TestTOCEntries_SuffixedAnchorsStayUnique(t *testing.T) {
+	var sections []model.Section
+	for _, h := range []string{"A", "A", "A 2", "A"} {
+		sections = append(sections, model.Section{Role: model.SectionRoleBody, Heading: h, Text: "x"})
+	}
+
+	var got []string
+	for _, e := range tocEntries(sections) {
+		got = append(got, e.anchor)
+	}
+	want := []string{"a", "a-2", "a-2-2", "a-3"}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Fatalf("anchors: got %q, want %q", got, want)
+	}
+}
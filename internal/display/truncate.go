@@ -0,0 +1,54 @@
+// internal/display/truncate.go
+//
+// Section capping shared by the Markdown, preview and plugin renderers.
+// Very large documents (e.g. a 5,000-item feed) are cut to the first N
+// sections and an overflow note reports exactly how many were omitted.
+
+package display
+
+import (
+	"strconv"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+// TruncateSections returns doc limited to its first max sections and the
+// number of sections dropped. The input is not modified; when nothing is
+// dropped (or max <= 0) doc itself is returned.
+func TruncateSections(doc *model.Document, max int) (*model.Document, int) {
+	if doc == nil || max <= 0 || len(doc.Sections) <= max {
+		return doc, 0
+	}
+	cp := *doc
+	cp.Sections = doc.Sections[:max:max]
+	return &cp, len(doc.Sections) - max
+}
+
+// OverflowNote describes omitted sections, e.g. "… and 4,980 more items".
+// It returns "" when omitted <= 0.
+func OverflowNote(omitted int) string {
+	switch {
+	case omitted <= 0:
+		return ""
+	case omitted == 1:
+		return "… and 1 more item"
+	}
+	return "… and " + groupThousands(omitted) + " more items"
+}
+
+// groupThousands formats n with comma separators (4980 → "4,980").
+func groupThousands(n int) string {
+	s := strconv.Itoa(n)
+	if len(s) <= 3 {
+		return s
+	}
+	lead := len(s) % 3
+	if lead == 0 {
+		lead = 3
+	}
+	out := s[:lead]
+	for i := lead; i < len(s); i += 3 {
+		out += "," + s[i:i+3]
+	}
+	return out
+}
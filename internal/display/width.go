@@ -5,12 +5,13 @@
 //
 // This file provides:
 //   • DetectTerminalWidth() – best-effort TTY width retrieval
+//   • WriterWidth(w) – width for output written to a given io.Writer
 //   • EffectiveWidth(theme) – theme-aware width with fallbacks
 //   • wrapTextToWidth() – low-level greedy wrapper (model_render layer)
 //
 // Normal rules:
 //   • If theme.MaxWidth > 0 → always use it
-//   • Else if stdout is a TTY → try TIOCGWINSZ
+//   • Else if stdout (or the writer passed to WriterWidth) is a TTY → try TIOCGWINSZ
 //   • Else fallback to DefaultWidth (80 chars)
 //
 // This avoids dependencies like "golang.org/x/term" and maintains
@@ -19,6 +20,7 @@
 package display
 
 import (
+	"io"
 	"os"
 	"strings"
 	"syscall"
@@ -41,10 +43,30 @@ type winSize struct {
 //
 // This is a best-effort detection. If detection fails, ok=false.
 func DetectTerminalWidth() (int, bool) {
-	ws := &winSize{}
-
 	// Use STDOUT for detection.
-	fd := os.Stdout.Fd()
+	return terminalWidth(os.Stdout.Fd())
+}
+
+// WriterWidth returns the width to render at for output written to w:
+// the terminal's column count when w is a terminal, DefaultWidth
+// otherwise. Buffers, regular files and pipes always get DefaultWidth,
+// so redirected output does not depend on the size of the terminal the
+// program happened to run in.
+func WriterWidth(w io.Writer) int {
+	f, ok := w.(interface{ Fd() uintptr })
+	if !ok {
+		return DefaultWidth
+	}
+	if cols, ok := terminalWidth(f.Fd()); ok && cols > 0 {
+		return cols
+	}
+	return DefaultWidth
+}
+
+// terminalWidth reads the column count of the terminal behind fd. It is
+// a variable so tests can stand in for a real TTY.
+var terminalWidth = func(fd uintptr) (int, bool) {
+	ws := &winSize{}
 
 	// Only attempt on character devices.
 	if !isTerminal(fd) {
@@ -74,12 +96,12 @@ func DetectTerminalWidth() (int, bool) {
 // This avoids pulling in x/term but remains cross-platform safe
 // (it simply tries fstat and checks the mode).
 func isTerminal(fd uintptr) bool {
-	fi, err := os.Stdout.Stat()
-	if err != nil {
+	var st syscall.Stat_t
+	if err := syscall.Fstat(int(fd), &st); err != nil {
 		return false
 	}
 	// Device file?
-	return (fi.Mode() & os.ModeCharDevice) != 0
+	return uint32(st.Mode)&syscall.S_IFMT == syscall.S_IFCHR
 }
 
 // EffectiveWidth decides what width should ultimately be used for
@@ -116,8 +138,9 @@ func EffectiveWidth(t Theme) int {
 // It differs from markdown.go's wrapText by ensuring:
 //   - it never trims existing newlines
 //   - paragraphs separated by blank lines remain intact
+//   - widths are measured with displayLen, so ANSI styling is free
 func wrapTextToWidth(s string, width int) string {
-	if width <= 0 || len(s) <= width {
+	if width <= 0 || displayLen(s) <= width {
 		return s
 	}
 
@@ -142,7 +165,7 @@ func wrapTextToWidth(s string, width int) string {
 		current := ""
 
 		for _, w := range words {
-			if len(current)+len(w)+1 > width {
+			if current != "" && displayLen(current)+displayLen(w)+1 > width {
 				out.WriteString(strings.TrimSpace(current))
 				out.WriteByte('\n')
 				current = w
@@ -0,0 +1,71 @@
+// internal/display/width_test.go
+package display
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriterWidth_NonTerminalUsesDefault(t *testing.T) {
+	var buf bytes.Buffer
+	if got := WriterWidth(&buf); got != DefaultWidth {
+		t.Fatalf("buffer: got %d, want %d", got, DefaultWidth)
+	}
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "out.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if got := WriterWidth(f); got != DefaultWidth {
+		t.Fatalf("regular file: got %d, want %d", got, DefaultWidth)
+	}
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+	if got := WriterWidth(w); got != DefaultWidth {
+		t.Fatalf("pipe: got %d, want %d", got, DefaultWidth)
+	}
+}
+
+func TestWriterWidth_TerminalFd(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer w.Close()
+
+	// Pretend the pipe's write end is a 132-column terminal.
+	orig := terminalWidth
+	t.Cleanup(func() { terminalWidth = orig })
+	terminalWidth = func(fd uintptr) (int, bool) {
+		if fd == w.Fd() {
+			return 132, true
+		}
+		return 0, false
+	}
+
+	if got := WriterWidth(w); got != 132 {
+		t.Fatalf("terminal fd: got %d, want 132", got)
+	}
+	var buf bytes.Buffer
+	if got := WriterWidth(&buf); got != DefaultWidth {
+		t.Fatalf("buffer: got %d, want %d", got, DefaultWidth)
+	}
+
+	if got := ThemeForWriter(DefaultTheme(), w).MaxWidth; got != 132 {
+		t.Fatalf("ThemeForWriter width: got %d, want 132", got)
+	}
+	explicit := DefaultTheme()
+	explicit.MaxWidth = 60
+	if got := ThemeForWriter(explicit, w).MaxWidth; got != 60 {
+		t.Fatalf("explicit MaxWidth: got %d, want 60", got)
+	}
+}
@@ -29,6 +29,16 @@ const (
 
 	// KindParsing indicates an error while parsing HTML, RSS, etc.
 	KindParsing Kind = "parsing"
+
+	// KindTimeout indicates an operation exceeded its own deadline.
+	KindTimeout Kind = "timeout"
+
+	// KindOffline indicates a network request was needed while offline
+	// mode is enabled, i.e. a cache miss.
+	KindOffline Kind = "offline"
+
+	// KindClosed indicates a request made after the client was closed.
+	KindClosed Kind = "closed"
 )
 
 // Error is Aether's structured error type.
@@ -0,0 +1,55 @@
+// internal/extract/aggressiveness.go
+//
+// Aggressiveness levels for boilerplate removal.
+//
+// Higher levels drop more class/id-hinted blocks (share bars, related
+// links, cookie banners) and require more text before an element is
+// considered as the main content container. Lower levels keep more of
+// the page, at the cost of occasionally including boilerplate.
+
+package extract
+
+// Aggressiveness controls how readily the extractor treats nodes as
+// boilerplate.
+type Aggressiveness int
+
+const (
+	// AggressivenessDefault selects AggressivenessNormal.
+	AggressivenessDefault Aggressiveness = iota
+
+	// AggressivenessConservative only removes scripts, embeds and nodes
+	// explicitly marked as advertising.
+	AggressivenessConservative
+
+	// AggressivenessNormal additionally removes navigation, headers,
+	// footers, sidebars, menus, comments and forms.
+	AggressivenessNormal
+
+	// AggressivenessAggressive additionally removes share/social widgets,
+	// related-content blocks, promos, banners and newsletter prompts.
+	AggressivenessAggressive
+)
+
+// normalized maps AggressivenessDefault and out-of-range values onto a
+// concrete level.
+func (a Aggressiveness) normalized() Aggressiveness {
+	switch {
+	case a <= AggressivenessDefault:
+		return AggressivenessNormal
+	case a > AggressivenessAggressive:
+		return AggressivenessAggressive
+	}
+	return a
+}
+
+// minCandidateLength is the minimum text length, in bytes, of an element
+// scored as a content candidate.
+func (a Aggressiveness) minCandidateLength() int {
+	switch a.normalized() {
+	case AggressivenessConservative:
+		return 25
+	case AggressivenessAggressive:
+		return 80
+	}
+	return 50
+}
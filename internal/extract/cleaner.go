@@ -13,7 +13,8 @@ import (
 
 // cleanNodeTree removes or skips elements that are unlikely to be part
 // of the main content, such as <script>, <style>, <nav>, <aside>, etc.
-func cleanNodeTree(root *xhtml.Node) {
+// level controls how readily class/id hints cause a drop.
+func cleanNodeTree(root *xhtml.Node, level Aggressiveness) {
 	if root == nil {
 		return
 	}
@@ -22,7 +23,7 @@ func cleanNodeTree(root *xhtml.Node) {
 	walker = func(n *xhtml.Node) {
 		for c := n.FirstChild; c != nil; {
 			next := c.NextSibling
-			if shouldDropNode(c) {
+			if shouldDropNode(c, level) {
 				// Remove node from tree.
 				if c.PrevSibling != nil {
 					c.PrevSibling.NextSibling = c.NextSibling
@@ -44,32 +45,83 @@ func cleanNodeTree(root *xhtml.Node) {
 }
 
 // shouldDropNode decides whether to remove a node as pure boilerplate.
-func shouldDropNode(n *xhtml.Node) bool {
+//
+// Class/id values are split into words on anything that is not a letter
+// or digit. Short hints must equal a whole word, so "ad-slot" or
+// "headline-ad" match the "ad" hint while "headline" or "download" do
+// not. Stems such as "comment" or "nav" also match at the start or end
+// of a word ("commentlist", "mainnav") but not inside one ("canvas").
+func shouldDropNode(n *xhtml.Node, level Aggressiveness) bool {
 	if n.Type != xhtml.ElementNode {
 		return false
 	}
+	level = level.normalized()
+
 	tag := strings.ToLower(n.Data)
 	switch tag {
-	case "script", "style", "noscript", "iframe", "footer", "nav", "aside", "header", "form":
+	case "script", "style", "noscript", "iframe":
 		return true
+	case "footer", "nav", "aside", "header", "form":
+		if level >= AggressivenessNormal {
+			return true
+		}
 	}
+
 	// Heuristic based on class/id hints.
 	classID := strings.ToLower(nodeClassAndID(n))
 	if classID == "" {
 		return false
 	}
-	if strings.Contains(classID, "comment") ||
-		strings.Contains(classID, "footer") ||
-		strings.Contains(classID, "sidebar") ||
-		strings.Contains(classID, "nav") ||
-		strings.Contains(classID, "menu") ||
-		strings.Contains(classID, "advert") ||
-		strings.Contains(classID, "ad-") {
-		return true
+	for _, word := range strings.FieldsFunc(classID, isHintSeparator) {
+		if h, ok := boilerplateHints[word]; ok && h <= level {
+			return true
+		}
+		for stem, h := range boilerplateStems {
+			if h <= level && (strings.HasPrefix(word, stem) || strings.HasSuffix(word, stem)) {
+				return true
+			}
+		}
 	}
 	return false
 }
 
+// boilerplateHints maps class/id words to the lowest aggressiveness at
+// which they cause a node to be dropped.
+var boilerplateHints = map[string]Aggressiveness{
+	"ad":  AggressivenessConservative,
+	"ads": AggressivenessConservative,
+
+	"banner":     AggressivenessAggressive,
+	"breadcrumb": AggressivenessAggressive,
+	"cookie":     AggressivenessAggressive,
+	"newsletter": AggressivenessAggressive,
+	"popup":      AggressivenessAggressive,
+	"promo":      AggressivenessAggressive,
+	"related":    AggressivenessAggressive,
+	"share":      AggressivenessAggressive,
+	"social":     AggressivenessAggressive,
+	"sponsored":  AggressivenessAggressive,
+	"subscribe":  AggressivenessAggressive,
+}
+
+// boilerplateStems are hints that also match as a word prefix or
+// suffix, like the substring hints extraction has always used:
+// "advertising", "commentlist", "footerwrap", "mainnav".
+var boilerplateStems = map[string]Aggressiveness{
+	"advert": AggressivenessConservative,
+
+	"comment": AggressivenessNormal,
+	"footer":  AggressivenessNormal,
+	"sidebar": AggressivenessNormal,
+	"nav":     AggressivenessNormal,
+	"menu":    AggressivenessNormal,
+}
+
+// isHintSeparator splits class/id values into words.
+func isHintSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
 // nodeClassAndID returns the concatenation of class and id attributes.
 func nodeClassAndID(n *xhtml.Node) string {
 	var parts []string
@@ -105,6 +157,87 @@ func collectText(n *xhtml.Node, b *strings.Builder) {
 	}
 }
 
+// blockElements are tags that start a new paragraph in blockText.
+var blockElements = map[string]bool{
+	"p": true, "div": true, "section": true, "article": true, "main": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"ul": true, "ol": true, "li": true, "dl": true, "dt": true, "dd": true,
+	"blockquote": true, "figure": true, "figcaption": true,
+	"table": true, "tr": true, "br": true, "hr": true,
+}
+
+// blockText extracts plain text for a node while preserving structure:
+// block-level elements are separated by blank lines ("\n\n"), runs of
+// inline whitespace are collapsed, <pre> content is kept verbatim so
+// code indentation survives, and data tables become pipe-table blocks
+// (see tables.go).
+func blockText(n *xhtml.Node) string {
+	var blocks []string
+	var current strings.Builder
+
+	flush := func() {
+		if t := collapseWhitespace(current.String()); t != "" {
+			blocks = append(blocks, t)
+		}
+		current.Reset()
+	}
+
+	var walker func(*xhtml.Node)
+	walker = func(node *xhtml.Node) {
+		switch node.Type {
+		case xhtml.TextNode:
+			current.WriteString(node.Data)
+			return
+		case xhtml.ElementNode:
+			tag := strings.ToLower(node.Data)
+			switch {
+			case tag == "script" || tag == "style":
+				return
+			case tag == "pre":
+				flush()
+				var raw strings.Builder
+				rawText(node, &raw)
+				if code := strings.Trim(raw.String(), "\n"); strings.TrimSpace(code) != "" {
+					blocks = append(blocks, strings.TrimRight(code, " \t\n"))
+				}
+				return
+			case tag == "table":
+				if t := tableText(node); t != "" {
+					flush()
+					blocks = append(blocks, t)
+					return
+				}
+				// A layout table is flattened like any other block.
+				fallthrough
+			case blockElements[tag]:
+				flush()
+				for c := node.FirstChild; c != nil; c = c.NextSibling {
+					walker(c)
+				}
+				flush()
+				return
+			}
+		}
+		for c := node.FirstChild; c != nil; c = c.NextSibling {
+			walker(c)
+		}
+	}
+	walker(n)
+	flush()
+
+	return strings.Join(blocks, "\n\n")
+}
+
+// rawText gathers text nodes recursively without altering whitespace.
+func rawText(n *xhtml.Node, b *strings.Builder) {
+	if n.Type == xhtml.TextNode {
+		b.WriteString(n.Data)
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		rawText(c, b)
+	}
+}
+
 // collapseWhitespace reduces whitespace sequences to single spaces.
 func collapseWhitespace(s string) string {
 	var b strings.Builder
@@ -175,6 +308,9 @@ func isContentChild(n *xhtml.Node) bool {
 		switch tag {
 		case "p", "div", "article", "section", "ul", "ol", "li", "img", "figure", "h1", "h2", "h3", "h4", "h5", "h6":
 			return true
+		case "table":
+			// Data tables only; layout tables are usually page chrome.
+			return tableText(n) != ""
 		}
 	}
 	if n.Type == xhtml.TextNode {
@@ -204,8 +340,9 @@ func deepClone(n *xhtml.Node) *xhtml.Node {
 	return root
 }
 
-// makeExcerpt produces a short excerpt from the article text.
+// makeExcerpt produces a short, single-line excerpt from the article text.
 func makeExcerpt(text string) string {
+	text = collapseWhitespace(text)
 	if text == "" {
 		return ""
 	}
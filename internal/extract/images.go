@@ -0,0 +1,218 @@
+// internal/extract/images.go
+//
+// Image collection for extracted articles.
+//
+// Images are gathered from <img> elements inside the extracted content
+// node. For each image the best candidate is chosen (the largest srcset
+// entry, falling back to src), resolved against the page URL, and
+// de-duplicated. Obvious tracking pixels are filtered out heuristically.
+
+package extract
+
+import (
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	xhtml "golang.org/x/net/html"
+)
+
+// trackingHosts lists host substrings that serve tracking pixels or ad
+// beacons rather than article imagery.
+var trackingHosts = []string{
+	"doubleclick.net",
+	"google-analytics.com",
+	"googletagmanager.com",
+	"googlesyndication.com",
+	"scorecardresearch.com",
+	"quantserve.com",
+	"facebook.com/tr",
+	"pixel.wp.com",
+	"stats.wp.com",
+	"amazon-adsystem.com",
+}
+
+// trackingPathSegments lists whole path segments typical of
+// spacer/beacon images. Matching whole segments keeps "/pixels/" or
+// "/pixelart/" galleries.
+var trackingPathSegments = map[string]bool{
+	"pixel":      true,
+	"pixel.gif":  true,
+	"pixel.png":  true,
+	"beacon":     true,
+	"beacon.gif": true,
+	"spacer.gif": true,
+	"blank.gif":  true,
+}
+
+// onePixelSize matches a "1x1" size token that is not part of a larger
+// size such as "151x151" or "1x100".
+var onePixelSize = regexp.MustCompile(`(^|[^0-9])1x1([^0-9]|$)`)
+
+// collectImages returns the absolute URLs of content images under n,
+// in document order, without duplicates or tracking pixels.
+func collectImages(n *xhtml.Node, baseURL string) []string {
+	if n == nil {
+		return nil
+	}
+
+	base, _ := url.Parse(strings.TrimSpace(baseURL))
+
+	var out []string
+	seen := map[string]struct{}{}
+
+	var walker func(*xhtml.Node)
+	walker = func(node *xhtml.Node) {
+		if node.Type == xhtml.ElementNode && strings.EqualFold(node.Data, "img") {
+			if src := imageCandidate(node); src != "" && !isTrackingPixel(node, src) {
+				if abs := resolveImageURL(base, src); abs != "" {
+					if _, dup := seen[abs]; !dup {
+						seen[abs] = struct{}{}
+						out = append(out, abs)
+					}
+				}
+			}
+		}
+		for c := node.FirstChild; c != nil; c = c.NextSibling {
+			walker(c)
+		}
+	}
+	walker(n)
+
+	return out
+}
+
+// imageCandidate picks the best URL for an <img>: the largest srcset
+// candidate when present, otherwise src (or common lazy-load attributes).
+func imageCandidate(n *xhtml.Node) string {
+	var src, srcset, dataSrc string
+	for _, a := range n.Attr {
+		switch strings.ToLower(a.Key) {
+		case "src":
+			src = strings.TrimSpace(a.Val)
+		case "srcset":
+			srcset = strings.TrimSpace(a.Val)
+		case "data-src":
+			dataSrc = strings.TrimSpace(a.Val)
+		}
+	}
+
+	if best := bestSrcsetCandidate(srcset); best != "" {
+		return best
+	}
+	if src == "" || strings.HasPrefix(src, "data:") {
+		src = dataSrc
+	}
+	if strings.HasPrefix(src, "data:") {
+		return ""
+	}
+	return src
+}
+
+// bestSrcsetCandidate parses a srcset attribute and returns the URL with
+// the largest width ("640w") or density ("2x") descriptor. Entries
+// without a descriptor count as 1x.
+func bestSrcsetCandidate(srcset string) string {
+	if srcset == "" {
+		return ""
+	}
+
+	best := ""
+	bestScore := -1.0
+
+	for _, entry := range strings.Split(srcset, ",") {
+		fields := strings.Fields(entry)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "data:") {
+			continue
+		}
+
+		score := 1.0
+		if len(fields) > 1 {
+			d := strings.ToLower(fields[1])
+			switch {
+			case strings.HasSuffix(d, "w"):
+				if v, err := strconv.ParseFloat(strings.TrimSuffix(d, "w"), 64); err == nil {
+					score = v
+				}
+			case strings.HasSuffix(d, "x"):
+				if v, err := strconv.ParseFloat(strings.TrimSuffix(d, "x"), 64); err == nil {
+					score = v
+				}
+			}
+		}
+
+		if score > bestScore {
+			best = fields[0]
+			bestScore = score
+		}
+	}
+	return best
+}
+
+// isTrackingPixel reports whether an <img> looks like a tracking beacon:
+// declared 1x1 (or smaller) dimensions, a known ad/analytics host, or a
+// typical spacer/beacon path.
+func isTrackingPixel(n *xhtml.Node, src string) bool {
+	var w, h string
+	for _, a := range n.Attr {
+		switch strings.ToLower(a.Key) {
+		case "width":
+			w = strings.TrimSpace(strings.TrimSuffix(a.Val, "px"))
+		case "height":
+			h = strings.TrimSpace(strings.TrimSuffix(a.Val, "px"))
+		}
+	}
+	if tinyDimension(w) || tinyDimension(h) {
+		return true
+	}
+
+	lower := strings.ToLower(src)
+	for _, host := range trackingHosts {
+		if strings.Contains(lower, host) {
+			return true
+		}
+	}
+
+	path := lower
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+	if onePixelSize.MatchString(path) {
+		return true
+	}
+	for _, seg := range strings.Split(path, "/") {
+		if trackingPathSegments[seg] {
+			return true
+		}
+	}
+	return false
+}
+
+// tinyDimension reports whether a width/height attribute is <= 1 pixel.
+func tinyDimension(v string) bool {
+	if v == "" {
+		return false
+	}
+	n, err := strconv.Atoi(v)
+	return err == nil && n <= 1
+}
+
+// resolveImageURL resolves src against base and returns an absolute
+// http(s) URL, or "" if that is not possible.
+func resolveImageURL(base *url.URL, src string) string {
+	u, err := url.Parse(src)
+	if err != nil {
+		return ""
+	}
+	if !u.IsAbs() {
+		if base == nil || !base.IsAbs() {
+			return ""
+		}
+		u = base.ResolveReference(u)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return ""
+	}
+	return u.String()
+}
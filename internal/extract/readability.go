@@ -13,6 +13,7 @@ package extract
 import (
 	"bytes"
 	"strings"
+	"unicode/utf8"
 
 	ihtml "github.com/Nibir1/Aether/internal/html"
 	xhtml "golang.org/x/net/html"
@@ -21,8 +22,11 @@ import (
 // Article represents the extracted main content of an HTML document.
 //
 // ContentHTML contains a sanitized HTML fragment of the main article.
-// Text contains plain text derived from ContentHTML.
+// Text contains plain text derived from ContentHTML, with paragraphs
+// separated by blank lines and <pre> blocks kept verbatim.
 // Excerpt is a short summary derived from the beginning of the Text.
+// Images lists absolute URLs of content images (tracking pixels removed);
+// TopImageURL is the first of them.
 type Article struct {
 	Title       string
 	Byline      string
@@ -30,13 +34,28 @@ type Article struct {
 	Text        string
 	Excerpt     string
 	TopImageURL string
+	Images      []string
 }
 
-// Extract runs the Readability-style algorithm on a parsed HTML Document.
+// Options tunes extraction.
+type Options struct {
+	// Aggressiveness controls how readily boilerplate is removed and how
+	// much text an element needs to be scored as a content candidate.
+	// The zero value means AggressivenessNormal.
+	Aggressiveness Aggressiveness
+}
+
+// Extract runs the Readability-style algorithm on a parsed HTML Document
+// with default Options.
 //
-// baseURL is optional but may be used in future enhancements to resolve
-// relative URLs or canonical links.
+// baseURL is optional; when present it is used to resolve relative image
+// URLs.
 func Extract(doc *ihtml.Document, baseURL string) *Article {
+	return ExtractWithOptions(doc, baseURL, Options{})
+}
+
+// ExtractWithOptions is Extract with explicit Options.
+func ExtractWithOptions(doc *ihtml.Document, baseURL string, opts Options) *Article {
 	if doc == nil || doc.Root == nil {
 		return &Article{}
 	}
@@ -47,20 +66,20 @@ func Extract(doc *ihtml.Document, baseURL string) *Article {
 	}
 
 	// Clean the DOM: ignore obvious boilerplate tags (nav, aside, footer, etc.)
-	cleanNodeTree(body)
+	level := opts.Aggressiveness.normalized()
+	cleanNodeTree(body, level)
 
 	// Score candidate nodes and pick the best container for the main content.
-	candidates := scoreCandidates(body)
+	candidates := scoreCandidates(body, level.minCandidateLength())
 	top := selectTopCandidate(candidates)
 	if top == nil {
 		// Fallback: use entire body text if no candidate is found.
-		text := nodeText(body)
-		text = strings.TrimSpace(text)
-		return &Article{
+		text := blockText(body)
+		return withImages(&Article{
 			Title:   "",
 			Text:    text,
 			Excerpt: makeExcerpt(text),
-		}
+		}, body, baseURL)
 	}
 
 	// Build a content fragment around the top candidate and its siblings.
@@ -69,23 +88,40 @@ func Extract(doc *ihtml.Document, baseURL string) *Article {
 	var buf bytes.Buffer
 	if err := xhtml.Render(&buf, contentNode); err != nil {
 		// On rendering failure, fallback to text-only extraction.
-		txt := nodeText(contentNode)
-		txt = strings.TrimSpace(txt)
-		return &Article{
+		txt := blockText(contentNode)
+		return withImages(&Article{
 			Title:   "",
 			Text:    txt,
 			Excerpt: makeExcerpt(txt),
-		}
+		}, contentNode, baseURL)
 	}
 
 	html := buf.String()
-	text := nodeText(contentNode)
-	text = strings.TrimSpace(text)
+	text := blockText(contentNode)
 
-	return &Article{
+	return withImages(&Article{
 		Title:       "",
 		ContentHTML: html,
 		Text:        text,
 		Excerpt:     makeExcerpt(text),
+	}, contentNode, baseURL)
+}
+
+// LongEnough reports whether the extracted text has at least min
+// characters (runes), ignoring surrounding whitespace. Landing pages and
+// redirect stubs typically fall below a few hundred characters.
+func (a *Article) LongEnough(min int) bool {
+	if a == nil {
+		return min <= 0
+	}
+	return utf8.RuneCountInString(strings.TrimSpace(a.Text)) >= min
+}
+
+// withImages fills Images and TopImageURL from the content node.
+func withImages(a *Article, content *xhtml.Node, baseURL string) *Article {
+	a.Images = collectImages(content, baseURL)
+	if len(a.Images) > 0 {
+		a.TopImageURL = a.Images[0]
 	}
+	return a
 }
@@ -35,8 +35,9 @@ func findBodyNode(root *xhtml.Node) *xhtml.Node {
 }
 
 // scoreCandidates traverses the DOM and assigns scores to likely
-// content-containing nodes.
-func scoreCandidates(body *xhtml.Node) []*candidateScore {
+// content-containing nodes. Elements with fewer than minLen characters
+// of text are not scored.
+func scoreCandidates(body *xhtml.Node, minLen int) []*candidateScore {
 	var candidates []*candidateScore
 	nodeToScore := make(map[*xhtml.Node]*candidateScore)
 
@@ -47,7 +48,7 @@ func scoreCandidates(body *xhtml.Node) []*candidateScore {
 			switch tag {
 			case "p", "td", "pre", "article", "section", "div", "li":
 				text := strings.TrimSpace(nodeText(n))
-				if len(text) < 50 {
+				if len(text) < minLen {
 					break
 				}
 				base := baseContentScore(tag, text)
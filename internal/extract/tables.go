@@ -0,0 +1,73 @@
+// internal/extract/tables.go
+//
+// Data tables inside article content.
+//
+// blockText would otherwise flatten a <table> into one paragraph per
+// row, losing its columns. tableText instead reads the rows and cells
+// and writes the table as a Markdown pipe table block
+// (model.FormatTable), which normalization later turns into a
+// SectionRoleTable section. Layout tables, with a single row or a
+// single column, are left to the flattening path.
+
+package extract
+
+import (
+	"strings"
+
+	"github.com/Nibir1/Aether/internal/model"
+	xhtml "golang.org/x/net/html"
+)
+
+// tableText returns table as a pipe table, or "" when it does not look
+// like a data table. The first row becomes the header: that is where
+// <thead> and <th> headers sit, and tables without one most often start
+// with a row of labels anyway.
+func tableText(table *xhtml.Node) string {
+	var rows [][]string
+	for _, tr := range tableRows(table) {
+		var cells []string
+		for c := tr.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type != xhtml.ElementNode {
+				continue
+			}
+			if tag := strings.ToLower(c.Data); tag == "td" || tag == "th" {
+				cells = append(cells, nodeText(c))
+			}
+		}
+		if len(cells) > 0 {
+			rows = append(rows, cells)
+		}
+	}
+
+	cols := 0
+	for _, r := range rows {
+		cols = max(cols, len(r))
+	}
+	if len(rows) < 2 || cols < 2 {
+		return ""
+	}
+	return model.FormatTable(rows[0], rows[1:])
+}
+
+// tableRows returns the <tr> elements of table in document order,
+// looking through <thead>, <tbody> and <tfoot> but not into nested
+// tables.
+func tableRows(table *xhtml.Node) []*xhtml.Node {
+	var out []*xhtml.Node
+	var walk func(*xhtml.Node)
+	walk = func(n *xhtml.Node) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type != xhtml.ElementNode {
+				continue
+			}
+			switch strings.ToLower(c.Data) {
+			case "tr":
+				out = append(out, c)
+			case "thead", "tbody", "tfoot":
+				walk(c)
+			}
+		}
+	}
+	walk(table)
+	return out
+}
@@ -0,0 +1,226 @@
+// internal/html/jsonld.go
+//
+// JSON-LD structured data: <script type="application/ld+json">.
+//
+// Many pages describe what they are about in schema.org JSON-LD blocks
+// (a Product with its price, a Recipe with its ingredients, an Event
+// with its date and venue). ExtractJSONLD decodes those blocks and
+// returns the nodes of commonly used types as flat StructuredItems, so
+// the normalizer can turn each into an entity section.
+//
+// A block may hold a single object, an array of objects, or an object
+// with an "@graph" array; all three are accepted. Malformed blocks and
+// nodes of other types are skipped.
+
+package html
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	xhtml "golang.org/x/net/html"
+)
+
+// StructuredItem is one schema.org node found in a page's JSON-LD.
+// Name, Description and URL hold the corresponding properties (Name
+// falls back to "headline"); every other property is flattened into
+// Properties with dotted keys, e.g. "offers.price" or
+// "aggregateRating.ratingValue".
+type StructuredItem struct {
+	Type        string
+	ID          string
+	Name        string
+	Description string
+	URL         string
+	Properties  map[string]string
+}
+
+// jsonLDTypes are the schema.org types ExtractJSONLD returns.
+var jsonLDTypes = map[string]bool{
+	"Product":             true,
+	"Recipe":              true,
+	"Event":               true,
+	"Organization":        true,
+	"LocalBusiness":       true,
+	"Restaurant":          true,
+	"Person":              true,
+	"Place":               true,
+	"Book":                true,
+	"Movie":               true,
+	"Course":              true,
+	"JobPosting":          true,
+	"SoftwareApplication": true,
+	"VideoObject":         true,
+	"Review":              true,
+}
+
+// maxJSONLDDepth bounds how deep nested objects are flattened.
+const maxJSONLDDepth = 4
+
+// ExtractJSONLD returns the recognized JSON-LD items of doc in document
+// order.
+func ExtractJSONLD(doc *Document) []StructuredItem {
+	if doc == nil || doc.Root == nil {
+		return nil
+	}
+
+	var scripts []*xhtml.Node
+	findElementsByTag(doc.Root, "script", &scripts)
+
+	var out []StructuredItem
+	for _, s := range scripts {
+		if !strings.EqualFold(strings.TrimSpace(attrValue(s, "type")), "application/ld+json") {
+			continue
+		}
+		dec := json.NewDecoder(strings.NewReader(textContent(s)))
+		dec.UseNumber()
+		var v any
+		if err := dec.Decode(&v); err != nil {
+			continue
+		}
+		for _, node := range jsonLDNodes(v) {
+			if item, ok := structuredItem(node); ok {
+				out = append(out, item)
+			}
+		}
+	}
+	return out
+}
+
+// jsonLDNodes returns the top-level objects of a decoded block,
+// unwrapping arrays and "@graph" containers.
+func jsonLDNodes(v any) []map[string]any {
+	switch t := v.(type) {
+	case []any:
+		var out []map[string]any
+		for _, e := range t {
+			out = append(out, jsonLDNodes(e)...)
+		}
+		return out
+	case map[string]any:
+		if g, ok := t["@graph"]; ok {
+			return jsonLDNodes(g)
+		}
+		return []map[string]any{t}
+	}
+	return nil
+}
+
+// structuredItem converts node when its @type is recognized.
+func structuredItem(node map[string]any) (StructuredItem, bool) {
+	typ := ""
+	for _, t := range stringValues(node["@type"]) {
+		if jsonLDTypes[t] {
+			typ = t
+			break
+		}
+	}
+	if typ == "" {
+		return StructuredItem{}, false
+	}
+
+	item := StructuredItem{
+		Type:        typ,
+		ID:          scalarString(node["@id"]),
+		Name:        scalarString(node["name"]),
+		Description: cleanWhitespace(scalarString(node["description"])),
+		URL:         scalarString(node["url"]),
+		Properties:  map[string]string{},
+	}
+	if item.Name == "" {
+		item.Name = scalarString(node["headline"])
+	}
+	for k, v := range node {
+		switch k {
+		case "name", "headline", "description", "url":
+			continue
+		}
+		if strings.HasPrefix(k, "@") {
+			continue
+		}
+		flattenJSONLD(item.Properties, k, v, 1)
+	}
+	return item, true
+}
+
+// flattenJSONLD writes v into props under key. Objects recurse with
+// dotted keys; arrays of scalars are joined with ", "; arrays of objects
+// are indexed ("offers.0.price") unless they hold a single object.
+func flattenJSONLD(props map[string]string, key string, v any, depth int) {
+	switch t := v.(type) {
+	case map[string]any:
+		if depth > maxJSONLDDepth {
+			return
+		}
+		for k, sub := range t {
+			if strings.HasPrefix(k, "@") {
+				continue
+			}
+			flattenJSONLD(props, key+"."+k, sub, depth+1)
+		}
+	case []any:
+		if vals := stringValues(t); len(vals) == len(t) {
+			if len(vals) > 0 {
+				props[key] = strings.Join(vals, ", ")
+			}
+			return
+		}
+		if len(t) == 1 {
+			flattenJSONLD(props, key, t[0], depth)
+			return
+		}
+		for i, e := range t {
+			flattenJSONLD(props, key+"."+strconv.Itoa(i), e, depth)
+		}
+	default:
+		if s := cleanWhitespace(scalarString(t)); s != "" {
+			props[key] = s
+		}
+	}
+}
+
+// stringValues returns the scalar values of v (a scalar or an array),
+// stopping at the first non-scalar element.
+func stringValues(v any) []string {
+	arr, ok := v.([]any)
+	if !ok {
+		if s := scalarString(v); s != "" {
+			return []string{s}
+		}
+		return nil
+	}
+	out := make([]string, 0, len(arr))
+	for _, e := range arr {
+		s := scalarString(e)
+		if s == "" {
+			break
+		}
+		out = append(out, s)
+	}
+	return out
+}
+
+// scalarString formats a JSON string, number or boolean; other values
+// yield "".
+func scalarString(v any) string {
+	switch t := v.(type) {
+	case string:
+		return strings.TrimSpace(t)
+	case json.Number:
+		return t.String()
+	case bool:
+		return strconv.FormatBool(t)
+	}
+	return ""
+}
+
+// attrValue returns the value of n's attribute key, or "".
+func attrValue(n *xhtml.Node, key string) string {
+	for _, a := range n.Attr {
+		if strings.EqualFold(a.Key, key) {
+			return a.Val
+		}
+	}
+	return ""
+}
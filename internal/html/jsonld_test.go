@@ -0,0 +1,49 @@
+// internal/html/jsonld_test.go
+package html
+
+import "testing"
+
+func TestExtractJSONLD_ArraysGraphAndUnknownTypes(t *testing.T) {
+	page := `<html><head>
+<script type="application/ld+json">[
+  {"@type": "Event", "name": "Launch", "startDate": "2025-03-01", "location": {"@type": "Place", "name": "Hall A"}},
+  {"@type": "WebSite", "name": "Example"}
+]</script>
+<script type="application/ld+json">{"@context": "https://schema.org", "@graph": [
+  {"@type": ["Thing", "Recipe"], "name": "Soup", "recipeIngredient": ["water", "salt"],
+   "recipeInstructions": [{"@type": "HowToStep", "text": "Boil."}, {"@type": "HowToStep", "text": "Salt."}]}
+]}</script>
+<script type="application/ld+json">{ not json</script>
+</head><body></body></html>`
+
+	doc, err := ParseDocument([]byte(page))
+	if err != nil {
+		t.Fatalf("ParseDocument error: %v", err)
+	}
+	items := ExtractJSONLD(doc)
+	if len(items) != 2 {
+		t.Fatalf("items: got %d, want 2 (%+v)", len(items), items)
+	}
+
+	ev := items[0]
+	if ev.Type != "Event" || ev.Name != "Launch" {
+		t.Fatalf("event: got %+v", ev)
+	}
+	if got := ev.Properties["location.name"]; got != "Hall A" {
+		t.Fatalf("location.name: got %q, want %q", got, "Hall A")
+	}
+
+	soup := items[1]
+	if soup.Type != "Recipe" {
+		t.Fatalf("recipe type: got %q, want %q", soup.Type, "Recipe")
+	}
+	for k, want := range map[string]string{
+		"recipeIngredient":          "water, salt",
+		"recipeInstructions.0.text": "Boil.",
+		"recipeInstructions.1.text": "Salt.",
+	} {
+		if got := soup.Properties[k]; got != want {
+			t.Fatalf("%s: got %q, want %q", k, got, want)
+		}
+	}
+}
@@ -18,6 +18,29 @@ type Link struct {
 	Rel  string
 }
 
+// LinkRel returns the href of the first <link> element whose rel
+// attribute contains rel (case-insensitively), e.g. "amphtml" or
+// "canonical", or "" when there is none.
+func LinkRel(doc *Document, rel string) string {
+	if doc == nil || doc.Root == nil {
+		return ""
+	}
+
+	var nodes []*xhtml.Node
+	findElementsByTag(doc.Root, "link", &nodes)
+
+	for _, n := range nodes {
+		for _, r := range strings.Fields(attrValue(n, "rel")) {
+			if strings.EqualFold(r, rel) {
+				if href := strings.TrimSpace(attrValue(n, "href")); href != "" {
+					return href
+				}
+			}
+		}
+	}
+	return ""
+}
+
 // ExtractLinks returns all <a> elements as Link values.
 func ExtractLinks(doc *Document) []Link {
 	if doc == nil || doc.Root == nil {
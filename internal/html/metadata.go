@@ -76,3 +76,58 @@ func ExtractMeta(doc *Document) map[string]string {
 	}
 	return result
 }
+
+// socialMetaKeys maps OpenGraph / Twitter Card <meta> keys to the
+// normalized metadata keys Aether exposes. When several source keys map
+// to the same normalized key, the first non-empty one wins.
+var socialMetaKeys = []struct {
+	From string
+	To   string
+}{
+	{"og:title", "og_title"},
+	{"og:description", "og_description"},
+	{"og:image", "og_image"},
+	{"og:image:url", "og_image"},
+	{"og:image:secure_url", "og_image"},
+	{"og:url", "og_url"},
+	{"og:type", "og_type"},
+	{"og:site_name", "og_site_name"},
+	{"twitter:card", "twitter_card"},
+	{"twitter:title", "twitter_title"},
+	{"twitter:description", "twitter_description"},
+	{"twitter:image", "twitter_image"},
+	{"twitter:image:src", "twitter_image"},
+	{"twitter:site", "twitter_site"},
+	{"twitter:creator", "twitter_creator"},
+}
+
+// SocialMeta extracts OpenGraph and Twitter Card values from a map
+// produced by ExtractMeta and returns them under normalized keys such
+// as "og_title", "og_image" and "twitter_card".
+//
+// Twitter keys are matched case-insensitively since some sites publish
+// them via name="Twitter:..." attributes.
+func SocialMeta(meta map[string]string) map[string]string {
+	out := make(map[string]string)
+	if len(meta) == 0 {
+		return out
+	}
+
+	lower := make(map[string]string, len(meta))
+	for k, v := range meta {
+		lk := strings.ToLower(k)
+		if _, exists := lower[lk]; !exists {
+			lower[lk] = v
+		}
+	}
+
+	for _, m := range socialMetaKeys {
+		if _, done := out[m.To]; done {
+			continue
+		}
+		if v := strings.TrimSpace(lower[m.From]); v != "" {
+			out[m.To] = v
+		}
+	}
+	return out
+}
@@ -0,0 +1,107 @@
+// internal/html/prune.go
+//
+// Pre-parse pruning for very large pages.
+//
+// Building a DOM costs far more memory than the bytes it came from, and
+// on multi-megabyte pages much of that goes to inline scripts,
+// stylesheets and comments that article extraction throws away anyway.
+// PruneBoilerplate removes them in a single tokenizer pass over the raw
+// bytes, before any node is allocated. Only elements that the extractor
+// drops at every aggressiveness level are pruned, so the extracted
+// article is the same whether or not the pass ran.
+
+package html
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	xhtml "golang.org/x/net/html"
+)
+
+// PruneThreshold is the input size, in bytes, above which
+// ParseArticleDocument prunes boilerplate before parsing.
+const PruneThreshold = 512 << 10
+
+// prunedElements are removed together with their content. The tokenizer
+// reads all of them as raw text, so their content never contains tags.
+var prunedElements = map[string]bool{
+	"script":   true,
+	"style":    true,
+	"noscript": true,
+	"iframe":   true,
+}
+
+// ParseArticleDocument parses data like ParseDocument. Inputs larger
+// than PruneThreshold are passed through PruneBoilerplate first.
+func ParseArticleDocument(data []byte) (*Document, error) {
+	if len(data) > PruneThreshold {
+		data = PruneBoilerplate(data)
+	}
+	return ParseDocument(data)
+}
+
+// PruneBoilerplate returns data without comments and without script,
+// style, noscript and iframe elements. JSON-LD scripts
+// (type="application/ld+json") are kept because they carry metadata
+// rather than code. Everything else is copied byte for byte. If the
+// tokenizer fails, data is returned unchanged.
+func PruneBoilerplate(data []byte) []byte {
+	out := bytes.NewBuffer(make([]byte, 0, len(data)))
+	z := xhtml.NewTokenizer(bytes.NewReader(data))
+
+	skipping := ""
+	for {
+		tt := z.Next()
+		if tt == xhtml.ErrorToken {
+			if z.Err() == io.EOF {
+				return out.Bytes()
+			}
+			return data
+		}
+
+		if skipping != "" {
+			if tt == xhtml.EndTagToken {
+				if name, _ := z.TagName(); string(name) == skipping {
+					skipping = ""
+				}
+			}
+			continue
+		}
+
+		if tt == xhtml.CommentToken {
+			continue
+		}
+
+		// TagName and TagAttr rewrite the token bytes in place, so the
+		// raw token is copied out before the tag is inspected.
+		mark := out.Len()
+		out.Write(z.Raw())
+		if tt != xhtml.StartTagToken && tt != xhtml.SelfClosingTagToken {
+			continue
+		}
+		name, hasAttr := z.TagName()
+		if !prunedElements[string(name)] || (string(name) == "script" && hasAttr && isJSONLD(z)) {
+			continue
+		}
+		out.Truncate(mark)
+		if tt == xhtml.StartTagToken {
+			skipping = string(name)
+		}
+	}
+}
+
+// isJSONLD reports whether the current script tag declares a JSON-LD
+// type.
+func isJSONLD(z *xhtml.Tokenizer) bool {
+	for {
+		key, val, more := z.TagAttr()
+		if string(key) == "type" {
+			return strings.EqualFold(strings.TrimSpace(string(val)), "application/ld+json")
+		}
+		if !more {
+			return false
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// internal/html/prune_test.go
+package html
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	xhtml "golang.org/x/net/html"
+)
+
+func TestPruneBoilerplate_RemovesScriptsStylesAndComments(t *testing.T) {
+	in := `<html><head><STYLE>p{color:red}</STYLE>` +
+		`<script type="application/ld+json">{"@type":"Article"}</script></head>` +
+		`<body><!-- tracking --><script>var a = "<p>not content</p>";</script>` +
+		`<p class="lead" title="a &amp; b">Kept <b>text</b></p><noscript><img src="x.gif"></noscript>` +
+		`<iframe src="https://ads.example.com/"></iframe><script src="app.js"/></body></html>`
+
+	got := string(PruneBoilerplate([]byte(in)))
+	want := `<html><head>` +
+		`<script type="application/ld+json">{"@type":"Article"}</script></head>` +
+		`<body>` +
+		`<p class="lead" title="a &amp; b">Kept <b>text</b></p>` +
+		`</body></html>`
+	if got != want {
+		t.Fatalf("PruneBoilerplate:\ngot  %q\nwant %q", got, want)
+	}
+}
+
+func TestParseArticleDocument_SmallInputIsNotPruned(t *testing.T) {
+	doc, err := ParseArticleDocument([]byte(`<html><body><script>x()</script><p>Hi</p></body></html>`))
+	if err != nil {
+		t.Fatalf("ParseArticleDocument error: %v", err)
+	}
+	var scripts []*xhtml.Node
+	findElementsByTag(doc.Root, "script", &scripts)
+	if len(scripts) != 1 {
+		t.Fatalf("script elements: got %d, want 1", len(scripts))
+	}
+}
+
+// largePage builds an HTML page of roughly size bytes in which most of
+// the weight is inline script, style and comments, as on heavy news
+// sites.
+func largePage(size int) []byte {
+	var b bytes.Buffer
+	b.WriteString("<!DOCTYPE html><html><head><title>Large</title>")
+	b.WriteString("<style>" + strings.Repeat(".c{margin:0;padding:0}\n", 2000) + "</style></head><body><article>")
+	for i := 0; b.Len() < size; i++ {
+		b.WriteString("<!-- slot -->")
+		b.WriteString(`<script>window.__data = {"items":[1,2,3],"html":"<div>x</div>"};</script>`)
+		b.WriteString(`<p>Paragraph of article text, with enough words to look like real content.</p>`)
+		b.WriteString(`<noscript><img src="/pixel.gif" width="1" height="1"></noscript>`)
+	}
+	b.WriteString("</article></body></html>")
+	return b.Bytes()
+}
+
+func BenchmarkParseDocument_Large(b *testing.B) {
+	data := largePage(4 << 20)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		if _, err := ParseDocument(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseArticleDocument_Large(b *testing.B) {
+	data := largePage(4 << 20)
+	b.ReportAllocs()
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		if _, err := ParseArticleDocument(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+// internal/html/sanitize.go
+//
+// Allowlist-based HTML sanitization. Sanitize keeps the elements and
+// attributes a Policy permits, unwraps every other element (its text
+// survives, its tags do not), and removes executable or embedded content
+// such as <script> outright, whatever the policy says. URL attributes
+// are kept only when they use a safe scheme, so javascript: links never
+// reach the output.
+
+package html
+
+import (
+	"net/url"
+	"strings"
+
+	xhtml "golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Policy lists the elements Sanitize keeps and, per element, the
+// attributes they may carry. Tag and attribute names are lowercase.
+type Policy struct {
+	Tags map[string][]string
+}
+
+// DefaultPolicy permits a small set of inline and block formatting
+// elements: b, i, em, strong, code, p, ul, li, and a with href.
+func DefaultPolicy() Policy {
+	return Policy{Tags: map[string][]string{
+		"a":      {"href"},
+		"b":      nil,
+		"code":   nil,
+		"em":     nil,
+		"i":      nil,
+		"li":     nil,
+		"p":      nil,
+		"strong": nil,
+		"ul":     nil,
+	}}
+}
+
+// droppedElements are removed together with their content under every
+// policy.
+var droppedElements = map[string]bool{
+	"script":   true,
+	"style":    true,
+	"iframe":   true,
+	"object":   true,
+	"embed":    true,
+	"noscript": true,
+	"template": true,
+}
+
+// urlAttributes hold URLs and are subject to the scheme check.
+var urlAttributes = map[string]bool{
+	"href":       true,
+	"src":        true,
+	"cite":       true,
+	"action":     true,
+	"formaction": true,
+	"poster":     true,
+}
+
+// voidElements have no end tag.
+var voidElements = map[string]bool{
+	"br": true, "hr": true, "img": true, "wbr": true,
+}
+
+// Sanitize parses fragment as HTML body content and re-serializes it
+// keeping only what p allows. Text is always escaped; comments are
+// dropped.
+func Sanitize(fragment string, p Policy) string {
+	ctx := &xhtml.Node{Type: xhtml.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := xhtml.ParseFragment(strings.NewReader(fragment), ctx)
+	if err != nil {
+		return xhtml.EscapeString(fragment)
+	}
+
+	var b strings.Builder
+	for _, n := range nodes {
+		sanitizeNode(&b, n, p)
+	}
+	return b.String()
+}
+
+func sanitizeNode(b *strings.Builder, n *xhtml.Node, p Policy) {
+	switch n.Type {
+	case xhtml.TextNode:
+		b.WriteString(xhtml.EscapeString(n.Data))
+		return
+	case xhtml.ElementNode:
+	default:
+		// Comments, doctypes: drop.
+		return
+	}
+
+	tag := strings.ToLower(n.Data)
+	if droppedElements[tag] {
+		return
+	}
+
+	allowedAttrs, ok := p.Tags[tag]
+	if ok {
+		b.WriteByte('<')
+		b.WriteString(tag)
+		for _, a := range n.Attr {
+			key := strings.ToLower(a.Key)
+			if a.Namespace != "" || !containsFold(allowedAttrs, key) {
+				continue
+			}
+			if urlAttributes[key] && !SafeURL(a.Val) {
+				continue
+			}
+			b.WriteByte(' ')
+			b.WriteString(key)
+			b.WriteString(`="`)
+			b.WriteString(xhtml.EscapeString(a.Val))
+			b.WriteByte('"')
+		}
+		b.WriteByte('>')
+		if voidElements[tag] {
+			return
+		}
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		sanitizeNode(b, c, p)
+	}
+
+	if ok {
+		b.WriteString("</")
+		b.WriteString(tag)
+		b.WriteByte('>')
+	}
+}
+
+// SafeURL reports whether raw is a relative URL or uses the http, https
+// or mailto scheme. Whitespace and control characters, which browsers
+// ignore inside schemes ("java\tscript:"), are removed before checking.
+func SafeURL(raw string) bool {
+	cleaned := strings.Map(func(r rune) rune {
+		if r <= ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, raw)
+
+	u, err := url.Parse(cleaned)
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "", "http", "https", "mailto":
+		return true
+	}
+	return false
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+// internal/html/sanitize_test.go
+package html
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSanitize_KeepsAllowedTags(t *testing.T) {
+	in := `<p>Hello <b>bold</b> and <a href="https://example.com/x" onclick="evil()">link</a></p>`
+	got := Sanitize(in, DefaultPolicy())
+	want := `<p>Hello <b>bold</b> and <a href="https://example.com/x">link</a></p>`
+	if got != want {
+		t.Fatalf("Sanitize: got %q, want %q", got, want)
+	}
+}
+
+func TestSanitize_StripsDisallowedTags(t *testing.T) {
+	in := `<div class="x"><span>kept text</span><img src="a.png"></div>`
+	got := Sanitize(in, DefaultPolicy())
+	if got != "kept text" {
+		t.Fatalf("Sanitize: got %q, want %q", got, "kept text")
+	}
+}
+
+func TestSanitize_AlwaysRemovesScript(t *testing.T) {
+	p := DefaultPolicy()
+	p.Tags["script"] = nil
+
+	got := Sanitize(`<p>a<script>alert(1)</script>b</p><style>p{}</style>`, p)
+	if got != "<p>ab</p>" {
+		t.Fatalf("Sanitize: got %q, want %q", got, "<p>ab</p>")
+	}
+}
+
+func TestSanitize_DropsJavascriptHref(t *testing.T) {
+	for _, href := range []string{"javascript:alert(1)", " JavaScript:alert(1)", "java\tscript:alert(1)"} {
+		got := Sanitize(`<a href="`+href+`">x</a>`, DefaultPolicy())
+		if got != "<a>x</a>" {
+			t.Fatalf("href %q: got %q, want %q", href, got, "<a>x</a>")
+		}
+	}
+	if !strings.Contains(Sanitize(`<a href="/rel">x</a>`, DefaultPolicy()), "href") {
+		t.Fatalf("relative href was dropped")
+	}
+}
@@ -0,0 +1,72 @@
+// internal/httpclient/cachecontrol.go
+//
+// HTTP caching directives. A response's Cache-Control and Expires
+// headers choose how long Fetch keeps it in the composite cache:
+//
+//   - Cache-Control: no-store or no-cache → not cached at all (Aether
+//     does not revalidate, so a no-cache response could never be reused)
+//   - Cache-Control: max-age=N           → cached for N seconds
+//   - Expires (without max-age)          → cached until that time,
+//     measured against the response's Date header when present
+//   - neither                            → Config.CacheTTL
+//
+// Config.CacheTTL also caps the server's choice, so a far-future
+// max-age never outlives the configured TTL.
+
+package httpclient
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cacheTTL returns how long a response with headers h may be cached and
+// whether it may be cached at all. fallback is the configured CacheTTL;
+// now is the current time, used when the response carries no Date.
+func cacheTTL(h http.Header, now time.Time, fallback time.Duration) (time.Duration, bool) {
+	ttl := fallback
+	hasMaxAge := false
+
+	for _, directive := range strings.Split(strings.Join(h.Values("Cache-Control"), ","), ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "no-store", "no-cache":
+			return 0, false
+		case "max-age":
+			secs, err := strconv.ParseInt(strings.Trim(strings.TrimSpace(value), `"`), 10, 64)
+			if err != nil {
+				continue
+			}
+			if secs <= 0 {
+				return 0, false
+			}
+			ttl = time.Duration(secs) * time.Second
+			hasMaxAge = true
+		}
+	}
+
+	// max-age takes precedence over Expires.
+	if !hasMaxAge {
+		if raw := strings.TrimSpace(h.Get("Expires")); raw != "" {
+			expires, err := http.ParseTime(raw)
+			if err != nil {
+				// Invalid dates such as "0" mean already expired.
+				return 0, false
+			}
+			if date, err := http.ParseTime(h.Get("Date")); err == nil {
+				now = date
+			}
+			ttl = expires.Sub(now)
+			if ttl <= 0 {
+				return 0, false
+			}
+		}
+	}
+
+	if fallback > 0 && ttl > fallback {
+		ttl = fallback
+	}
+	return ttl, true
+}
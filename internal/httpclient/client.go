@@ -16,12 +16,16 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strconv"
+	"sync/atomic"
 	"time"
 
 	"github.com/Nibir1/Aether/internal/cache"
+	"github.com/Nibir1/Aether/internal/clock"
 	"github.com/Nibir1/Aether/internal/config"
 	"github.com/Nibir1/Aether/internal/errors"
 	"github.com/Nibir1/Aether/internal/log"
+	"github.com/Nibir1/Aether/internal/trace"
 )
 
 // Error is Aether’s internal structured error type (re-exported).
@@ -40,6 +44,8 @@ type Client struct {
 	limiter        *hostLimiter
 	cache          cache.Cache // unified memory/file/redis cache
 	robotsOverride map[string]struct{}
+	clock          clock.Clock
+	closed         atomic.Bool // set by Close
 }
 
 // New constructs a new internal HTTP client.
@@ -57,7 +63,8 @@ func New(cfg *config.Config, logger log.Logger, unified cache.Cache) *Client {
 	}
 
 	httpClient := &http.Client{
-		Timeout: timeout,
+		Timeout:   timeout,
+		Transport: cfg.Transport,
 	}
 
 	// Build host-level robots override map from config.
@@ -76,9 +83,10 @@ func New(cfg *config.Config, logger log.Logger, unified cache.Cache) *Client {
 		logger:         logger,
 		http:           httpClient,
 		robots:         newRobotsCache(cfg),
-		limiter:        newHostLimiter(cfg.MaxConcurrentHosts, cfg.MaxRequestsPerHost),
+		limiter:        newHostLimiter(cfg.MaxConcurrentHosts, cfg.MaxRequestsPerHost, cfg.PerHostConcurrency),
 		cache:          unified,
 		robotsOverride: overrideMap,
+		clock:          clock.Or(cfg.Clock),
 	}
 }
 
@@ -88,6 +96,9 @@ func New(cfg *config.Config, logger log.Logger, unified cache.Cache) *Client {
 // - retry logic
 // - transparent User-Agent injection
 //
+// In offline mode (Config.Offline) only the cache is consulted and a miss
+// is a KindOffline error.
+//
 // headers: optional additional request headers.
 func (c *Client) Fetch(
 	ctx context.Context,
@@ -95,47 +106,36 @@ func (c *Client) Fetch(
 	headers http.Header,
 ) (*Response, error) {
 
-	parsed, err := url.Parse(rawURL)
-	if err != nil {
-		return nil, errors.New(errors.KindHTTP, "invalid URL", err)
+	if c.closed.Load() {
+		return nil, closedError(rawURL)
 	}
-	hostKey := parsed.Host
 
-	// Global + per-host concurrency limiting.
-	if err := c.limiter.Acquire(ctx, hostKey); err != nil {
-		return nil, errors.New(errors.KindHTTP, "acquiring concurrency slot failed", err)
-	}
-	defer c.limiter.Release(hostKey)
+	ctx, end := trace.Start(c.cfg.Tracer, ctx, trace.SpanFetch)
+	defer end()
 
-	// Robots.txt check (fail-closed for robots violations).
-	//
-	// Option A override:
-	//   - If the host is listed in robotsOverride, robots.allowed()
-	//     will *immediately* return (true, nil) and skip any robots
-	//     fetching/parsing.
-	allowed, err := c.robots.allowed(ctx, rawURL, c.cfg.UserAgent, c.http)
-	if err != nil {
-		return nil, err
-	}
-	if !allowed {
-		return nil, errors.New(errors.KindRobots, "access disallowed by robots.txt", nil)
-	}
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
 
 	// ---- Composite Cache Check (memory → file → redis)
 	cacheKey := "http:" + rawURL
 
-	if c.cache != nil {
-		if cached, ok := c.cache.Get(cacheKey); ok {
-			c.logger.Debugf("cache hit (composite) for %s", rawURL)
-
-			return &Response{
-				URL:        rawURL,
-				StatusCode: http.StatusOK,
-				Header:     http.Header{"X-Aether-Cache": []string{"HIT"}},
-				Body:       cached,
-				FetchedAt:  time.Now(),
-			}, nil
+	// Offline mode answers from the cache alone; admit would refuse the
+	// robots.txt lookup anyway.
+	if c.cfg.Offline {
+		if cached, ok := c.cached(cacheKey, rawURL); ok {
+			return cached, nil
 		}
+		return nil, offlineError(rawURL)
+	}
+
+	release, err := c.admit(ctx, rawURL)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	if cached, ok := c.cached(cacheKey, rawURL); ok {
+		return cached, nil
 	}
 
 	// ---- Build headers for request
@@ -149,6 +149,7 @@ func (c *Client) Fetch(
 	if reqHeaders.Get("Accept") == "" {
 		reqHeaders.Set("Accept", "*/*")
 	}
+	c.setAcceptLanguage(reqHeaders)
 
 	// ---- Retry Logic
 	const maxRetries = 2
@@ -172,11 +173,12 @@ func (c *Client) Fetch(
 
 		resp, err := c.http.Do(req)
 		if err != nil {
-			if !isRetryableError(err) || attempt == maxRetries {
+			// A timeout caused by ctx itself is not worth retrying.
+			if !isRetryableError(err) || attempt == maxRetries || ctx.Err() != nil {
 				return nil, errors.New(errors.KindHTTP, "request failed", err)
 			}
 			lastErr = err
-			time.Sleep(backoff)
+			c.clock.Sleep(backoff)
 			backoff *= 2
 			continue
 		}
@@ -188,7 +190,7 @@ func (c *Client) Fetch(
 				return nil, errors.New(errors.KindHTTP, "reading response failed", readErr)
 			}
 			lastErr = readErr
-			time.Sleep(backoff)
+			c.clock.Sleep(backoff)
 			backoff *= 2
 			continue
 		}
@@ -198,12 +200,18 @@ func (c *Client) Fetch(
 			StatusCode: resp.StatusCode,
 			Header:     resp.Header.Clone(),
 			Body:       body,
-			FetchedAt:  time.Now(),
+			FetchedAt:  c.clock.Now(),
 		}
 
-		// ---- Store in unified cache (only cache 200 OK)
+		// ---- Store in unified cache (only cache 200 OK, honouring
+		// Cache-Control / Expires; see cachecontrol.go). The TTL chosen
+		// is reported in HeaderCacheTTL, "0" when nothing was stored.
+		out.Header.Set(HeaderCacheTTL, "0")
 		if resp.StatusCode == http.StatusOK && c.cache != nil {
-			c.cache.Set(cacheKey, body, c.cfg.CacheTTL)
+			if ttl, ok := cacheTTL(resp.Header, out.FetchedAt, c.cfg.CacheTTL); ok {
+				out.Header.Set(HeaderCacheTTL, strconv.FormatInt(int64(ttl/time.Second), 10))
+				c.cache.Set(cacheKey, encodeStored(out), ttl)
+			}
 		}
 
 		return out, nil
@@ -215,6 +223,105 @@ func (c *Client) Fetch(
 	return nil, errors.New(errors.KindHTTP, "request failed for unknown reasons", nil)
 }
 
+// cached returns the cached response stored under cacheKey, if any.
+func (c *Client) cached(cacheKey, rawURL string) (*Response, bool) {
+	if c.cache == nil {
+		return nil, false
+	}
+	stored, ok := c.cache.Get(cacheKey)
+	if !ok {
+		return nil, false
+	}
+	c.logger.Debugf("cache hit (composite) for %s", rawURL)
+
+	hdr, fetchedAt, body := decodeStored(stored)
+	hdr.Set(HeaderCache, "HIT")
+	if fetchedAt.IsZero() {
+		fetchedAt = c.clock.Now()
+	} else {
+		age := int64(c.clock.Now().Sub(fetchedAt) / time.Second)
+		hdr.Set(HeaderCacheAge, strconv.FormatInt(max(age, 0), 10))
+	}
+
+	return &Response{
+		URL:        rawURL,
+		StatusCode: http.StatusOK,
+		Header:     hdr,
+		Body:       body,
+		FetchedAt:  fetchedAt,
+		FromCache:  true,
+	}, true
+}
+
+// offlineError reports that rawURL would need the network while
+// offline mode is enabled.
+func offlineError(rawURL string) error {
+	return errors.New(errors.KindOffline, "no cached response for "+rawURL+" in offline mode", nil)
+}
+
+// requestContext derives the context for one request, bounded by
+// PerRequestTimeout when configured. The caller's deadline still applies
+// when it is the earlier of the two.
+func (c *Client) requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	if d := c.cfg.PerRequestTimeout; d > 0 {
+		return context.WithTimeout(ctx, d)
+	}
+	return ctx, func() {}
+}
+
+// admit parses rawURL, reserves a global and per-host concurrency slot,
+// and enforces robots.txt. On success the returned func releases the
+// slot and must be called once the request is finished. In offline mode
+// nothing may touch the network, so admit fails with KindOffline; after
+// Close it fails with KindClosed.
+func (c *Client) admit(ctx context.Context, rawURL string) (func(), error) {
+	if c.closed.Load() {
+		return nil, closedError(rawURL)
+	}
+	if c.cfg.Offline {
+		return nil, offlineError(rawURL)
+	}
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errors.New(errors.KindHTTP, "invalid URL", err)
+	}
+	hostKey := parsed.Host
+
+	// Global + per-host concurrency limiting.
+	if err := c.limiter.Acquire(ctx, hostKey); err != nil {
+		return nil, errors.New(errors.KindHTTP, "acquiring concurrency slot failed", err)
+	}
+	release := func() { c.limiter.Release(hostKey) }
+
+	// Robots.txt check (fail-closed for robots violations).
+	//
+	// Option A override:
+	//   - If the host is listed in robotsOverride, robots.allowed()
+	//     will *immediately* return (true, nil) and skip any robots
+	//     fetching/parsing.
+	robotsCtx, end := trace.Start(c.cfg.Tracer, ctx, trace.SpanRobots)
+	allowed, err := c.robots.allowed(robotsCtx, rawURL, c.cfg.UserAgent, c.http)
+	end()
+	if err != nil {
+		release()
+		return nil, err
+	}
+	if !allowed {
+		release()
+		return nil, errors.New(errors.KindRobots, "access disallowed by robots.txt", nil)
+	}
+	return release, nil
+}
+
+// setAcceptLanguage applies the configured Accept-Language unless h
+// already carries one.
+func (c *Client) setAcceptLanguage(h http.Header) {
+	if c.cfg.AcceptLanguage != "" && h.Get("Accept-Language") == "" {
+		h.Set("Accept-Language", c.cfg.AcceptLanguage)
+	}
+}
+
 // isRetryableError reports whether the error is transient.
 func isRetryableError(err error) bool {
 	if ne, ok := err.(net.Error); ok {
@@ -0,0 +1,31 @@
+// internal/httpclient/close.go
+//
+// Shutdown of the HTTP client.
+//
+// Close makes every later request fail with KindClosed and releases the
+// idle keep-alive connections held by the transport. Requests already
+// in flight run to completion and their connections are released as
+// they finish.
+
+package httpclient
+
+import (
+	"github.com/Nibir1/Aether/internal/errors"
+)
+
+// Close stops the client from making further requests and closes its
+// idle connections. It is safe to call more than once.
+func (c *Client) Close() {
+	c.closed.Store(true)
+	c.http.CloseIdleConnections()
+}
+
+// Closed reports whether Close has been called.
+func (c *Client) Closed() bool {
+	return c.closed.Load()
+}
+
+// closedError is the error for a request made after Close.
+func closedError(target string) error {
+	return errors.New(errors.KindClosed, "client is closed; cannot request "+target, nil)
+}
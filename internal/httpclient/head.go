@@ -0,0 +1,58 @@
+// internal/httpclient/head.go
+//
+// This file implements robots.txt-compliant HTTP HEAD requests. HEAD is
+// used for cheap existence and metadata checks (content type, size)
+// before committing to a full GET. Responses are never cached and are
+// not retried: a failed HEAD is cheap to replace with the GET the caller
+// was going to issue anyway.
+
+package httpclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/Nibir1/Aether/internal/errors"
+)
+
+// Head performs a robots.txt-compliant HTTP HEAD for rawURL, subject to
+// the same concurrency limits as Fetch. The returned Response carries
+// the status code and headers and an empty Body.
+//
+// Non-2xx statuses are returned as-is rather than as errors; servers
+// that reject HEAD typically answer 405 or 501, and callers should fall
+// back to GET in that case.
+func (c *Client) Head(ctx context.Context, rawURL string) (*Response, error) {
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+
+	release, err := c.admit(ctx, rawURL)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, rawURL, nil)
+	if err != nil {
+		return nil, errors.New(errors.KindHTTP, "creating request failed", err)
+	}
+	req.Header.Set("User-Agent", c.cfg.UserAgent)
+	req.Header.Set("Accept", "*/*")
+	c.setAcceptLanguage(req.Header)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, errors.New(errors.KindHTTP, "request failed", err)
+	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	return &Response{
+		URL:        rawURL,
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header.Clone(),
+		Body:       []byte{},
+		FetchedAt:  c.clock.Now(),
+	}, nil
+}
@@ -3,25 +3,34 @@
 // This file implements simple concurrency limiting for outbound HTTP
 // requests, both globally and per-host. It helps ensure that Aether
 // behaves politely when accessing remote servers.
+//
+// Individual hosts can be given their own per-host limit (see
+// config.PerHostConcurrency). The host slot is taken before the global
+// one, so requests queued behind a tightly capped host do not hold
+// global slots that other hosts could use.
 package httpclient
 
 import (
 	"context"
+	"net"
+	"strings"
 	"sync"
 )
 
 // hostLimiter controls concurrent access to remote hosts.
 type hostLimiter struct {
-	globalCh chan struct{}
-	maxPer   int
+	globalCh  chan struct{}
+	maxPer    int
+	overrides map[string]int // lowercase "host" or "host:port" → limit
 
 	mu      sync.Mutex
 	perHost map[string]chan struct{}
 }
 
 // newHostLimiter constructs a limiter with the given global and
-// per-host concurrency limits.
-func newHostLimiter(maxHosts, maxPerHost int) *hostLimiter {
+// per-host concurrency limits. overrides replaces maxPerHost for the
+// hosts it lists.
+func newHostLimiter(maxHosts, maxPerHost int, overrides map[string]int) *hostLimiter {
 	if maxHosts <= 0 {
 		maxHosts = 4
 	}
@@ -29,29 +38,46 @@ func newHostLimiter(maxHosts, maxPerHost int) *hostLimiter {
 		maxPerHost = 4
 	}
 	return &hostLimiter{
-		globalCh: make(chan struct{}, maxHosts),
-		maxPer:   maxPerHost,
-		perHost:  make(map[string]chan struct{}),
+		globalCh:  make(chan struct{}, maxHosts),
+		maxPer:    maxPerHost,
+		overrides: overrides,
+		perHost:   make(map[string]chan struct{}),
 	}
 }
 
+// limitFor returns the per-host limit for host ("name" or "name:port"):
+// an override for the exact host and port, then one for the name alone,
+// then the default.
+func (l *hostLimiter) limitFor(host string) int {
+	host = strings.ToLower(host)
+	if n, ok := l.overrides[host]; ok {
+		return n
+	}
+	if name, _, err := net.SplitHostPort(host); err == nil {
+		if n, ok := l.overrides[name]; ok {
+			return n
+		}
+	}
+	return l.maxPer
+}
+
 // Acquire reserves a slot for the given host. It respects context
 // cancellation.
 func (l *hostLimiter) Acquire(ctx context.Context, host string) error {
+	ch := l.getHostChan(host)
+
 	select {
-	case l.globalCh <- struct{}{}:
-		// acquired global slot
+	case ch <- struct{}{}:
+		// acquired host slot
 	case <-ctx.Done():
 		return ctx.Err()
 	}
 
-	ch := l.getHostChan(host)
-
 	select {
-	case ch <- struct{}{}:
+	case l.globalCh <- struct{}{}:
 		return nil
 	case <-ctx.Done():
-		<-l.globalCh // release global
+		<-ch // release host
 		return ctx.Err()
 	}
 }
@@ -82,7 +108,7 @@ func (l *hostLimiter) getHostChan(host string) chan struct{} {
 
 	ch, ok := l.perHost[host]
 	if !ok {
-		ch = make(chan struct{}, l.maxPer)
+		ch = make(chan struct{}, l.limitFor(host))
 		l.perHost[host] = ch
 	}
 	return ch
@@ -17,6 +17,10 @@ type Response struct {
 	Header     http.Header
 	Body       []byte
 	FetchedAt  time.Time
+
+	// FromCache is true when the response was served from the unified
+	// cache; FetchedAt then holds the original fetch time.
+	FromCache bool
 }
 
 // clone creates a deep copy of the response suitable for reuse in
@@ -40,5 +44,6 @@ func (r *Response) clone() *Response {
 		Header:     hdr,
 		Body:       bodyCopy,
 		FetchedAt:  r.FetchedAt,
+		FromCache:  r.FromCache,
 	}
 }
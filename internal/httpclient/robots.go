@@ -20,6 +20,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -183,3 +184,89 @@ func (c *robotsCache) fetch(
 
 	return entry, nil
 }
+
+// hosts returns the cached origins ("scheme://host[:port]"), sorted.
+func (c *robotsCache) hosts() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	out := make([]string, 0, len(c.entries))
+	for k := range c.entries {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// evict removes the entries for host and returns their origins. host is
+// either an origin ("https://example.com"), which matches exactly, or a
+// bare host name, which matches every scheme and port.
+func (c *robotsCache) evict(host string) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var removed []string
+	for k := range c.entries {
+		if originMatches(k, host) {
+			delete(c.entries, k)
+			removed = append(removed, k)
+		}
+	}
+	sort.Strings(removed)
+	return removed
+}
+
+// originMatches reports whether the cache key origin is named by host.
+func originMatches(origin, host string) bool {
+	if strings.Contains(host, "://") {
+		return strings.EqualFold(origin, strings.TrimRight(host, "/"))
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return canonicalHost(u.Host) == canonicalHost(host)
+}
+
+// RobotsHosts returns the origins whose robots.txt is currently cached,
+// sorted.
+func (c *Client) RobotsHosts() []string {
+	return c.robots.hosts()
+}
+
+// RefreshRobots drops the cached robots.txt for host and fetches it
+// again. host is an origin or a bare host name (see evict); a bare name
+// that is not cached is refreshed over https. If the fetch fails the
+// entry stays evicted, so the next request retries it. In offline mode
+// the entry is evicted and KindOffline is returned.
+func (c *Client) RefreshRobots(ctx context.Context, host string) error {
+	host = strings.TrimSpace(host)
+	if host == "" {
+		return errors.New(errors.KindHTTP, "empty host for robots refresh", nil)
+	}
+
+	if c.closed.Load() {
+		return closedError(host)
+	}
+
+	origins := c.robots.evict(host)
+	if len(origins) == 0 {
+		if strings.Contains(host, "://") {
+			origins = []string{strings.TrimRight(host, "/")}
+		} else {
+			origins = []string{"https://" + host}
+		}
+	}
+	if c.cfg.Offline {
+		return offlineError(origins[0] + "/robots.txt")
+	}
+
+	ctx, cancel := c.requestContext(ctx)
+	defer cancel()
+	for _, origin := range origins {
+		if _, err := c.robots.fetch(ctx, origin, c.http); err != nil {
+			return err
+		}
+	}
+	return nil
+}
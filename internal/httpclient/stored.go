@@ -0,0 +1,111 @@
+// internal/httpclient/stored.go
+//
+// Encoding of responses stored in the unified cache. Besides the body,
+// an entry records when it was fetched and a few representation headers,
+// so cache hits can report their age and keep their Content-Type.
+//
+// Layout:
+//
+//   AETHER-CACHE/1\n
+//   <fetched-at, unix nanoseconds>\n
+//   Header-Name: value\n      (zero or more)
+//   \n
+//   raw body bytes
+//
+// Entries written before this format existed are plain bodies; they
+// decode with an unknown fetch time and no headers.
+
+package httpclient
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const storedMagic = "AETHER-CACHE/1\n"
+
+// Cache status headers added to responses served from the cache.
+// HeaderCacheTTL is also set on fresh responses: it carries the TTL, in
+// whole seconds, the response was cached for ("0" = not cached).
+const (
+	HeaderCache    = "X-Aether-Cache"
+	HeaderCacheAge = "X-Aether-Cache-Age"
+	HeaderCacheTTL = "X-Aether-Cache-TTL"
+)
+
+// storedHeaders are the response headers preserved in cache entries.
+var storedHeaders = []string{"Content-Type", "Content-Language", "ETag", "Last-Modified", HeaderCacheTTL}
+
+func encodeStored(resp *Response) []byte {
+	var b bytes.Buffer
+	b.WriteString(storedMagic)
+	b.WriteString(strconv.FormatInt(resp.FetchedAt.UnixNano(), 10))
+	b.WriteByte('\n')
+	for _, name := range storedHeaders {
+		if v := resp.Header.Get(name); v != "" {
+			b.WriteString(name + ": " + v + "\n")
+		}
+	}
+	b.WriteByte('\n')
+	b.Write(resp.Body)
+	return b.Bytes()
+}
+
+// decodeStored splits a cache entry into headers, fetch time and body.
+// A zero time means the entry predates the stored format.
+func decodeStored(data []byte) (http.Header, time.Time, []byte) {
+	if !bytes.HasPrefix(data, []byte(storedMagic)) {
+		return http.Header{}, time.Time{}, data
+	}
+	rest := data[len(storedMagic):]
+
+	end := bytes.Index(rest, []byte("\n\n"))
+	if end < 0 {
+		return http.Header{}, time.Time{}, data
+	}
+	lines := strings.Split(string(rest[:end]), "\n")
+	body := rest[end+2:]
+
+	var fetched time.Time
+	if ns, err := strconv.ParseInt(lines[0], 10, 64); err == nil {
+		fetched = time.Unix(0, ns)
+	}
+
+	hdr := http.Header{}
+	for _, line := range lines[1:] {
+		if k, v, ok := strings.Cut(line, ": "); ok {
+			hdr.Set(k, v)
+		}
+	}
+	return hdr, fetched, body
+}
+
+// CacheStatus reports whether a response was served from Aether's cache
+// and, when known, how long ago it was originally fetched.
+func CacheStatus(h http.Header) (bool, time.Duration) {
+	if h == nil || h.Get(HeaderCache) != "HIT" {
+		return false, 0
+	}
+	secs, err := strconv.ParseInt(h.Get(HeaderCacheAge), 10, 64)
+	if err != nil || secs < 0 {
+		return true, 0
+	}
+	return true, time.Duration(secs) * time.Second
+}
+
+// CacheTTL reports the TTL a response was cached for, as chosen from its
+// Cache-Control / Expires headers and Config.CacheTTL. It is 0 when the
+// response was not cached or the header is missing.
+func CacheTTL(h http.Header) time.Duration {
+	if h == nil {
+		return 0
+	}
+	secs, err := strconv.ParseInt(h.Get(HeaderCacheTTL), 10, 64)
+	if err != nil || secs < 0 {
+		return 0
+	}
+	return time.Duration(secs) * time.Second
+}
@@ -40,6 +40,14 @@ const (
 	// Special metadata sections (fallback)
 	SectionRoleMetadata SectionRole = "metadata"
 
+	// Source code or preformatted text, rendered verbatim. The
+	// language, if known, is stored in Meta["lang"].
+	SectionRoleCode SectionRole = "code"
+
+	// Tabular data. Text holds the table as a Markdown pipe table (see
+	// FormatTable and ParseTable).
+	SectionRoleTable SectionRole = "table"
+
 	// Unknown / unspecified
 	SectionRoleUnknown SectionRole = "unknown"
 )
@@ -119,6 +127,17 @@ type Document struct {
 	// Arbitrary key/value metadata
 	Metadata map[string]string `json:"metadata,omitempty"`
 
+	// TypedMetadata optionally mirrors selected Metadata entries as
+	// JSON-native values (int64, float64 or bool), so that numbers such
+	// as "score" serialize unquoted. Metadata remains the source of
+	// truth; this map is only filled when typed metadata is requested.
+	TypedMetadata map[string]any `json:"typed_metadata,omitempty"`
+
+	// Links lists the absolute outbound links found while normalizing:
+	// the source URL, article anchors, feed and item links, and entity
+	// URLs, de-duplicated in first-seen order.
+	Links []string `json:"links,omitempty"`
+
 	// Structured content
 	// Examples:
 	//   - article body paragraphs
@@ -127,3 +146,39 @@ type Document struct {
 	//   - metadata blocks
 	Sections []Section `json:"sections,omitempty"`
 }
+
+// Metadata keys marking a document that represents "nothing found".
+const (
+	// MetaEmpty is set to "true" on documents normalized from a result
+	// that carried no content at all.
+	MetaEmpty = "empty"
+
+	// MetaDiagnostic explains why a document is empty.
+	MetaDiagnostic = "diagnostic"
+)
+
+// MetaVerbatim marks a SectionRoleCode section split out of prose
+// content. Its value is VerbatimCode for code blocks or VerbatimMath for
+// display math, whose Text keeps its $$ delimiters. Renderers must emit
+// such sections without reflowing or collapsing whitespace.
+const (
+	MetaVerbatim = "verbatim"
+
+	VerbatimCode = "code"
+	VerbatimMath = "math"
+)
+
+// MetaHeadingLevel optionally records the outline level (1–6) of a
+// section heading, as in <h1>–<h6>. Sections without it are treated as
+// level 2, or level 3 for code, metadata and table sections.
+const MetaHeadingLevel = "heading_level"
+
+// IsEmpty reports whether d stands for "nothing was found": it is nil or
+// was marked empty by normalization. A document that was found but has
+// blank content is not empty.
+func (d *Document) IsEmpty() bool {
+	if d == nil {
+		return true
+	}
+	return d.Metadata[MetaEmpty] == "true"
+}
@@ -0,0 +1,59 @@
+// internal/model/sections.go
+//
+// Section lookup and mutation helpers for Document.
+//
+// Post-processing a normalized document (dropping metadata blocks before
+// rendering, pulling out the summary, listing feed items) usually means
+// filtering Sections by role. These helpers keep that terse and are safe
+// to call on a nil or empty document.
+
+package model
+
+// SectionsByRole returns the sections with the given role, in document
+// order. The returned slice is a copy; modifying it does not affect d.
+// It returns nil when d is nil or has no matching sections.
+func (d *Document) SectionsByRole(role SectionRole) []Section {
+	if d == nil {
+		return nil
+	}
+	var out []Section
+	for _, s := range d.Sections {
+		if s.Role == role {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// FirstSection returns a pointer to the first section with the given
+// role, or nil if there is none. The pointer refers to the section
+// inside d, so changes through it are visible in the document.
+func (d *Document) FirstSection(role SectionRole) *Section {
+	if d == nil {
+		return nil
+	}
+	for i := range d.Sections {
+		if d.Sections[i].Role == role {
+			return &d.Sections[i]
+		}
+	}
+	return nil
+}
+
+// RemoveSectionsByRole deletes every section with the given role,
+// preserving the order of the rest, and returns how many were removed.
+func (d *Document) RemoveSectionsByRole(role SectionRole) int {
+	if d == nil || len(d.Sections) == 0 {
+		return 0
+	}
+	kept := d.Sections[:0]
+	for _, s := range d.Sections {
+		if s.Role != role {
+			kept = append(kept, s)
+		}
+	}
+	removed := len(d.Sections) - len(kept)
+	clear(d.Sections[len(kept):])
+	d.Sections = kept
+	return removed
+}
@@ -0,0 +1,82 @@
+// internal/model/sections_test.go
+package model
+
+import "testing"
+
+func sectionsFixture() *Document {
+	return &Document{
+		Sections: []Section{
+			{Role: SectionRoleSummary, Text: "sum"},
+			{Role: SectionRoleBody, Text: "one"},
+			{Role: SectionRoleMetadata, Text: "meta"},
+			{Role: SectionRoleBody, Text: "two"},
+		},
+	}
+}
+
+func TestDocument_SectionsByRole(t *testing.T) {
+	d := sectionsFixture()
+	got := d.SectionsByRole(SectionRoleBody)
+	if len(got) != 2 || got[0].Text != "one" || got[1].Text != "two" {
+		t.Fatalf("SectionsByRole: got %+v", got)
+	}
+
+	got[0].Text = "changed"
+	if d.Sections[1].Text != "one" {
+		t.Fatalf("SectionsByRole result aliases the document")
+	}
+
+	if got := d.SectionsByRole(SectionRoleFeedItem); got != nil {
+		t.Fatalf("no match: got %+v, want nil", got)
+	}
+}
+
+func TestDocument_FirstSection(t *testing.T) {
+	d := sectionsFixture()
+	s := d.FirstSection(SectionRoleBody)
+	if s == nil || s.Text != "one" {
+		t.Fatalf("FirstSection: got %+v", s)
+	}
+
+	s.Heading = "Intro"
+	if d.Sections[1].Heading != "Intro" {
+		t.Fatalf("FirstSection should point into the document")
+	}
+
+	if s := d.FirstSection(SectionRoleEntity); s != nil {
+		t.Fatalf("no match: got %+v, want nil", s)
+	}
+}
+
+func TestDocument_RemoveSectionsByRole(t *testing.T) {
+	d := sectionsFixture()
+	if n := d.RemoveSectionsByRole(SectionRoleBody); n != 2 {
+		t.Fatalf("removed: got %d, want 2", n)
+	}
+	if len(d.Sections) != 2 ||
+		d.Sections[0].Role != SectionRoleSummary ||
+		d.Sections[1].Role != SectionRoleMetadata {
+		t.Fatalf("remaining: got %+v", d.Sections)
+	}
+
+	if n := d.RemoveSectionsByRole(SectionRoleBody); n != 0 {
+		t.Fatalf("second removal: got %d, want 0", n)
+	}
+}
+
+func TestDocument_SectionHelpersNilSafe(t *testing.T) {
+	var nilDoc *Document
+	empty := &Document{}
+
+	for _, d := range []*Document{nilDoc, empty} {
+		if got := d.SectionsByRole(SectionRoleBody); got != nil {
+			t.Fatalf("SectionsByRole: got %+v, want nil", got)
+		}
+		if got := d.FirstSection(SectionRoleBody); got != nil {
+			t.Fatalf("FirstSection: got %+v, want nil", got)
+		}
+		if n := d.RemoveSectionsByRole(SectionRoleBody); n != 0 {
+			t.Fatalf("RemoveSectionsByRole: got %d, want 0", n)
+		}
+	}
+}
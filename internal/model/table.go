@@ -0,0 +1,118 @@
+// internal/model/table.go
+//
+// Text encoding of SectionRoleTable sections.
+//
+// A table section stores its cells in Section.Text as a Markdown (GFM)
+// pipe table: a header row, a delimiter row, then one line per body
+// row. The form reads well as plain text, is valid Markdown, and keeps
+// the header/row structure so renderers can draw a real table.
+
+package model
+
+import "strings"
+
+// FormatTable encodes header and rows as a pipe table. Rows are padded
+// to the widest row; cell whitespace is collapsed and "|" is escaped.
+// It returns "" when there are no columns.
+func FormatTable(header []string, rows [][]string) string {
+	cols := len(header)
+	for _, r := range rows {
+		cols = max(cols, len(r))
+	}
+	if cols == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	writeRow := func(cells []string) {
+		b.WriteByte('|')
+		for i := 0; i < cols; i++ {
+			cell := ""
+			if i < len(cells) {
+				cell = strings.Join(strings.Fields(cells[i]), " ")
+				cell = strings.ReplaceAll(cell, "|", `\|`)
+			}
+			b.WriteByte(' ')
+			b.WriteString(cell)
+			b.WriteString(" |")
+		}
+		b.WriteByte('\n')
+	}
+
+	writeRow(header)
+	b.WriteByte('|')
+	for i := 0; i < cols; i++ {
+		b.WriteString(" --- |")
+	}
+	b.WriteByte('\n')
+	for _, r := range rows {
+		writeRow(r)
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// ParseTable decodes a pipe table written by FormatTable, or any GFM
+// table whose lines all start with "|". ok is false when text is not a
+// table: fewer than two lines, or a second line that is not a delimiter
+// row.
+func ParseTable(text string) (header []string, rows [][]string, ok bool) {
+	lines := strings.Split(strings.TrimSpace(text), "\n")
+	if len(lines) < 2 {
+		return nil, nil, false
+	}
+	for _, l := range lines {
+		if !strings.HasPrefix(strings.TrimSpace(l), "|") {
+			return nil, nil, false
+		}
+	}
+	if !isDelimiterRow(splitTableRow(lines[1])) {
+		return nil, nil, false
+	}
+
+	header = splitTableRow(lines[0])
+	for _, l := range lines[2:] {
+		rows = append(rows, splitTableRow(l))
+	}
+	return header, rows, true
+}
+
+// splitTableRow splits one pipe-table line into trimmed, unescaped
+// cells.
+func splitTableRow(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, `\|`) {
+		line = line[:len(line)-1]
+	}
+
+	var cells []string
+	var cur strings.Builder
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '\\' && i+1 < len(line) && line[i+1] == '|':
+			cur.WriteByte('|')
+			i++
+		case line[i] == '|':
+			cells = append(cells, strings.TrimSpace(cur.String()))
+			cur.Reset()
+		default:
+			cur.WriteByte(line[i])
+		}
+	}
+	return append(cells, strings.TrimSpace(cur.String()))
+}
+
+// isDelimiterRow reports whether cells form a GFM delimiter row such as
+// "--- | :---: | ---:".
+func isDelimiterRow(cells []string) bool {
+	if len(cells) == 0 {
+		return false
+	}
+	for _, c := range cells {
+		c = strings.TrimSuffix(strings.TrimPrefix(c, ":"), ":")
+		if c == "" || strings.Trim(c, "-") != "" {
+			return false
+		}
+	}
+	return true
+}
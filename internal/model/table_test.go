@@ -0,0 +1,38 @@
+// internal/model/table_test.go
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFormatTable_RoundTrip(t *testing.T) {
+	header := []string{"Planet", "Moons"}
+	rows := [][]string{{"Earth", "1"}, {"Mars  has\ttwo", "2 | small"}, {"Venus"}}
+
+	text := FormatTable(header, rows)
+	want := "| Planet | Moons |\n| --- | --- |\n| Earth | 1 |\n| Mars has two | 2 \\| small |\n| Venus |  |"
+	if text != want {
+		t.Fatalf("FormatTable:\ngot  %q\nwant %q", text, want)
+	}
+
+	gotHeader, gotRows, ok := ParseTable(text)
+	if !ok {
+		t.Fatalf("ParseTable: not recognized as a table")
+	}
+	if !reflect.DeepEqual(gotHeader, header) {
+		t.Fatalf("header: got %q, want %q", gotHeader, header)
+	}
+	wantRows := [][]string{{"Earth", "1"}, {"Mars has two", "2 | small"}, {"Venus", ""}}
+	if !reflect.DeepEqual(gotRows, wantRows) {
+		t.Fatalf("rows: got %q, want %q", gotRows, wantRows)
+	}
+}
+
+func TestParseTable_RejectsProse(t *testing.T) {
+	for _, text := range []string{"", "| just one line |", "| a | b |\n| not | delimiter |", "plain\ntext"} {
+		if _, _, ok := ParseTable(text); ok {
+			t.Fatalf("ParseTable(%q): got ok, want not a table", text)
+		}
+	}
+}
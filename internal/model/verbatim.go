@@ -0,0 +1,23 @@
+// internal/model/verbatim.go
+//
+// Text helpers for verbatim (code and display math) sections, shared by
+// normalization, the renderers and the TOON encoder so they agree on
+// what a verbatim block looks like.
+
+package model
+
+import "strings"
+
+// TrimBlankLines removes leading and trailing blank lines from a
+// verbatim block, keeping the indentation of the first and last
+// non-blank lines and every line in between untouched.
+func TrimBlankLines(s string) string {
+	lines := strings.Split(s, "\n")
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,88 @@
+// internal/normalize/excerpt.go
+//
+// Excerpt strategies.
+//
+// By default a derived excerpt is a prefix of the content, which for
+// HTML pages often starts with a byline, a date or a one-line teaser.
+// ExcerptFirstParagraph instead picks the first paragraph that is long
+// enough to describe the document. It only applies to article and HTML
+// documents, and never replaces an excerpt supplied by the source.
+
+package normalize
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+// ExcerptStrategy selects how Pipeline derives a document excerpt.
+type ExcerptStrategy string
+
+const (
+	// ExcerptPrefix uses the first characters of the content (the
+	// default).
+	ExcerptPrefix ExcerptStrategy = "prefix"
+
+	// ExcerptFirstParagraph uses the first paragraph of at least
+	// MinExcerptParagraphLength characters, falling back to the prefix
+	// when there is none.
+	ExcerptFirstParagraph ExcerptStrategy = "first_paragraph"
+)
+
+// MinExcerptParagraphLength is the shortest paragraph, in runes, that
+// ExcerptFirstParagraph accepts.
+const MinExcerptParagraphLength = 80
+
+// excerptLength caps derived excerpts, matching deriveExcerpt.
+const excerptLength = 240
+
+// WithExcerptStrategy selects how excerpts are derived for article and
+// HTML documents.
+func WithExcerptStrategy(s ExcerptStrategy) Option {
+	return func(o *options) {
+		o.excerpt = s
+	}
+}
+
+// applyExcerptStrategy re-derives doc.Excerpt according to strategy. A
+// source-supplied excerpt on the primary document is kept.
+func applyExcerptStrategy(doc *model.Document, sr *SearchResult, strategy ExcerptStrategy) {
+	if strategy != ExcerptFirstParagraph {
+		return
+	}
+	if doc.Kind != model.DocumentKindArticle && doc.Kind != model.DocumentKindHTML {
+		return
+	}
+	if sr.PrimaryDocument != nil && safeTrim(sr.PrimaryDocument.Excerpt) != "" {
+		return
+	}
+
+	text := doc.Content
+	if sr.Article != nil && safeTrim(sr.Article.Content) != "" {
+		text = sr.Article.Content
+	}
+	if p := firstParagraph(text, MinExcerptParagraphLength); p != "" {
+		doc.Excerpt = Excerpt(p, excerptLength)
+	}
+}
+
+// firstParagraph returns the first paragraph of text with at least
+// minLen runes once whitespace is collapsed, or "". Paragraphs are
+// separated by blank lines; text without blank lines is split into
+// lines instead.
+func firstParagraph(text string, minLen int) string {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	paras := strings.Split(text, "\n\n")
+	if len(paras) == 1 {
+		paras = strings.Split(text, "\n")
+	}
+	for _, p := range paras {
+		p = collapseWhitespace(p)
+		if utf8.RuneCountInString(p) >= minLen {
+			return p
+		}
+	}
+	return ""
+}
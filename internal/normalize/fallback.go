@@ -0,0 +1,151 @@
+// internal/normalize/fallback.go
+//
+// Title and excerpt fallback chains.
+//
+// When a source supplies no title, the schema normalizers fall back to
+// the excerpt, the URL or "(untitled)"; when it supplies no excerpt,
+// one is cut from the start of the content. Pages usually describe
+// themselves better in their metadata (og_title, twitter_title,
+// og_description), so WithTitleFallbacks and WithExcerptFallbacks let
+// callers list where a missing title or excerpt should come from
+// instead. Chains are tried in order and stop at the first source that
+// yields a non-empty value; when none does, the built-in value is kept.
+
+package normalize
+
+import (
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+// Fallback sources that are not metadata keys. Every other chain entry
+// names a metadata key.
+const (
+	// FallbackFirstHeading is the first section heading.
+	FallbackFirstHeading = "@first_heading"
+
+	// FallbackURLSegment is the last path segment of the source URL,
+	// with its extension dropped and "-" and "_" read as spaces.
+	FallbackURLSegment = "@url_segment"
+
+	// FallbackFirstParagraph is the first paragraph of the content, or
+	// of the first section with text.
+	FallbackFirstParagraph = "@first_paragraph"
+)
+
+// DefaultTitleFallbacks is a title chain suited to HTML pages.
+var DefaultTitleFallbacks = []string{"og_title", "twitter_title", FallbackFirstHeading, FallbackURLSegment}
+
+// DefaultExcerptFallbacks is an excerpt chain suited to HTML pages.
+var DefaultExcerptFallbacks = []string{"og_description", "twitter_description", "description", FallbackFirstParagraph}
+
+// WithTitleFallbacks sets the sources tried, in order, for the title of
+// a document whose source has none. Entries are metadata keys ("og_title";
+// "twitter:title" is read as "twitter_title") or one of the Fallback*
+// constants.
+func WithTitleFallbacks(sources ...string) Option {
+	return func(o *options) {
+		o.titleFallbacks = append([]string(nil), sources...)
+	}
+}
+
+// WithExcerptFallbacks sets the sources tried, in order, for the excerpt
+// of a document whose source has none. Entries are as for
+// WithTitleFallbacks.
+func WithExcerptFallbacks(sources ...string) Option {
+	return func(o *options) {
+		o.excerptFallbacks = append([]string(nil), sources...)
+	}
+}
+
+// applyFallbacks replaces doc's title and excerpt from the configured
+// chains when the search result supplied none of its own.
+func applyFallbacks(doc *model.Document, sr *SearchResult, o options) {
+	if len(o.titleFallbacks) > 0 && sourceTitle(sr) == "" {
+		if v := resolveFallback(doc, o.titleFallbacks); v != "" {
+			doc.Title = collapseWhitespace(v)
+		}
+	}
+	if len(o.excerptFallbacks) > 0 && sourceExcerpt(sr) == "" {
+		if v := resolveFallback(doc, o.excerptFallbacks); v != "" {
+			doc.Excerpt = Excerpt(v, excerptLength)
+		}
+	}
+}
+
+// sourceTitle returns the title supplied by any part of sr.
+func sourceTitle(sr *SearchResult) string {
+	switch {
+	case sr.PrimaryDocument != nil && safeTrim(sr.PrimaryDocument.Title) != "":
+		return safeTrim(sr.PrimaryDocument.Title)
+	case sr.Article != nil && safeTrim(sr.Article.Title) != "":
+		return safeTrim(sr.Article.Title)
+	case sr.Feed != nil && safeTrim(sr.Feed.Title) != "":
+		return safeTrim(sr.Feed.Title)
+	}
+	return ""
+}
+
+// sourceExcerpt returns the excerpt supplied by any part of sr.
+func sourceExcerpt(sr *SearchResult) string {
+	switch {
+	case sr.PrimaryDocument != nil && safeTrim(sr.PrimaryDocument.Excerpt) != "":
+		return safeTrim(sr.PrimaryDocument.Excerpt)
+	case sr.Feed != nil && safeTrim(sr.Feed.Description) != "":
+		return safeTrim(sr.Feed.Description)
+	}
+	return ""
+}
+
+// resolveFallback returns the first non-empty value of chain for doc.
+func resolveFallback(doc *model.Document, chain []string) string {
+	for _, src := range chain {
+		var v string
+		switch src {
+		case FallbackFirstHeading:
+			for i := range doc.Sections {
+				if v = safeTrim(doc.Sections[i].Heading); v != "" {
+					break
+				}
+			}
+		case FallbackURLSegment:
+			v = urlSegmentTitle(doc.SourceURL)
+		case FallbackFirstParagraph:
+			if v = firstParagraph(doc.Content, 1); v == "" {
+				for i := range doc.Sections {
+					if v = firstParagraph(doc.Sections[i].Text, 1); v != "" {
+						break
+					}
+				}
+			}
+		default:
+			v = safeTrim(doc.Metadata[src])
+			if v == "" {
+				v = safeTrim(doc.Metadata[strings.ReplaceAll(src, ":", "_")])
+			}
+		}
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// urlSegmentTitle turns the last path segment of rawURL into words:
+// "https://x.org/blog/solar-power_2024.html" yields "solar power 2024".
+func urlSegmentTitle(rawURL string) string {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return ""
+	}
+	seg := path.Base(strings.TrimRight(u.Path, "/"))
+	if seg == "." || seg == "/" {
+		return ""
+	}
+	seg = strings.TrimSuffix(seg, path.Ext(seg))
+	seg = strings.NewReplacer("-", " ", "_", " ").Replace(seg)
+	return collapseWhitespace(seg)
+}
@@ -0,0 +1,80 @@
+// internal/normalize/federated.go
+//
+// Federated merge: combine the answers of several sources to one query.
+//
+// Unlike Pipeline, which merges the layers of a single SearchResult
+// (primary document, article, feed, entities), Federated takes one
+// primary document per source and turns each into a body section tagged
+// with its provenance, then ranks the sections by relevance to the query
+// (see rank.go).
+
+package normalize
+
+import (
+	"strings"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+// Federated merges the documents returned by several sources for query
+// into one Document with one section per source, most relevant first.
+// The document kind, source URL and excerpt follow the top-ranked
+// section; Metadata["sources"] lists the sources in ranked order.
+func Federated(query string, docs []*SearchDocument) *model.Document {
+	out := emptyDocument()
+	out.Title = strings.TrimSpace(query)
+
+	var sections []model.Section
+	byProvenance := map[string]*model.Document{}
+	for _, d := range docs {
+		if d == nil {
+			continue
+		}
+		norm := normalizeSearchDocument(&SearchResult{PrimaryDocument: d})
+		source := primarySource(d)
+		if s := safeTrim(d.Metadata["source"]); source == "primary" && s != "" {
+			source = s
+		}
+
+		text := norm.Content
+		if text == "" {
+			text = norm.Excerpt
+		}
+		meta := map[string]string{provenanceKey: source}
+		if norm.SourceURL != "" {
+			meta["url"] = norm.SourceURL
+		}
+		sections = append(sections, model.Section{
+			Role:    model.SectionRoleBody,
+			Heading: norm.Title,
+			Text:    text,
+			Meta:    meta,
+		})
+		if _, ok := byProvenance[source]; !ok {
+			byProvenance[source] = norm
+		}
+	}
+	if len(sections) == 0 {
+		return emptyResultDocument("no source returned a document")
+	}
+
+	out.Sections = rankSections(sections, query)
+
+	var sources []string
+	seen := map[string]struct{}{}
+	for _, s := range out.Sections {
+		src := s.Meta[provenanceKey]
+		if _, dup := seen[src]; dup {
+			continue
+		}
+		seen[src] = struct{}{}
+		sources = append(sources, src)
+	}
+	out.Metadata[sourcesKey] = strings.Join(sources, ",")
+
+	top := byProvenance[out.Sections[0].Meta[provenanceKey]]
+	out.Kind = top.Kind
+	out.SourceURL = top.SourceURL
+	out.Excerpt = top.Excerpt
+	return out
+}
@@ -0,0 +1,67 @@
+// internal/normalize/links.go
+//
+// Document-level outbound link list.
+//
+// Pipeline gathers every link a result points to into Document.Links so
+// that link-graph consumers find them in one place: the source URL, the
+// anchors of the extracted article's HTML (found with the crawler's link
+// extractor), the feed and feed item links, and entity URLs. Relative
+// links are resolved against the document's SourceURL; the list is
+// de-duplicated and keeps first-seen order.
+
+package normalize
+
+import (
+	"net/url"
+
+	"github.com/Nibir1/Aether/internal/crawl"
+)
+
+// collectLinks returns the absolute http(s) links referenced by sr,
+// resolved against sourceURL, or nil when there are none.
+func collectLinks(sr *SearchResult, sourceURL string) []string {
+	if sr == nil {
+		return nil
+	}
+	base, err := url.Parse(safeTrim(sourceURL))
+	if err != nil {
+		base = &url.URL{}
+	}
+
+	var links []string
+	seen := map[string]struct{}{}
+	add := func(href string) {
+		abs := crawl.ResolveLink(base, href)
+		if abs == "" {
+			return
+		}
+		if _, ok := seen[abs]; ok {
+			return
+		}
+		seen[abs] = struct{}{}
+		links = append(links, abs)
+	}
+
+	add(sourceURL)
+
+	if sr.Article != nil && safeTrim(sr.Article.HTML) != "" {
+		for _, l := range crawl.ExtractLinks(base.String(), sr.Article.HTML) {
+			add(l)
+		}
+	}
+
+	if sr.Feed != nil {
+		add(sr.Feed.Link)
+		for _, item := range sr.Feed.Items {
+			add(item.Link)
+		}
+	}
+
+	for _, e := range sr.Entities {
+		if e != nil {
+			add(e.URL)
+		}
+	}
+
+	return links
+}
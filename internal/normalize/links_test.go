@@ -0,0 +1,36 @@
+// internal/normalize/links_test.go
+package normalize
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPipeline_CollectsLinks(t *testing.T) {
+	sr := &SearchResult{
+		PrimaryDocument: &SearchDocument{URL: "https://example.com/blog/post", Title: "Post"},
+		Article: &Article{
+			Title:   "Post",
+			Content: "Body text.",
+			HTML: `<p>See <a href="../about">about</a>, <a href="/docs#intro">docs</a>,` +
+				` <a href="https://other.org/x">x</a> and <a href="mailto:a@b.c">mail</a>.</p>`,
+		},
+		Feed: &Feed{Items: []FeedItem{
+			{Title: "One", Link: "/blog/one"},
+			{Title: "Two", Link: "https://example.com/docs"},
+		}},
+	}
+
+	doc := Pipeline(sr)
+
+	want := []string{
+		"https://example.com/blog/post",
+		"https://example.com/about",
+		"https://example.com/docs",
+		"https://other.org/x",
+		"https://example.com/blog/one",
+	}
+	if got := strings.Join(doc.Links, "\n"); got != strings.Join(want, "\n") {
+		t.Fatalf("Links:\ngot  %q\nwant %q", doc.Links, want)
+	}
+}
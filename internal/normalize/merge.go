@@ -16,13 +16,86 @@
 //   • Metadata is merged; existing keys on the base are not overwritten.
 //   • Sections from all documents are appended in order.
 //   • SourceURL is taken from the first document that provides it.
+//
+// Provenance:
+//
+//   • mergeWithProvenance() tags every section with Meta["provenance"]
+//     naming the layer that produced it ("primary", "article", "feed",
+//     "entity", or a source plugin name), and records all contributing
+//     layers in document-level Metadata["sources"] (comma-separated).
 
 package normalize
 
 import (
+	"strings"
+
 	"github.com/Nibir1/Aether/internal/model"
 )
 
+// Provenance metadata keys.
+const (
+	provenanceKey = "provenance"
+	sourcesKey    = "sources"
+)
+
+// sourcedDocument pairs a partial Document with the name of the
+// normalization layer (or plugin) that produced it.
+type sourcedDocument struct {
+	Source string
+	Doc    *model.Document
+}
+
+// mergeWithProvenance tags each partial's sections with their source,
+// merges the partials via mergeDocuments, and records the contributing
+// sources on the merged Document.
+func mergeWithProvenance(parts ...sourcedDocument) *model.Document {
+	docs := make([]*model.Document, 0, len(parts))
+	sources := make([]string, 0, len(parts))
+	seen := map[string]struct{}{}
+
+	for _, p := range parts {
+		if p.Doc == nil {
+			continue
+		}
+		tagProvenance(p.Doc, p.Source)
+		docs = append(docs, p.Doc)
+
+		if p.Source == "" {
+			continue
+		}
+		if _, dup := seen[p.Source]; !dup {
+			seen[p.Source] = struct{}{}
+			sources = append(sources, p.Source)
+		}
+	}
+
+	doc := mergeDocuments(docs...)
+	if len(sources) > 0 {
+		if doc.Metadata == nil {
+			doc.Metadata = map[string]string{}
+		}
+		doc.Metadata[sourcesKey] = strings.Join(sources, ",")
+	}
+	return doc
+}
+
+// tagProvenance sets Meta["provenance"] on every section of doc that does
+// not already carry one.
+func tagProvenance(doc *model.Document, source string) {
+	if doc == nil || source == "" {
+		return
+	}
+	for i := range doc.Sections {
+		sec := &doc.Sections[i]
+		if sec.Meta == nil {
+			sec.Meta = map[string]string{}
+		}
+		if _, exists := sec.Meta[provenanceKey]; !exists {
+			sec.Meta[provenanceKey] = source
+		}
+	}
+}
+
 // mergeDocuments merges zero or more partial Documents into a single
 // canonical Document.
 //
@@ -0,0 +1,50 @@
+// internal/normalize/merge_test.go
+package normalize
+
+import (
+	"testing"
+
+	"github.com/Nibir1/Aether/internal/toon"
+)
+
+func TestPipeline_SectionProvenance(t *testing.T) {
+	sr := &SearchResult{
+		PrimaryDocument: &SearchDocument{
+			URL:      "https://example.com/news",
+			Title:    "News",
+			Metadata: map[string]string{"aether.source_plugin": "newsapi"},
+		},
+		Article: &Article{Title: "Story", Content: "Story body."},
+		Feed:    &Feed{Items: []FeedItem{{Title: "Item 1"}, {Title: "Item 2"}}},
+	}
+
+	doc := Pipeline(sr)
+
+	want := []string{"article", "feed", "feed"}
+	if len(doc.Sections) != len(want) {
+		t.Fatalf("got %d sections, want %d", len(doc.Sections), len(want))
+	}
+	for i, w := range want {
+		if got := doc.Sections[i].Meta["provenance"]; got != w {
+			t.Fatalf("section %d provenance: got %q, want %q", i, got, w)
+		}
+	}
+	if got := doc.Metadata["sources"]; got != "newsapi,article,feed" {
+		t.Fatalf("sources: got %q, want %q", got, "newsapi,article,feed")
+	}
+
+	// Provenance must survive TOON conversion as section META tokens.
+	tdoc := toon.FromModel(doc)
+	if got := tdoc.Attributes["sources"]; got != "newsapi,article,feed" {
+		t.Fatalf("TOON sources: got %q", got)
+	}
+	seen := 0
+	for _, tok := range tdoc.Tokens {
+		if tok.Type == toon.TokenMeta && tok.Attrs["provenance"] != "" {
+			seen++
+		}
+	}
+	if seen != len(want) {
+		t.Fatalf("TOON provenance tokens: got %d, want %d", seen, len(want))
+	}
+}
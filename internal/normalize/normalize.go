@@ -55,17 +55,24 @@ type Article struct {
 	Title   string
 	Content string
 	Meta    map[string]string
+
+	// HTML is the extracted article markup, used to collect its links.
+	HTML string
 }
 
 // Feed is the normalized RSS/Atom representation.
 type Feed struct {
-	Items []FeedItem
+	Title       string
+	Description string
+	Link        string
+	Items       []FeedItem
 }
 
 type FeedItem struct {
 	Title       string
 	Link        string
 	Author      string
+	Authors     []FeedAuthor
 	GUID        string
 	Description string
 	Content     string
@@ -73,6 +80,13 @@ type FeedItem struct {
 	Updated     int64
 }
 
+// FeedAuthor is one credited author of a feed item.
+type FeedAuthor struct {
+	Name  string
+	Email string
+	URI   string
+}
+
 // Entity represents a structured API response (Wikidata, etc.).
 type Entity struct {
 	ID       string
@@ -87,33 +101,64 @@ type Entity struct {
 // sr may include SearchDocument, Article extraction, Feed data, and
 // structured Entities. Each schema_* normalizer can emit a partial
 // model.Document. mergeDocuments() combines them into one canonical
-// Document.
-func Pipeline(sr *SearchResult) *model.Document {
+// Document. Options (see options.go) are applied after merging.
+func Pipeline(sr *SearchResult, opts ...Option) *model.Document {
 	if sr == nil {
-		return emptyDocument()
+		return emptyResultDocument("nil search result")
 	}
 
-	partials := []*model.Document{}
+	o := buildOptions(opts)
+
+	partials := []sourcedDocument{}
 
 	// Future hook:
 	sr = preNormalize(sr)
 
-	// Core normalizers:
+	// Core normalizers, each tagged with its provenance:
 	if sr.PrimaryDocument != nil {
-		partials = append(partials, normalizeSearchDocument(sr))
+		partials = append(partials, sourcedDocument{primarySource(sr.PrimaryDocument), normalizeSearchDocument(sr)})
 	}
 	if sr.Article != nil {
-		partials = append(partials, normalizeArticle(sr))
+		partials = append(partials, sourcedDocument{"article", normalizeArticle(sr)})
 	}
 	if sr.Feed != nil {
-		partials = append(partials, normalizeFeed(sr))
+		partials = append(partials, sourcedDocument{"feed", normalizeFeed(sr)})
 	}
 	if len(sr.Entities) > 0 {
-		partials = append(partials, normalizeEntities(sr))
+		partials = append(partials, sourcedDocument{"entity", normalizeEntities(sr)})
+	}
+
+	if len(partials) == 0 {
+		doc := emptyResultDocument("search result has no primary document, article, feed or entities")
+		if sr.Plan.Intent != "" {
+			doc.Metadata["aether.intent"] = sr.Plan.Intent
+		}
+		return doc
 	}
 
 	// Merge into a single canonical model.Document.
-	doc := mergeDocuments(partials...)
+	doc := mergeWithProvenance(partials...)
+
+	// Optional section reordering by role.
+	doc.Sections = orderSections(doc.Sections, o.sectionOrder)
+
+	// Optional paragraph-based excerpt.
+	applyExcerptStrategy(doc, sr, o.excerpt)
+
+	// Outbound links from every part of the result.
+	doc.Links = collectLinks(sr, doc.SourceURL)
+
+	// Optional whitespace normalization of content and section text.
+	applyWhitespace(doc, o.whitespaceFor(doc.Kind))
+
+	// Fold source-specific metadata keys into the canonical key set.
+	doc.Metadata = canonicalizeMetadata(doc.Metadata)
+
+	// Strip control characters and cap oversized metadata values.
+	sanitizeMetadata(doc, o.metaLimit())
+
+	// Optional title/excerpt fallback chains over the cleaned metadata.
+	applyFallbacks(doc, sr, o)
 
 	// Add search plan intent, if any.
 	if sr.Plan.Intent != "" {
@@ -123,6 +168,11 @@ func Pipeline(sr *SearchResult) *model.Document {
 		doc.Metadata["aether.intent"] = sr.Plan.Intent
 	}
 
+	// Optional JSON-native mirror of numeric/boolean metadata.
+	if o.typed {
+		doc.TypedMetadata = typedMetadata(doc.Metadata, o.typedKeys)
+	}
+
 	// Future hook:
 	doc = postNormalize(doc)
 
@@ -153,6 +203,26 @@ func postNormalize(doc *model.Document) *model.Document {
 // ────────────────────────────────────────────────────────────────────────
 //
 
+// primarySource names the provenance of the primary document: the
+// source plugin that produced it, if any, otherwise "primary".
+func primarySource(d *SearchDocument) string {
+	if d != nil {
+		if name := safeTrim(d.Metadata["aether.source_plugin"]); name != "" {
+			return name
+		}
+	}
+	return "primary"
+}
+
+// emptyResultDocument returns an empty document marked as "nothing
+// found", with reason recorded as its diagnostic.
+func emptyResultDocument(reason string) *model.Document {
+	doc := emptyDocument()
+	doc.Metadata[model.MetaEmpty] = "true"
+	doc.Metadata[model.MetaDiagnostic] = reason
+	return doc
+}
+
 // emptyDocument returns a minimal well-formed Document.
 func emptyDocument() *model.Document {
 	return &model.Document{
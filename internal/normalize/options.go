@@ -0,0 +1,189 @@
+// internal/normalize/options.go
+//
+// Functional options for the normalization pipeline.
+//
+// Options tune how Pipeline assembles the final model.Document without
+// changing the schema-specific normalizers. They are applied after all
+// partial documents have been merged.
+
+package normalize
+
+import (
+	"sort"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+// Option configures a single Pipeline run.
+type Option func(*options)
+
+// WhitespaceMode controls how Content and section Text whitespace is
+// normalized after merging.
+type WhitespaceMode string
+
+const (
+	// WhitespacePreserve leaves text exactly as produced by the schema
+	// normalizers (the default).
+	WhitespacePreserve WhitespaceMode = "preserve"
+
+	// WhitespaceCollapse reduces every whitespace run, including
+	// newlines, to a single space.
+	WhitespaceCollapse WhitespaceMode = "collapse"
+
+	// WhitespaceParagraphs keeps paragraph breaks (blank lines) and the
+	// indentation of code blocks, while collapsing runs of spaces and
+	// joining soft-wrapped lines within a paragraph.
+	WhitespaceParagraphs WhitespaceMode = "paragraphs"
+)
+
+// options holds the resolved pipeline configuration.
+type options struct {
+	// sectionOrder lists roles in the order their sections should
+	// appear. Roles not listed keep their original relative order and
+	// are appended after all listed roles.
+	sectionOrder []model.SectionRole
+
+	// whitespace is the default whitespace mode; kindWhitespace
+	// overrides it for specific document kinds.
+	whitespace     WhitespaceMode
+	kindWhitespace map[model.DocumentKind]WhitespaceMode
+
+	// maxMetaLen caps metadata values, in runes. Zero means
+	// DefaultMaxMetadataValueLength; negative means no cap.
+	maxMetaLen int
+
+	// typed enables TypedMetadata for typedKeys (defaults when empty).
+	typed     bool
+	typedKeys []string
+
+	// excerpt selects how article and HTML excerpts are derived.
+	excerpt ExcerptStrategy
+
+	// titleFallbacks and excerptFallbacks are the chains tried when the
+	// source supplies no title or excerpt (see fallback.go).
+	titleFallbacks   []string
+	excerptFallbacks []string
+}
+
+// DefaultMaxMetadataValueLength is the metadata value cap, in runes,
+// used when WithMaxMetadataValueLength is not given.
+const DefaultMaxMetadataValueLength = 1024
+
+// WithSectionOrder reorders the merged document's sections by role.
+//
+// Sections whose role appears in roles are emitted first, grouped in the
+// given role order; sections with unlisted roles follow in their original
+// order. Ordering within each role group is always stable.
+func WithSectionOrder(roles ...model.SectionRole) Option {
+	return func(o *options) {
+		o.sectionOrder = append([]model.SectionRole(nil), roles...)
+	}
+}
+
+// WithWhitespaceMode sets the whitespace mode for all document kinds
+// that have no kind-specific mode.
+func WithWhitespaceMode(mode WhitespaceMode) Option {
+	return func(o *options) {
+		o.whitespace = mode
+	}
+}
+
+// WithKindWhitespaceMode sets the whitespace mode for documents of the
+// given kind, overriding WithWhitespaceMode.
+func WithKindWhitespaceMode(kind model.DocumentKind, mode WhitespaceMode) Option {
+	return func(o *options) {
+		if o.kindWhitespace == nil {
+			o.kindWhitespace = map[model.DocumentKind]WhitespaceMode{}
+		}
+		o.kindWhitespace[kind] = mode
+	}
+}
+
+// WithMaxMetadataValueLength caps every metadata value (document and
+// section level) at n runes, ending truncated values with "…". A
+// negative n disables the cap; control characters are stripped and
+// whitespace collapsed regardless.
+func WithMaxMetadataValueLength(n int) Option {
+	return func(o *options) {
+		o.maxMetaLen = n
+	}
+}
+
+// metaLimit resolves the metadata value cap; <= 0 means unlimited.
+func (o options) metaLimit() int {
+	if o.maxMetaLen == 0 {
+		return DefaultMaxMetadataValueLength
+	}
+	return max(o.maxMetaLen, 0)
+}
+
+// whitespaceFor resolves the whitespace mode for a document kind.
+func (o options) whitespaceFor(kind model.DocumentKind) WhitespaceMode {
+	if m, ok := o.kindWhitespace[kind]; ok {
+		return m
+	}
+	if o.whitespace == "" {
+		return WhitespacePreserve
+	}
+	return o.whitespace
+}
+
+// applyWhitespace normalizes doc.Content and every section's Text
+// according to mode. Code and table sections are left untouched.
+func applyWhitespace(doc *model.Document, mode WhitespaceMode) {
+	var fn func(string) string
+	switch mode {
+	case WhitespaceCollapse:
+		fn = collapseWhitespace
+	case WhitespaceParagraphs:
+		fn = preserveParagraphs
+	default:
+		return
+	}
+
+	doc.Content = fn(doc.Content)
+	for i := range doc.Sections {
+		if r := doc.Sections[i].Role; r == model.SectionRoleCode || r == model.SectionRoleTable {
+			continue
+		}
+		doc.Sections[i].Text = fn(doc.Sections[i].Text)
+	}
+}
+
+// buildOptions applies opts over the zero configuration.
+func buildOptions(opts []Option) options {
+	var o options
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+	return o
+}
+
+// orderSections stably sorts sections according to order.
+func orderSections(sections []model.Section, order []model.SectionRole) []model.Section {
+	if len(order) == 0 || len(sections) < 2 {
+		return sections
+	}
+
+	rank := make(map[model.SectionRole]int, len(order))
+	for i, r := range order {
+		if _, dup := rank[r]; !dup {
+			rank[r] = i
+		}
+	}
+	unlisted := len(order)
+
+	rankOf := func(r model.SectionRole) int {
+		if v, ok := rank[r]; ok {
+			return v
+		}
+		return unlisted
+	}
+
+	sort.SliceStable(sections, func(i, j int) bool {
+		return rankOf(sections[i].Role) < rankOf(sections[j].Role)
+	})
+	return sections
+}
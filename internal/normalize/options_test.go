@@ -0,0 +1,113 @@
+// internal/normalize/options_test.go
+package normalize
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+func TestPipeline_WithSectionOrder(t *testing.T) {
+	sr := &SearchResult{
+		PrimaryDocument: &SearchDocument{URL: "https://example.com", Title: "Mixed"},
+		Article:         &Article{Title: "Body", Content: "Article body."},
+		Feed: &Feed{Items: []FeedItem{
+			{Title: "Item 1"},
+			{Title: "Item 2"},
+		}},
+		Entities: []*Entity{{ID: "Q1", Label: "Entity"}},
+	}
+
+	// Default order: article, feed, entities.
+	def := Pipeline(sr)
+	if got := roles(def.Sections); got != "body,feed_item,feed_item,entity" {
+		t.Fatalf("default order: got %q", got)
+	}
+
+	doc := Pipeline(sr, WithSectionOrder(model.SectionRoleFeedItem))
+	if got := roles(doc.Sections); got != "feed_item,feed_item,body,entity" {
+		t.Fatalf("reordered: got %q", got)
+	}
+	if doc.Sections[0].Heading != "Item 1" || doc.Sections[1].Heading != "Item 2" {
+		t.Fatalf("feed items not stable: got %q, %q", doc.Sections[0].Heading, doc.Sections[1].Heading)
+	}
+
+	doc = Pipeline(sr, WithSectionOrder(model.SectionRoleEntity, model.SectionRoleBody))
+	if got := roles(doc.Sections); got != "entity,body,feed_item,feed_item" {
+		t.Fatalf("reordered: got %q", got)
+	}
+}
+
+func roles(sections []model.Section) string {
+	out := ""
+	for i, s := range sections {
+		if i > 0 {
+			out += ","
+		}
+		out += string(s.Role)
+	}
+	return out
+}
+
+func TestPipeline_WithExcerptStrategy(t *testing.T) {
+	body := "By Jane Doe · 3 min read\n\n" +
+		"Solar panels on rooftops produced more electricity than coal plants across the region " +
+		"for the first time this spring, according to the grid operator.\n\n" +
+		"Second paragraph."
+	sr := &SearchResult{Article: &Article{Title: "Solar", Content: body}}
+
+	prefix := Pipeline(sr)
+	if !strings.HasPrefix(prefix.Excerpt, "By Jane Doe") {
+		t.Fatalf("prefix excerpt: got %q", prefix.Excerpt)
+	}
+
+	para := Pipeline(sr, WithExcerptStrategy(ExcerptFirstParagraph))
+	if !strings.HasPrefix(para.Excerpt, "Solar panels on rooftops") || strings.Contains(para.Excerpt, "Jane") {
+		t.Fatalf("first-paragraph excerpt: got %q", para.Excerpt)
+	}
+	if strings.Contains(para.Excerpt, "Second paragraph") {
+		t.Fatalf("first-paragraph excerpt ran into the next paragraph: %q", para.Excerpt)
+	}
+}
+
+func TestPipeline_WithTitleFallbacks(t *testing.T) {
+	sr := &SearchResult{PrimaryDocument: &SearchDocument{
+		URL:     "https://example.com/news/solar-record.html",
+		Content: "Rooftop panels beat coal.",
+		Metadata: map[string]string{
+			"og_title":       "Solar Beats Coal",
+			"og_description": "A record spring for rooftop solar.",
+		},
+	}}
+
+	// Without a chain the built-in fallback (the excerpt) is used.
+	if got := Pipeline(sr).Title; got != "Rooftop panels beat coal." {
+		t.Fatalf("default title: got %q", got)
+	}
+
+	doc := Pipeline(sr,
+		WithTitleFallbacks("twitter:title", "og_title", FallbackURLSegment),
+		WithExcerptFallbacks("og_description", FallbackFirstParagraph),
+	)
+	if got, want := doc.Title, "Solar Beats Coal"; got != want {
+		t.Fatalf("title: got %q, want %q", got, want)
+	}
+	if got, want := doc.Excerpt, "A record spring for rooftop solar."; got != want {
+		t.Fatalf("excerpt: got %q, want %q", got, want)
+	}
+
+	// The chain continues past empty sources.
+	doc = Pipeline(sr, WithTitleFallbacks("twitter_title", FallbackFirstHeading, FallbackURLSegment, "og_title"))
+	if got, want := doc.Title, "solar record"; got != want {
+		t.Fatalf("url segment title: got %q, want %q", got, want)
+	}
+
+	// A title supplied by the source is never replaced.
+	titled := *sr.PrimaryDocument
+	titled.Title = "Source Title"
+	doc = Pipeline(&SearchResult{PrimaryDocument: &titled}, WithTitleFallbacks("og_title"))
+	if got, want := doc.Title, "Source Title"; got != want {
+		t.Fatalf("source title: got %q, want %q", got, want)
+	}
+}
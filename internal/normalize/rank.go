@@ -0,0 +1,123 @@
+// internal/normalize/rank.go
+//
+// Query relevance ranking for merged documents.
+//
+// When several sources answer the same query, their sections are ordered
+// by a small TF-IDF score so the most query-relevant section comes first.
+// Each section is treated as one "document" of the corpus:
+//
+//   • tf  = occurrences of a query term / tokens in the section
+//   • idf = ln(1 + N/df), smoothed so terms present everywhere still count
+//   • heading tokens are weighted double, since a matching heading is a
+//     stronger signal than a passing mention in the body
+//
+// The score is recorded in Meta["relevance"] and the sort is stable, so
+// sections with equal scores keep their original order.
+
+package normalize
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+// relevanceKey is the section metadata key holding the ranking score.
+const relevanceKey = "relevance"
+
+// headingWeight multiplies the term counts of section headings.
+const headingWeight = 2
+
+// rankSections scores sections against query, stores each score in
+// Meta["relevance"] and returns the sections sorted by descending score.
+// An empty query leaves the order unchanged.
+func rankSections(sections []model.Section, query string) []model.Section {
+	terms := uniqueTerms(tokenize(query))
+	if len(terms) == 0 || len(sections) == 0 {
+		return sections
+	}
+
+	// Per-section weighted term counts.
+	counts := make([]map[string]int, len(sections))
+	lengths := make([]int, len(sections))
+	df := map[string]int{}
+	for i, s := range sections {
+		c := map[string]int{}
+		for _, tok := range tokenize(s.Heading) {
+			c[tok] += headingWeight
+			lengths[i] += headingWeight
+		}
+		for _, tok := range tokenize(s.Text) {
+			c[tok]++
+			lengths[i]++
+		}
+		counts[i] = c
+		for _, t := range terms {
+			if c[t] > 0 {
+				df[t]++
+			}
+		}
+	}
+
+	n := float64(len(sections))
+	scores := make([]float64, len(sections))
+	for i := range sections {
+		if lengths[i] == 0 {
+			continue
+		}
+		for _, t := range terms {
+			if df[t] == 0 {
+				continue
+			}
+			tf := float64(counts[i][t]) / float64(lengths[i])
+			idf := math.Log(1 + n/float64(df[t]))
+			scores[i] += tf * idf
+		}
+	}
+
+	idx := make([]int, len(sections))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool {
+		return scores[idx[a]] > scores[idx[b]]
+	})
+
+	out := make([]model.Section, len(sections))
+	for pos, i := range idx {
+		s := sections[i]
+		meta := make(map[string]string, len(s.Meta)+1)
+		for k, v := range s.Meta {
+			meta[k] = v
+		}
+		meta[relevanceKey] = strconv.FormatFloat(scores[i], 'f', 4, 64)
+		s.Meta = meta
+		out[pos] = s
+	}
+	return out
+}
+
+// tokenize lowercases s and splits it into letter/digit runs.
+func tokenize(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// uniqueTerms drops repeated query terms, keeping first occurrences.
+func uniqueTerms(tokens []string) []string {
+	seen := map[string]struct{}{}
+	out := tokens[:0]
+	for _, t := range tokens {
+		if _, dup := seen[t]; dup {
+			continue
+		}
+		seen[t] = struct{}{}
+		out = append(out, t)
+	}
+	return out
+}
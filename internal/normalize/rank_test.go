@@ -0,0 +1,35 @@
+// internal/normalize/rank_test.go
+package normalize
+
+import (
+	"testing"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+func TestRankSections_HeadingOutweighsBody(t *testing.T) {
+	in := []model.Section{
+		{Heading: "Cooking", Text: "rust on a pan is bad"},
+		{Heading: "Rust ownership", Text: "borrowing and lifetimes"},
+		{Heading: "Gardening", Text: "water daily"},
+	}
+	out := rankSections(in, "Rust")
+
+	if out[0].Heading != "Rust ownership" || out[1].Heading != "Cooking" {
+		t.Fatalf("order: got %q, %q", out[0].Heading, out[1].Heading)
+	}
+	if got := out[2].Meta["relevance"]; got != "0.0000" {
+		t.Fatalf("non-matching relevance: got %q, want %q", got, "0.0000")
+	}
+	if in[0].Meta != nil {
+		t.Fatalf("input sections were mutated")
+	}
+}
+
+func TestRankSections_EmptyQueryKeepsOrder(t *testing.T) {
+	in := []model.Section{{Text: "b"}, {Text: "a"}}
+	out := rankSections(in, "  ")
+	if out[0].Text != "b" || out[0].Meta != nil {
+		t.Fatalf("empty query changed sections: %+v", out)
+	}
+}
@@ -10,10 +10,15 @@
 //   Meta (map[string]string)
 //
 // Normalization rules:
-//   • Produces exactly one main body section.
+//   • Produces one main body section, or, when the content embeds code
+//     blocks or display math, alternating body and verbatim code
+//     sections (see verbatim.go). Tables in the content become
+//     SectionRoleTable sections (see tables.go).
 //   • The SearchResult.PrimaryDocument establishes the root title,
 //     but Article content supersedes it as richer content.
 //   • Article.Meta is preserved as section-level metadata.
+//   • OpenGraph / Twitter Card keys (og_*, twitter_*) and lead_image are
+//     additionally promoted to document-level metadata for link previews.
 
 package normalize
 
@@ -50,20 +55,28 @@ func normalizeArticle(sr *SearchResult) *model.Document {
 		}
 	}
 
-	section := model.Section{
+	sections := []model.Section{{
 		Role:    model.SectionRoleBody,
 		Heading: title,
 		Text:    content,
 		Meta:    copyMetadata(art.Meta),
+	}}
+
+	// Code blocks and display math become verbatim code sections so
+	// that whitespace handling and rendering cannot reflow them.
+	if segs := splitVerbatim(art.Content); hasVerbatim(segs) {
+		sections = verbatimSections(title, segs, art.Meta)
 	}
+	// Pipe tables written by the extractor become table sections.
+	sections = splitTableSections(sections)
 
 	doc := &model.Document{
 		Kind:     model.DocumentKindArticle,
 		Title:    title,
-		Excerpt:  deriveExcerpt(content),
+		Excerpt:  deriveExcerpt(stripTables(content)),
 		Content:  content,
-		Metadata: map[string]string{}, // no root metadata; section metadata only
-		Sections: []model.Section{section},
+		Metadata: promoteSocialMeta(art.Meta),
+		Sections: sections,
 	}
 
 	return doc
@@ -86,3 +99,19 @@ func copyMetadata(m map[string]string) map[string]string {
 	}
 	return out
 }
+
+// promoteSocialMeta returns the og_*, twitter_* and lead_image entries of
+// m, which are surfaced at document level. All other article metadata
+// stays on the body section.
+func promoteSocialMeta(m map[string]string) map[string]string {
+	out := map[string]string{}
+	for k, v := range m {
+		k = strings.TrimSpace(k)
+		if k == "lead_image" || strings.HasPrefix(k, "og_") || strings.HasPrefix(k, "twitter_") {
+			if v = strings.TrimSpace(v); v != "" {
+				out[k] = v
+			}
+		}
+	}
+	return out
+}
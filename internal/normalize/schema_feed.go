@@ -32,42 +32,8 @@ func normalizeFeed(sr *SearchResult) *model.Document {
 	}
 
 	sections := make([]model.Section, 0, len(f.Items))
-
 	for _, item := range f.Items {
-		heading := strings.TrimSpace(item.Title)
-		body := chooseBody(item)
-
-		// Fallbacks
-		if heading == "" {
-			heading = "(feed item)"
-		}
-		if body == "" {
-			body = heading
-		}
-
-		meta := map[string]string{}
-		if item.Link != "" {
-			meta["link"] = strings.TrimSpace(item.Link)
-		}
-		if item.Author != "" {
-			meta["author"] = strings.TrimSpace(item.Author)
-		}
-		if item.GUID != "" {
-			meta["guid"] = strings.TrimSpace(item.GUID)
-		}
-		if item.Published != 0 {
-			meta["published_unix"] = strconv.FormatInt(item.Published, 10)
-		}
-		if item.Updated != 0 {
-			meta["updated_unix"] = strconv.FormatInt(item.Updated, 10)
-		}
-
-		sections = append(sections, model.Section{
-			Role:    model.SectionRoleFeedItem,
-			Heading: heading,
-			Text:    body,
-			Meta:    meta,
-		})
+		sections = append(sections, feedItemSection(item))
 	}
 
 	// Wrap feed sections in a standalone Document.
@@ -83,12 +49,142 @@ func normalizeFeed(sr *SearchResult) *model.Document {
 	return doc
 }
 
+// NormalizeFeed converts a standalone Feed into a model.Document without
+// requiring a surrounding SearchResult. Each item becomes a feed_item
+// section, exactly as in the search pipeline.
+func NormalizeFeed(f *Feed) *model.Document {
+	if f == nil {
+		return emptyDocument()
+	}
+
+	doc := normalizeFeed(&SearchResult{Feed: f})
+	if doc == nil {
+		doc = &model.Document{
+			Kind:     model.DocumentKindFeed,
+			Metadata: map[string]string{},
+		}
+	}
+
+	doc.SourceURL = strings.TrimSpace(f.Link)
+	if t := strings.TrimSpace(f.Title); t != "" {
+		doc.Title = t
+	} else if doc.Title == "" {
+		doc.Title = "(feed)"
+	}
+	doc.Excerpt = excerptFromContent(f.Description, 0)
+
+	if doc.SourceURL != "" {
+		doc.Metadata["link"] = doc.SourceURL
+	}
+	doc.Metadata["item_count"] = strconv.Itoa(len(f.Items))
+
+	return doc
+}
+
+// NormalizeFeedItems converts every feed item into its own model.Document.
+//
+// Item documents carry the same metadata as feed_item sections (link,
+// author, guid, published_unix, updated_unix) plus feed_title when the
+// parent feed has one.
+func NormalizeFeedItems(f *Feed) []*model.Document {
+	if f == nil || len(f.Items) == 0 {
+		return nil
+	}
+
+	feedTitle := strings.TrimSpace(f.Title)
+	out := make([]*model.Document, 0, len(f.Items))
+
+	for _, item := range f.Items {
+		sec := feedItemSection(item)
+
+		meta := safeMetadataCopy(sec.Meta)
+		if feedTitle != "" {
+			meta["feed_title"] = feedTitle
+		}
+
+		out = append(out, &model.Document{
+			SourceURL: meta["link"],
+			Kind:      model.DocumentKindArticle,
+			Title:     sec.Heading,
+			Excerpt:   excerptFromContent(sec.Text, 0),
+			Content:   sec.Text,
+			Metadata:  meta,
+			Sections: []model.Section{
+				newSection(model.SectionRoleBody, "", sec.Text, nil),
+			},
+		})
+	}
+
+	return out
+}
+
 //
 // ────────────────────────────────────────────────────────────────────────
 //                             HELPERS
 // ────────────────────────────────────────────────────────────────────────
 //
 
+// feedItemSection converts a single FeedItem into a feed_item section.
+func feedItemSection(item FeedItem) model.Section {
+	heading := strings.TrimSpace(item.Title)
+	body := chooseBody(item)
+
+	// Fallbacks
+	if heading == "" {
+		heading = "(feed item)"
+	}
+	if body == "" {
+		body = heading
+	}
+
+	meta := map[string]string{}
+	if item.Link != "" {
+		meta["link"] = strings.TrimSpace(item.Link)
+	}
+	if item.Author != "" {
+		meta["author"] = strings.TrimSpace(item.Author)
+	}
+	if names, emails := authorLists(item.Authors); names != "" || emails != "" {
+		if names != "" {
+			meta["authors"] = names
+		}
+		if emails != "" {
+			meta["author_emails"] = emails
+		}
+	}
+	if item.GUID != "" {
+		meta["guid"] = strings.TrimSpace(item.GUID)
+	}
+	if item.Published != 0 {
+		meta["published_unix"] = strconv.FormatInt(item.Published, 10)
+	}
+	if item.Updated != 0 {
+		meta["updated_unix"] = strconv.FormatInt(item.Updated, 10)
+	}
+
+	return model.Section{
+		Role:    model.SectionRoleFeedItem,
+		Heading: heading,
+		Text:    body,
+		Meta:    meta,
+	}
+}
+
+// authorLists joins author names and emails into comma-separated lists
+// for section metadata.
+func authorLists(authors []FeedAuthor) (names, emails string) {
+	var n, e []string
+	for _, a := range authors {
+		if v := strings.TrimSpace(a.Name); v != "" {
+			n = append(n, v)
+		}
+		if v := strings.TrimSpace(a.Email); v != "" {
+			e = append(e, v)
+		}
+	}
+	return strings.Join(n, ", "), strings.Join(e, ", ")
+}
+
 // chooseBody picks the best available content field from a feed item.
 func chooseBody(item FeedItem) string {
 	candidates := []string{
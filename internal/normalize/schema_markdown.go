@@ -0,0 +1,230 @@
+// internal/normalize/schema_markdown.go
+//
+// Parses Markdown text into a model.Document.
+//
+// The parser understands the block structure the display renderer
+// produces, which makes rendered output round-trip:
+//
+//   • the first level-1 ATX heading ("# Title") becomes the title
+//   • every other ATX heading starts a body section carrying the
+//     heading and its level (model.MetaHeadingLevel)
+//   • fenced code blocks (``` or ~~~) become code sections, keeping the
+//     info string's language in Meta["lang"]
+//   • the first prose paragraph also becomes the (whitespace-collapsed)
+//     excerpt; it stays in the body as well, so content before the first
+//     heading — or a document without headings — is never lost
+//
+// Everything else (lists, quotes, inline markup) is kept verbatim as
+// paragraph text. Setext headings are not recognized.
+
+package normalize
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+// NormalizeMarkdown converts Markdown text into an article document.
+func NormalizeMarkdown(md string) *model.Document {
+	p := &markdownParser{}
+	for _, line := range strings.Split(strings.ReplaceAll(md, "\r\n", "\n"), "\n") {
+		p.line(line)
+	}
+	p.closeFence()
+	p.flushSection()
+
+	var content []string
+	for _, s := range p.sections {
+		if s.Role == model.SectionRoleBody && s.Text != "" {
+			content = append(content, s.Text)
+		}
+	}
+
+	return &model.Document{
+		Kind:     model.DocumentKindArticle,
+		Title:    p.title,
+		Excerpt:  p.excerpt,
+		Content:  strings.Join(content, "\n\n"),
+		Metadata: map[string]string{},
+		Sections: p.sections,
+	}
+}
+
+// markdownParser accumulates blocks line by line.
+type markdownParser struct {
+	title    string
+	excerpt  string
+	sections []model.Section
+
+	// current body section
+	heading string
+	level   int
+	paras   []string
+	para    []string
+
+	// open code fence
+	fence     string
+	fenceLang string
+	code      []string
+}
+
+func (p *markdownParser) line(line string) {
+	if p.fence != "" {
+		if isFenceClose(line, p.fence) {
+			p.closeFence()
+			return
+		}
+		p.code = append(p.code, line)
+		return
+	}
+
+	if fence, lang, ok := fenceOpen(line); ok {
+		p.flushSection()
+		p.fence, p.fenceLang, p.code = fence, lang, nil
+		return
+	}
+
+	if level, text, ok := atxHeading(line); ok {
+		p.flushSection()
+		if level == 1 && p.title == "" {
+			p.title = text
+			return
+		}
+		p.heading, p.level = text, level
+		return
+	}
+
+	if strings.TrimSpace(line) == "" {
+		p.flushParagraph()
+		return
+	}
+	p.para = append(p.para, strings.TrimRight(line, " \t"))
+}
+
+// flushParagraph ends the paragraph being read. The first paragraph of
+// the document also becomes the excerpt.
+func (p *markdownParser) flushParagraph() {
+	if len(p.para) == 0 {
+		return
+	}
+	text := strings.Join(p.para, "\n")
+	p.para = nil
+
+	if p.excerpt == "" {
+		p.excerpt = collapseWhitespace(text)
+	}
+	p.paras = append(p.paras, text)
+}
+
+// flushSection ends the current body section, if it has any content.
+func (p *markdownParser) flushSection() {
+	p.flushParagraph()
+	if p.heading == "" && len(p.paras) == 0 {
+		return
+	}
+
+	sec := model.Section{
+		Role:    model.SectionRoleBody,
+		Heading: p.heading,
+		Text:    strings.Join(p.paras, "\n\n"),
+	}
+	if p.heading != "" {
+		sec.Meta = map[string]string{model.MetaHeadingLevel: strconv.Itoa(p.level)}
+	}
+	p.sections = append(p.sections, sec)
+	p.heading, p.level, p.paras = "", 0, nil
+}
+
+// closeFence ends an open code block as a code section.
+func (p *markdownParser) closeFence() {
+	if p.fence == "" {
+		return
+	}
+	meta := map[string]string{model.MetaVerbatim: model.VerbatimCode}
+	if p.fenceLang != "" {
+		meta["lang"] = p.fenceLang
+	}
+	p.sections = append(p.sections, model.Section{
+		Role: model.SectionRoleCode,
+		Text: strings.Join(p.code, "\n"),
+		Meta: meta,
+	})
+	p.fence, p.fenceLang, p.code = "", "", nil
+}
+
+// atxHeading parses "## Heading ##": up to three spaces of indentation,
+// one to six '#', then a space or the end of the line. An optional
+// closing run of '#' is removed.
+func atxHeading(line string) (int, string, bool) {
+	trimmed, ok := trimIndent(line)
+	if !ok {
+		return 0, "", false
+	}
+	level := 0
+	for level < len(trimmed) && trimmed[level] == '#' {
+		level++
+	}
+	if level == 0 || level > 6 {
+		return 0, "", false
+	}
+	rest := trimmed[level:]
+	if rest != "" && rest[0] != ' ' && rest[0] != '\t' {
+		return 0, "", false
+	}
+
+	text := strings.TrimSpace(rest)
+	if closing := strings.TrimRight(text, "#"); closing != text &&
+		(closing == "" || strings.HasSuffix(closing, " ") || strings.HasSuffix(closing, "\t")) {
+		text = strings.TrimSpace(closing)
+	}
+	if text == "" {
+		return 0, "", false
+	}
+	return level, text, true
+}
+
+// fenceOpen parses an opening code fence of at least three backticks or
+// tildes, returning the fence and the first word of its info string.
+func fenceOpen(line string) (string, string, bool) {
+	trimmed, ok := trimIndent(line)
+	if !ok || len(trimmed) < 3 || (trimmed[0] != '`' && trimmed[0] != '~') {
+		return "", "", false
+	}
+	n := 0
+	for n < len(trimmed) && trimmed[n] == trimmed[0] {
+		n++
+	}
+	if n < 3 {
+		return "", "", false
+	}
+	info := strings.TrimSpace(trimmed[n:])
+	if trimmed[0] == '`' && strings.Contains(info, "`") {
+		return "", "", false
+	}
+	lang, _, _ := strings.Cut(info, " ")
+	return trimmed[:n], lang, true
+}
+
+// isFenceClose reports whether line closes a block opened with fence: the
+// same character, at least as many times, and nothing else.
+func isFenceClose(line, fence string) bool {
+	trimmed, ok := trimIndent(line)
+	if !ok {
+		return false
+	}
+	trimmed = strings.TrimRight(trimmed, " \t")
+	return len(trimmed) >= len(fence) && strings.Trim(trimmed, fence[:1]) == ""
+}
+
+// trimIndent strips up to three leading spaces; more indentation makes
+// the line an indented code line rather than a block marker.
+func trimIndent(line string) (string, bool) {
+	for i := 0; i < 4; i++ {
+		if i == len(line) || line[i] != ' ' {
+			return line[i:], true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,75 @@
+// internal/normalize/schema_markdown_test.go
+package normalize
+
+import (
+	"testing"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+func TestNormalizeMarkdown_Structure(t *testing.T) {
+	md := "# Guide\n\nA short guide.\n\n## Install\n\nRun the installer.\nThen restart.\n\n```sh\ngo install ./...\n\n# not a heading\n```\n\n### Usage ###\n\nCall it.\n"
+
+	doc := NormalizeMarkdown(md)
+	if doc.Title != "Guide" {
+		t.Fatalf("Title: got %q, want %q", doc.Title, "Guide")
+	}
+	if doc.Excerpt != "A short guide." {
+		t.Fatalf("Excerpt: got %q, want %q", doc.Excerpt, "A short guide.")
+	}
+	if len(doc.Sections) != 4 {
+		t.Fatalf("got %d sections: %+v", len(doc.Sections), doc.Sections)
+	}
+
+	lead, install, code, usage := doc.Sections[0], doc.Sections[1], doc.Sections[2], doc.Sections[3]
+	if lead.Heading != "" || lead.Text != "A short guide." {
+		t.Fatalf("lead section: %+v", lead)
+	}
+	if install.Heading != "Install" || install.Text != "Run the installer.\nThen restart." ||
+		install.Meta[model.MetaHeadingLevel] != "2" {
+		t.Fatalf("install section: %+v", install)
+	}
+	if code.Role != model.SectionRoleCode || code.Meta["lang"] != "sh" ||
+		code.Text != "go install ./...\n\n# not a heading" {
+		t.Fatalf("code section: %+v", code)
+	}
+	if usage.Heading != "Usage" || usage.Meta[model.MetaHeadingLevel] != "3" || usage.Text != "Call it." {
+		t.Fatalf("usage section: %+v", usage)
+	}
+}
+
+func TestNormalizeMarkdown_ExcerptInsideSectionIsKept(t *testing.T) {
+	doc := NormalizeMarkdown("## Only\n\nFirst paragraph.\n\nSecond.")
+	if doc.Title != "" || doc.Excerpt != "First paragraph." {
+		t.Fatalf("title %q, excerpt %q", doc.Title, doc.Excerpt)
+	}
+	if len(doc.Sections) != 1 || doc.Sections[0].Text != "First paragraph.\n\nSecond." {
+		t.Fatalf("sections: %+v", doc.Sections)
+	}
+}
+
+func TestNormalizeMarkdown_WithoutHeadingsKeepsBody(t *testing.T) {
+	doc := NormalizeMarkdown("First paragraph.\n\nSecond paragraph.")
+	if doc.Excerpt != "First paragraph." {
+		t.Fatalf("Excerpt: got %q", doc.Excerpt)
+	}
+	if want := "First paragraph.\n\nSecond paragraph."; doc.Content != want {
+		t.Fatalf("Content: got %q, want %q", doc.Content, want)
+	}
+	if len(doc.Sections) != 1 || doc.Sections[0].Text != doc.Content {
+		t.Fatalf("sections: %+v", doc.Sections)
+	}
+}
+
+func TestNormalizeMarkdown_LeadingListKeepsStructure(t *testing.T) {
+	doc := NormalizeMarkdown("- one\n- two\n- three\n\n## Next\n\nMore.")
+	if len(doc.Sections) != 2 {
+		t.Fatalf("got %d sections: %+v", len(doc.Sections), doc.Sections)
+	}
+	if want := "- one\n- two\n- three"; doc.Sections[0].Text != want {
+		t.Fatalf("list section: got %q, want %q", doc.Sections[0].Text, want)
+	}
+	if want := "- one\n- two\n- three\n\nMore."; doc.Content != want {
+		t.Fatalf("Content: got %q, want %q", doc.Content, want)
+	}
+}
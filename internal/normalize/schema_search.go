@@ -117,14 +117,6 @@ func mapSearchKind(kind string) model.DocumentKind {
 // This function is intentionally simple—Aether aims for clarity over
 // linguistic complexity.
 func deriveExcerpt(content string) string {
-	clean := strings.TrimSpace(content)
-	if clean == "" {
-		return ""
-	}
-
 	// Use first 240 characters (soft heuristic)
-	if len(clean) <= 240 {
-		return clean
-	}
-	return clean[:240] + "…"
+	return Excerpt(content, 240)
 }
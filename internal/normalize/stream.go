@@ -0,0 +1,73 @@
+// internal/normalize/stream.go
+//
+// Streaming normalization for results with very large feeds.
+//
+// Pipeline materializes one section per feed item before returning,
+// which for a feed with tens of thousands of items means holding all of
+// them at once. PipelineStream instead normalizes the result with its
+// feed cut down to a single item, which yields the same document-level
+// fields, metadata and section layout, and returns that skeleton with
+// the feed item's slot marked. FeedSection then builds the section for
+// each item on demand, applying the same provenance tag, whitespace
+// mode and metadata sanitizing as Pipeline, so a caller can write
+// sections out one at a time.
+
+package normalize
+
+import "github.com/Nibir1/Aether/internal/model"
+
+// Stream is a normalized document whose feed item sections are built
+// lazily.
+type Stream struct {
+	// Doc is the normalized document without feed item sections.
+	Doc *model.Document
+
+	// FeedAt is the index in Doc.Sections at which the feed item
+	// sections belong, or -1 when the result has no feed items.
+	FeedAt int
+
+	whitespace WhitespaceMode
+	metaLimit  int
+}
+
+// PipelineStream normalizes sr like Pipeline, except that feed items
+// are left to FeedSection. Only the first item of sr.Feed is normalized,
+// to find the items' slot; the caller streams every item through
+// FeedSection instead.
+func PipelineStream(sr *SearchResult, opts ...Option) *Stream {
+	s := &Stream{FeedAt: -1}
+	if sr != nil && sr.Feed != nil && len(sr.Feed.Items) > 1 {
+		f := *sr.Feed
+		f.Items = f.Items[:1]
+		cp := *sr
+		cp.Feed = &f
+		sr = &cp
+	}
+
+	doc := Pipeline(sr, opts...)
+	o := buildOptions(opts)
+	s.whitespace = o.whitespaceFor(doc.Kind)
+	s.metaLimit = o.metaLimit()
+
+	for i, sec := range doc.Sections {
+		if sec.Role == model.SectionRoleFeedItem && sec.Meta[provenanceKey] == "feed" {
+			s.FeedAt = i
+			doc.Sections = append(doc.Sections[:i:i], doc.Sections[i+1:]...)
+			break
+		}
+	}
+	s.Doc = doc
+	return s
+}
+
+// FeedSection returns the normalized section for one feed item, as
+// Pipeline would have placed it in the document.
+func (s *Stream) FeedSection(item FeedItem) model.Section {
+	sec := feedItemSection(item)
+	sec.Meta[provenanceKey] = "feed"
+
+	one := model.Document{Sections: []model.Section{sec}}
+	applyWhitespace(&one, s.whitespace)
+	sanitizeMetadata(&one, s.metaLimit)
+	return one.Sections[0]
+}
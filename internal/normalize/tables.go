@@ -0,0 +1,71 @@
+// internal/normalize/tables.go
+//
+// Table sections.
+//
+// Article extraction writes data tables into the content as Markdown
+// pipe tables (model.FormatTable), each as its own blank-line separated
+// block. splitTableSections cuts those blocks out of body sections into
+// SectionRoleTable sections, so renderers can draw them as tables
+// instead of reflowing them as prose.
+
+package normalize
+
+import (
+	"strings"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+// splitTableSections returns sections with every table block of a body
+// section split into a table section of its own. The pieces keep the
+// source section's metadata; its heading stays on the first piece.
+func splitTableSections(sections []model.Section) []model.Section {
+	var out []model.Section
+	for _, sec := range sections {
+		if sec.Role != model.SectionRoleBody || !strings.Contains(sec.Text, "|") {
+			out = append(out, sec)
+			continue
+		}
+
+		var pieces []model.Section
+		var prose []string
+		flush := func() {
+			if text := strings.TrimSpace(strings.Join(prose, "\n\n")); text != "" {
+				pieces = append(pieces, model.Section{Role: model.SectionRoleBody, Text: text, Meta: copyMetadata(sec.Meta)})
+			}
+			prose = nil
+		}
+		for _, block := range strings.Split(sec.Text, "\n\n") {
+			if _, _, ok := model.ParseTable(block); ok {
+				flush()
+				pieces = append(pieces, model.Section{Role: model.SectionRoleTable, Text: strings.TrimSpace(block), Meta: copyMetadata(sec.Meta)})
+				continue
+			}
+			prose = append(prose, block)
+		}
+		flush()
+
+		if len(pieces) == 0 {
+			out = append(out, sec)
+			continue
+		}
+		pieces[0].Heading = sec.Heading
+		out = append(out, pieces...)
+	}
+	return out
+}
+
+// stripTables removes the table blocks from content, so that excerpts
+// are drawn from prose.
+func stripTables(content string) string {
+	if !strings.Contains(content, "|") {
+		return content
+	}
+	var prose []string
+	for _, block := range strings.Split(content, "\n\n") {
+		if _, _, ok := model.ParseTable(block); !ok {
+			prose = append(prose, block)
+		}
+	}
+	return strings.Join(prose, "\n\n")
+}
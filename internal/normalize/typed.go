@@ -0,0 +1,115 @@
+// internal/normalize/typed.go
+//
+// Typed metadata.
+//
+// Metadata is map[string]string throughout Aether, so scores, counts
+// and timestamps reach JSON consumers as quoted strings. When requested
+// with WithTypedMetadata, Pipeline mirrors selected keys into
+// model.Document.TypedMetadata as int64, float64 or bool values. Only
+// keys known to hold numbers or flags are typed, so identifiers that
+// merely look numeric (zip codes, phone numbers, GUIDs) stay strings.
+
+package normalize
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// DefaultTypedMetadataKeys are the metadata keys typed by
+// WithTypedMetadata when no keys are given. Keys ending in "_unix",
+// "_count" or "_seconds" are typed as well.
+var DefaultTypedMetadataKeys = []string{
+	"score",
+	"relevance",
+	"rank",
+	"page",
+	"status_code",
+	"empty",
+	"truncated",
+}
+
+// typedSuffixes mark numeric keys by naming convention.
+var typedSuffixes = []string{"_unix", "_count", "_seconds"}
+
+// WithTypedMetadata fills Document.TypedMetadata with JSON-native values
+// for the given metadata keys, or for DefaultTypedMetadataKeys (and the
+// conventional numeric suffixes) when keys is empty. Values that do not
+// parse as a number or boolean are left out.
+func WithTypedMetadata(keys ...string) Option {
+	return func(o *options) {
+		o.typed = true
+		o.typedKeys = append([]string(nil), keys...)
+	}
+}
+
+// typedMetadata returns the typed mirror of meta, or nil when no value
+// qualifies.
+func typedMetadata(meta map[string]string, keys []string) map[string]any {
+	want := func(k string) bool {
+		if len(keys) > 0 {
+			for _, key := range keys {
+				if key == k {
+					return true
+				}
+			}
+			return false
+		}
+		for _, key := range DefaultTypedMetadataKeys {
+			if key == k {
+				return true
+			}
+		}
+		for _, suffix := range typedSuffixes {
+			if strings.HasSuffix(k, suffix) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var out map[string]any
+	for k, v := range meta {
+		if !want(k) {
+			continue
+		}
+		tv, ok := typedValue(v)
+		if !ok {
+			continue
+		}
+		if out == nil {
+			out = map[string]any{}
+		}
+		out[k] = tv
+	}
+	return out
+}
+
+// typedValue parses s as a bool, an integer or a finite float. Integers
+// with a leading "+" or leading zeros are rejected, as they are usually
+// identifiers rather than quantities.
+func typedValue(s string) (any, bool) {
+	s = strings.TrimSpace(s)
+	switch s {
+	case "":
+		return nil, false
+	case "true":
+		return true, true
+	case "false":
+		return false, true
+	}
+
+	digits := strings.TrimPrefix(s, "-")
+	if digits == "" || digits[0] == '+' || (len(digits) > 1 && digits[0] == '0' && digits[1] != '.') {
+		return nil, false
+	}
+
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i, true
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil && !math.IsInf(f, 0) && !math.IsNaN(f) {
+		return f, true
+	}
+	return nil, false
+}
@@ -6,6 +6,7 @@
 //
 //   • consistent whitespace collapsing
 //   • safe metadata cloning
+//   • canonical metadata keys
 //   • excerpt trimming
 //   • safe string operations
 //   • section de-duplication (for feeds, entities, etc.)
@@ -18,7 +19,10 @@ package normalize
 
 import (
 	"regexp"
+	"sort"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/Nibir1/Aether/internal/model"
 )
@@ -41,6 +45,80 @@ func collapseWhitespace(s string) string {
 	return re.ReplaceAllString(s, " ")
 }
 
+// preserveParagraphs normalizes whitespace while keeping structure:
+//
+//   - paragraphs (separated by one or more blank lines) are kept apart
+//     by exactly one blank line
+//   - soft line breaks inside a paragraph become single spaces
+//   - runs of spaces/tabs inside prose collapse to one space
+//   - fenced (```) and indented (tab or 4+ spaces) code lines are kept
+//     verbatim, apart from trailing whitespace
+func preserveParagraphs(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	if strings.TrimSpace(s) == "" {
+		return ""
+	}
+
+	var (
+		blocks  []string
+		prose   []string
+		code    []string
+		inFence bool
+	)
+
+	flush := func() {
+		if len(prose) > 0 {
+			blocks = append(blocks, strings.Join(prose, " "))
+			prose = nil
+		}
+		if len(code) > 0 {
+			blocks = append(blocks, strings.Join(code, "\n"))
+			code = nil
+		}
+	}
+
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimRight(line, " \t")
+
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			if !inFence {
+				flush()
+			}
+			code = append(code, line)
+			inFence = !inFence
+			if !inFence {
+				flush()
+			}
+			continue
+		}
+		if inFence {
+			code = append(code, line)
+			continue
+		}
+
+		if strings.TrimSpace(line) == "" {
+			flush()
+			continue
+		}
+
+		if strings.HasPrefix(line, "\t") || strings.HasPrefix(line, "    ") {
+			if len(prose) > 0 {
+				flush()
+			}
+			code = append(code, line)
+			continue
+		}
+
+		if len(code) > 0 {
+			flush()
+		}
+		prose = append(prose, collapseWhitespace(line))
+	}
+	flush()
+
+	return strings.Join(blocks, "\n\n")
+}
+
 // safeTrim returns trimmed s, preserving empty result safely.
 func safeTrim(s string) string {
 	return strings.TrimSpace(s)
@@ -90,6 +168,72 @@ func mergeMetadata(base, overlay map[string]string) map[string]string {
 	return base
 }
 
+// RawMetaPrefix prefixes the original key of every metadata entry that
+// canonicalizeMetadata renamed, e.g. "page_url" is kept as "raw.page_url".
+const RawMetaPrefix = "raw."
+
+// CanonicalMetaKeys lists the canonical document metadata keys and the
+// source-specific synonyms that are folded into each of them. When
+// several synonyms are present, the earliest in the list wins; an
+// existing canonical key always wins over its synonyms.
+var CanonicalMetaKeys = map[string][]string{
+	"url":       {"page_url", "source_url"},
+	"language":  {"lang"},
+	"author":    {"byline", "creator"},
+	"published": {"published_at", "pub_date", "date_published"},
+	"updated":   {"updated_at", "modified", "date_modified"},
+	"site_name": {"site", "og_site_name"},
+}
+
+// CanonicalMetaFallbacks lists keys that fill a missing canonical key
+// but, unlike synonyms, are kept under their own name as well, because
+// callers look them up directly (e.g. "content_language", recorded from
+// the Content-Language response header). Synonyms take precedence.
+var CanonicalMetaFallbacks = map[string][]string{
+	"language": {"content_language"},
+}
+
+// canonicalizeMetadata rewrites known synonym keys in m to their
+// canonical names (see CanonicalMetaKeys). Each renamed entry is kept
+// under RawMetaPrefix+key so no source information is lost. Unknown keys
+// pass through untouched. m is modified in place and returned.
+func canonicalizeMetadata(m map[string]string) map[string]string {
+	if len(m) == 0 {
+		return m
+	}
+
+	canon := make([]string, 0, len(CanonicalMetaKeys))
+	for k := range CanonicalMetaKeys {
+		canon = append(canon, k)
+	}
+	sort.Strings(canon)
+
+	for _, key := range canon {
+		for _, syn := range CanonicalMetaKeys[key] {
+			v, ok := m[syn]
+			if !ok {
+				continue
+			}
+			delete(m, syn)
+			if _, exists := m[RawMetaPrefix+syn]; !exists {
+				m[RawMetaPrefix+syn] = v
+			}
+			if _, exists := m[key]; !exists && strings.TrimSpace(v) != "" {
+				m[key] = v
+			}
+		}
+		for _, src := range CanonicalMetaFallbacks[key] {
+			if _, exists := m[key]; exists {
+				break
+			}
+			if v := m[src]; strings.TrimSpace(v) != "" {
+				m[key] = v
+			}
+		}
+	}
+	return m
+}
+
 //
 // ────────────────────────────────────────────────────────────────────────
 //                           SECTION HELPERS
@@ -143,20 +287,60 @@ func deriveTitleFromURL(url string) string {
 // ────────────────────────────────────────────────────────────────────────
 //
 
+// Excerpt collapses whitespace in text and shortens it to at most maxLen
+// runes, ending with "…" when anything was cut. Truncation happens on
+// rune boundaries, so multi-byte text stays valid UTF-8. maxLen <= 0
+// means no limit.
+func Excerpt(text string, maxLen int) string {
+	text = collapseWhitespace(text)
+	if text == "" || maxLen <= 0 || utf8.RuneCountInString(text) <= maxLen {
+		return text
+	}
+
+	runes := []rune(text)
+	if maxLen == 1 {
+		return string(runes[:1])
+	}
+	return strings.TrimRight(string(runes[:maxLen-1]), " ") + "…"
+}
+
+// sanitizeMetaValue makes a metadata value safe to render on one line:
+// whitespace (including newlines and tabs) becomes a single space, other
+// control characters are dropped, and the result is capped at limit
+// runes (limit <= 0 means no cap).
+func sanitizeMetaValue(v string, limit int) string {
+	v = strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsSpace(r):
+			return ' '
+		case unicode.IsControl(r), r == utf8.RuneError:
+			return -1
+		}
+		return r
+	}, v)
+	return Excerpt(v, limit)
+}
+
+// sanitizeMetadata applies sanitizeMetaValue to the document metadata
+// and to every section's metadata in place. Keys are left untouched.
+func sanitizeMetadata(doc *model.Document, limit int) {
+	for k, v := range doc.Metadata {
+		doc.Metadata[k] = sanitizeMetaValue(v, limit)
+	}
+	for i := range doc.Sections {
+		for k, v := range doc.Sections[i].Meta {
+			doc.Sections[i].Meta[k] = sanitizeMetaValue(v, limit)
+		}
+	}
+}
+
 // excerptFromContent returns up to limit characters from the content.
 // If limit <= 0, defaults to 240 chars.
 func excerptFromContent(content string, limit int) string {
-	content = collapseWhitespace(content)
-	if content == "" {
-		return ""
-	}
 	if limit <= 0 {
 		limit = 240
 	}
-	if len(content) <= limit {
-		return content
-	}
-	return content[:limit] + "…"
+	return Excerpt(content, limit)
 }
 
 //
@@ -0,0 +1,125 @@
+// internal/normalize/util_test.go
+package normalize
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestCanonicalizeMetadata_Synonyms(t *testing.T) {
+	for key, synonyms := range CanonicalMetaKeys {
+		for _, syn := range synonyms {
+			m := canonicalizeMetadata(map[string]string{syn: "v-" + syn, "other": "x"})
+
+			if got := m[key]; got != "v-"+syn {
+				t.Fatalf("%s: canonical %q: got %q, want %q", syn, key, got, "v-"+syn)
+			}
+			if got := m[RawMetaPrefix+syn]; got != "v-"+syn {
+				t.Fatalf("%s: raw copy: got %q, want %q", syn, got, "v-"+syn)
+			}
+			if _, ok := m[syn]; ok {
+				t.Fatalf("%s: synonym key still present", syn)
+			}
+			if m["other"] != "x" {
+				t.Fatalf("%s: unrelated key changed: %v", syn, m)
+			}
+		}
+	}
+}
+
+func TestCanonicalizeMetadata_Precedence(t *testing.T) {
+	m := canonicalizeMetadata(map[string]string{
+		"source_url": "https://example.com/source",
+		"page_url":   "https://example.com/page",
+		"language":   "en",
+		"lang":       "de",
+	})
+
+	if got := m["url"]; got != "https://example.com/page" {
+		t.Fatalf("url: got %q, want %q", got, "https://example.com/page")
+	}
+	if got := m["raw.source_url"]; got != "https://example.com/source" {
+		t.Fatalf("raw.source_url: got %q, want %q", got, "https://example.com/source")
+	}
+	if got := m["language"]; got != "en" {
+		t.Fatalf("language: got %q, want %q", got, "en")
+	}
+	if got := m["raw.lang"]; got != "de" {
+		t.Fatalf("raw.lang: got %q, want %q", got, "de")
+	}
+}
+
+func TestCanonicalizeMetadata_FallbacksAreKept(t *testing.T) {
+	m := canonicalizeMetadata(map[string]string{"content_language": "nb-NO"})
+	if got := m["language"]; got != "nb-NO" {
+		t.Fatalf("language: got %q, want %q", got, "nb-NO")
+	}
+	if got := m["content_language"]; got != "nb-NO" {
+		t.Fatalf("content_language: got %q, want %q", got, "nb-NO")
+	}
+
+	m = canonicalizeMetadata(map[string]string{"lang": "en", "content_language": "nb-NO"})
+	if got := m["language"]; got != "en" {
+		t.Fatalf("language with synonym: got %q, want %q", got, "en")
+	}
+}
+
+func TestPipeline_CanonicalMetadata(t *testing.T) {
+	doc := Pipeline(&SearchResult{
+		PrimaryDocument: &SearchDocument{
+			URL:      "https://en.wikipedia.org/wiki/Go",
+			Title:    "Go",
+			Metadata: map[string]string{"page_url": "https://en.wikipedia.org/wiki/Go", "lang": "en"},
+		},
+	})
+
+	if got := doc.Metadata["url"]; got != "https://en.wikipedia.org/wiki/Go" {
+		t.Fatalf("url: got %q", got)
+	}
+	if got := doc.Metadata["language"]; got != "en" {
+		t.Fatalf("language: got %q", got)
+	}
+}
+
+func TestPipeline_SanitizesMetadata(t *testing.T) {
+	huge := "line one\nline two\x00\x07\tend " + strings.Repeat("x", 10*1024)
+
+	doc := Pipeline(&SearchResult{
+		PrimaryDocument: &SearchDocument{
+			Title:    "T",
+			Metadata: map[string]string{"noisy\nkey": huge, "short": "a\r\n b"},
+		},
+	})
+
+	v, ok := doc.Metadata["noisy\nkey"]
+	if !ok {
+		t.Fatalf("metadata key was altered: %v", doc.Metadata)
+	}
+	if n := utf8.RuneCountInString(v); n != DefaultMaxMetadataValueLength {
+		t.Fatalf("capped length: got %d runes, want %d", n, DefaultMaxMetadataValueLength)
+	}
+	if !strings.HasPrefix(v, "line one line two end xxx") || !strings.HasSuffix(v, "…") {
+		t.Fatalf("sanitized value: got %q...", v[:40])
+	}
+	if strings.ContainsAny(v, "\n\r\t\x00\x07") {
+		t.Fatalf("control characters survived: %q", v[:40])
+	}
+	if got := doc.Metadata["short"]; got != "a b" {
+		t.Fatalf("short: got %q, want %q", got, "a b")
+	}
+
+	capped := Pipeline(&SearchResult{
+		PrimaryDocument: &SearchDocument{Title: "T", Metadata: map[string]string{"k": huge}},
+	}, WithMaxMetadataValueLength(16))
+	if got := capped.Metadata["k"]; got != "line one line t…" {
+		t.Fatalf("custom cap: got %q", got)
+	}
+
+	uncapped := Pipeline(&SearchResult{
+		PrimaryDocument: &SearchDocument{Title: "T", Metadata: map[string]string{"k": huge}},
+	}, WithMaxMetadataValueLength(-1))
+	if n := len(uncapped.Metadata["k"]); n < 10*1024 {
+		t.Fatalf("negative cap should keep the full value, got %d bytes", n)
+	}
+}
@@ -0,0 +1,223 @@
+// internal/normalize/verbatim.go
+//
+// Detection of verbatim segments inside prose content.
+//
+// Extracted article text may embed source code (``` fences or indented
+// blocks) and display math ($$ … $$). Both are corrupted by whitespace
+// collapsing and by the renderers' line wrapping. splitVerbatim cuts such
+// segments out of the prose so they can be stored as SectionRoleCode
+// sections, marked with model.MetaVerbatim, and emitted untouched.
+//
+// Only display math is detected. Inline $…$ math stays part of its
+// paragraph and is reflowed like the surrounding prose.
+
+package normalize
+
+import (
+	"strings"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+// segment is one run of content: prose, or a verbatim code/math block.
+type segment struct {
+	verbatim string // "", model.VerbatimCode or model.VerbatimMath
+	lang     string
+	text     string
+}
+
+// splitVerbatim splits content into prose and verbatim segments in
+// document order. Prose segments are trimmed; verbatim segments keep
+// their indentation and internal whitespace exactly, minus fence lines.
+func splitVerbatim(content string) []segment {
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+
+	var (
+		out   []segment
+		prose []string
+	)
+	flushProse := func() {
+		if t := strings.TrimSpace(strings.Join(prose, "\n")); t != "" {
+			out = append(out, segment{text: t})
+		}
+		prose = nil
+	}
+
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~"):
+			// Fenced code: everything up to the matching fence. Without
+			// one, the opening line is prose rather than the rest of the
+			// content turning into code.
+			fence := trimmed[:3]
+			lang := strings.TrimSpace(strings.TrimLeft(trimmed, fence[:1]))
+			j := i + 1
+			for j < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[j]), fence) {
+				j++
+			}
+			if j == len(lines) {
+				prose = append(prose, line)
+				i++
+				break
+			}
+			flushProse()
+			out = appendVerbatim(out, model.VerbatimCode, lang, lines[i+1:j])
+			i = j + 1
+
+		case strings.HasPrefix(trimmed, "$$"):
+			// Display math: a one-line $$…$$ or a block closed by $$.
+			// An unclosed $$ leaves its line as prose, like a fence.
+			j := i
+			if !(len(trimmed) > 2 && strings.HasSuffix(trimmed, "$$")) {
+				j = i + 1
+				for j < len(lines) && !strings.HasSuffix(strings.TrimSpace(lines[j]), "$$") {
+					j++
+				}
+			}
+			if j == len(lines) {
+				prose = append(prose, line)
+				i++
+				break
+			}
+			flushProse()
+			out = appendVerbatim(out, model.VerbatimMath, "", lines[i:j+1])
+			i = j + 1
+
+		case isIndentedCode(line) && startsParagraph(lines, i):
+			// Indented code: a block of tab/4-space lines that starts a
+			// paragraph, running until the next non-indented text line.
+			j := i
+			for j < len(lines) && (isIndentedCode(lines[j]) || strings.TrimSpace(lines[j]) == "") {
+				j++
+			}
+			flushProse()
+			out = appendVerbatim(out, model.VerbatimCode, "", lines[i:j])
+			i = j
+
+		case startsParagraph(lines, i) && opensBlock(line) && hangingIndent(lines, i):
+			// A paragraph whose first line opens a block and whose
+			// continuation lines are indented, such as "def f():"
+			// followed by its body: preformatted code whose first line
+			// sits at column zero. Nested lists, wrapped quotes and
+			// indented addresses lack the opener and stay prose.
+			j := paragraphEnd(lines, i)
+			flushProse()
+			out = appendVerbatim(out, model.VerbatimCode, "", lines[i:j])
+			i = j
+
+		default:
+			prose = append(prose, line)
+			i++
+		}
+	}
+	flushProse()
+
+	return out
+}
+
+// appendVerbatim adds a verbatim segment unless it is blank.
+func appendVerbatim(out []segment, kind, lang string, lines []string) []segment {
+	text := trimVerbatim(strings.Join(lines, "\n"))
+	if strings.TrimSpace(text) == "" {
+		return out
+	}
+	return append(out, segment{verbatim: kind, lang: lang, text: text})
+}
+
+// hasVerbatim reports whether any segment is verbatim.
+func hasVerbatim(segs []segment) bool {
+	for _, s := range segs {
+		if s.verbatim != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// startsParagraph reports whether lines[i] follows a blank line or is
+// the first line.
+func startsParagraph(lines []string, i int) bool {
+	return i == 0 || strings.TrimSpace(lines[i-1]) == ""
+}
+
+// paragraphEnd returns the index of the first blank line at or after i,
+// or len(lines).
+func paragraphEnd(lines []string, i int) int {
+	for i < len(lines) && strings.TrimSpace(lines[i]) != "" {
+		i++
+	}
+	return i
+}
+
+// hangingIndent reports whether the paragraph starting at i has more
+// than one line and any line after the first is indented like code.
+func hangingIndent(lines []string, i int) bool {
+	end := paragraphEnd(lines, i)
+	if end-i < 2 {
+		return false
+	}
+	for _, l := range lines[i+1 : end] {
+		if isIndentedCode(l) {
+			return true
+		}
+	}
+	return false
+}
+
+// opensBlock reports whether line ends like the header of a code block:
+// with ":", "{" or "(".
+func opensBlock(line string) bool {
+	t := strings.TrimRight(line, " \t")
+	return strings.HasSuffix(t, ":") || strings.HasSuffix(t, "{") || strings.HasSuffix(t, "(")
+}
+
+// isIndentedCode reports whether line is indented like a code line.
+func isIndentedCode(line string) bool {
+	return strings.TrimSpace(line) != "" &&
+		(strings.HasPrefix(line, "\t") || strings.HasPrefix(line, "    "))
+}
+
+// trimVerbatim strips trailing whitespace from every line of a verbatim
+// block and drops its leading and trailing blank lines, keeping leading
+// indentation.
+func trimVerbatim(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimRight(l, " \t")
+	}
+	return model.TrimBlankLines(strings.Join(lines, "\n"))
+}
+
+// verbatimSections turns segments into body sections for prose and code
+// sections for verbatim segments. The first prose section carries the
+// heading; every section gets a copy of meta, and code sections add the
+// verbatim marker and language.
+func verbatimSections(heading string, segs []segment, meta map[string]string) []model.Section {
+	var out []model.Section
+	headed := false
+	for _, s := range segs {
+		if s.verbatim == "" {
+			sec := model.Section{Role: model.SectionRoleBody, Text: s.text, Meta: copyMetadata(meta)}
+			if !headed {
+				sec.Heading = heading
+				headed = true
+			}
+			out = append(out, sec)
+			continue
+		}
+
+		m := copyMetadata(meta)
+		if m == nil {
+			m = map[string]string{}
+		}
+		m[model.MetaVerbatim] = s.verbatim
+		if s.lang != "" {
+			m["lang"] = s.lang
+		}
+		out = append(out, model.Section{Role: model.SectionRoleCode, Text: s.text, Meta: m})
+	}
+	return out
+}
@@ -0,0 +1,47 @@
+// internal/normalize/whitespace_test.go
+package normalize
+
+import (
+	"strings"
+	"testing"
+)
+
+const multiParagraph = "First   paragraph line one\nline two.\n\n\n" +
+	"Second paragraph.\n\n" +
+	"    func main() {\n        fmt.Println(\"hi\")\n    }\n"
+
+func TestPipeline_WhitespaceModes(t *testing.T) {
+	sr := &SearchResult{Article: &Article{Title: "Doc", Content: multiParagraph}}
+
+	collapsed := Pipeline(sr, WithWhitespaceMode(WhitespaceCollapse))
+	if strings.Contains(collapsed.Content, "\n") {
+		t.Fatalf("collapse mode kept newlines: %q", collapsed.Content)
+	}
+
+	para := Pipeline(sr, WithWhitespaceMode(WhitespaceParagraphs))
+	want := "First paragraph line one line two.\n\n" +
+		"Second paragraph.\n\n" +
+		"    func main() {\n        fmt.Println(\"hi\")\n    }"
+	if para.Content != want {
+		t.Fatalf("paragraph mode:\ngot  %q\nwant %q", para.Content, want)
+	}
+	// The indented snippet is split into its own verbatim code section.
+	if len(para.Sections) != 2 {
+		t.Fatalf("sections: got %d, want 2", len(para.Sections))
+	}
+	if got, want := para.Sections[0].Text, "First paragraph line one line two.\n\nSecond paragraph."; got != want {
+		t.Fatalf("section text:\ngot  %q\nwant %q", got, want)
+	}
+	if got, want := para.Sections[1].Text, "    func main() {\n        fmt.Println(\"hi\")\n    }"; got != want {
+		t.Fatalf("code section text:\ngot  %q\nwant %q", got, want)
+	}
+
+	// Kind-specific override wins over the default mode.
+	kinded := Pipeline(sr,
+		WithWhitespaceMode(WhitespaceParagraphs),
+		WithKindWhitespaceMode(para.Kind, WhitespaceCollapse),
+	)
+	if strings.Contains(kinded.Content, "\n") {
+		t.Fatalf("kind override ignored: %q", kinded.Content)
+	}
+}
@@ -22,13 +22,45 @@ package openapi
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/Nibir1/Aether/internal/clock"
 	"github.com/Nibir1/Aether/internal/config"
+	"github.com/Nibir1/Aether/internal/errors"
 	"github.com/Nibir1/Aether/internal/httpclient"
 	"github.com/Nibir1/Aether/internal/log"
 )
 
+// Endpoint identifies one OpenAPI integration. It keys the per-endpoint
+// settings in config.Config (e.g. OpenAPITimeouts).
+type Endpoint string
+
+const (
+	EndpointWikipedia  Endpoint = "wikipedia"
+	EndpointWikidata   Endpoint = "wikidata"
+	EndpointHackerNews Endpoint = "hackernews"
+	EndpointGitHub     Endpoint = "github"
+	EndpointWhiteHouse Endpoint = "whitehouse"
+	EndpointPress      Endpoint = "press"
+	EndpointWeather    Endpoint = "weather"
+)
+
+// defaultTimeouts bounds each individual request to an endpoint, so one
+// slow service cannot consume the caller's whole deadline. Wikidata gets
+// more time because SPARQL queries are routinely slow.
+var defaultTimeouts = map[Endpoint]time.Duration{
+	EndpointWikipedia:  10 * time.Second,
+	EndpointWikidata:   15 * time.Second,
+	EndpointHackerNews: 10 * time.Second,
+	EndpointGitHub:     10 * time.Second,
+	EndpointWhiteHouse: 10 * time.Second,
+	EndpointPress:      10 * time.Second,
+	EndpointWeather:    10 * time.Second,
+}
+
 // Client is Aether's internal aggregator for all OpenAPI integrations.
 //
 // The Client is intentionally simple: it wraps the shared internal HTTP
@@ -67,33 +99,120 @@ func New(cfg *config.Config, logger log.Logger, httpClient *httpclient.Client) *
 // the response body and headers for JSON decoding.
 //
 // The caller is responsible for unmarshalling the JSON.
-func (c *Client) getJSON(ctx context.Context, url string) ([]byte, http.Header, error) {
-	resp, err := c.http.Fetch(ctx, url, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-	return resp.Body, resp.Header, nil
+func (c *Client) getJSON(ctx context.Context, ep Endpoint, url string) ([]byte, http.Header, error) {
+	return c.get(ctx, ep, url)
 }
 
 // getText executes a GET request intended for plain-text sources such as
 // GitHub README documents, plain RSS feeds, or metadata endpoints.
 //
 // The caller receives the raw bytes and HTTP headers.
-func (c *Client) getText(ctx context.Context, url string) ([]byte, http.Header, error) {
-	resp, err := c.http.Fetch(ctx, url, nil)
-	if err != nil {
-		return nil, nil, err
-	}
-	return resp.Body, resp.Header, nil
+func (c *Client) getText(ctx context.Context, ep Endpoint, url string) ([]byte, http.Header, error) {
+	return c.get(ctx, ep, url)
 }
 
 // getXML is a small helper used by RSS/Atom and XML-structured APIs.
 // It does not interpret charset conversion automatically — that is handled
 // by the individual XML integration files where needed.
-func (c *Client) getXML(ctx context.Context, url string) ([]byte, http.Header, error) {
-	resp, err := c.http.Fetch(ctx, url, nil)
+func (c *Client) getXML(ctx context.Context, ep Endpoint, url string) ([]byte, http.Header, error) {
+	return c.get(ctx, ep, url)
+}
+
+// get fetches url under the endpoint's timeout. When the primary host
+// fails (see shouldFailOver) the same path is requested from each of the
+// endpoint's mirrors in turn, each under a fresh timeout; the outcome of
+// the last attempt is returned when none succeeds.
+func (c *Client) get(ctx context.Context, ep Endpoint, url string) ([]byte, http.Header, error) {
+	resp, err := c.getOnce(ctx, ep, url)
+	for _, mirror := range c.mirrors(ep) {
+		if !shouldFailOver(resp, err) || ctx.Err() != nil {
+			break
+		}
+		alt, ok := mirrorURL(url, mirror)
+		if !ok {
+			continue
+		}
+		if c.logger != nil {
+			c.logger.Debugf("openapi %s: %s failed, trying mirror %s", ep, url, alt)
+		}
+		resp, err = c.getOnce(ctx, ep, alt)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
 	return resp.Body, resp.Header, nil
 }
+
+// getOnce fetches url once under the endpoint's timeout. The timeout
+// applies to a child context, so the caller's remaining deadline is
+// untouched when the endpoint gives up; that case is reported as a
+// KindTimeout error.
+func (c *Client) getOnce(ctx context.Context, ep Endpoint, url string) (*httpclient.Response, error) {
+	reqCtx := ctx
+	timeout := c.timeout(ep)
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		reqCtx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	resp, err := c.http.Fetch(reqCtx, url, nil)
+	if err != nil {
+		if reqCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil {
+			msg := fmt.Sprintf("openapi %s: no response within %s", ep, timeout)
+			return nil, errors.New(errors.KindTimeout, msg, context.DeadlineExceeded)
+		}
+		return nil, err
+	}
+	return resp, nil
+}
+
+// timeout returns the per-request timeout for ep: the configured
+// override if present (0 disables it), otherwise the built-in default.
+func (c *Client) timeout(ep Endpoint) time.Duration {
+	if c.cfg != nil {
+		if d, ok := c.cfg.OpenAPITimeouts[string(ep)]; ok {
+			return d
+		}
+	}
+	return defaultTimeouts[ep]
+}
+
+// ───── FRESHNESS METADATA ─────
+
+// Metadata keys describing how fresh an OpenAPI-produced document is.
+const (
+	// MetaFetchedAt is the RFC 3339 time the underlying data was fetched
+	// from the network (for cached responses, the original fetch).
+	MetaFetchedAt = "fetched_at"
+
+	// MetaTTLSeconds is the TTL the response was cached for in whole
+	// seconds, as chosen from its Cache-Control / Expires headers; "0"
+	// means it was not cached.
+	MetaTTLSeconds = "ttl_seconds"
+)
+
+// fetchedAt reports when the response with headers h was fetched from
+// the network: now for a fresh response, now minus the cache age for a
+// cache hit.
+func (c *Client) fetchedAt(h http.Header) time.Time {
+	var clk clock.Clock
+	if c.cfg != nil {
+		clk = c.cfg.Clock
+	}
+	now := clock.Or(clk).Now()
+	if hit, age := httpclient.CacheStatus(h); hit {
+		return now.Add(-age)
+	}
+	return now
+}
+
+// StampFreshness records fetchedAt and the response's cache TTL (see
+// httpclient.CacheTTL) in meta under MetaFetchedAt and MetaTTLSeconds.
+func (c *Client) StampFreshness(meta map[string]string, fetchedAt time.Time, ttl time.Duration) {
+	if meta == nil || fetchedAt.IsZero() {
+		return
+	}
+	meta[MetaFetchedAt] = fetchedAt.UTC().Format(time.RFC3339)
+	meta[MetaTTLSeconds] = strconv.FormatInt(int64(ttl/time.Second), 10)
+}
@@ -0,0 +1,216 @@
+// internal/openapi/client_test.go
+package openapi
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Nibir1/Aether/internal/cache"
+	"github.com/Nibir1/Aether/internal/clock"
+	"github.com/Nibir1/Aether/internal/config"
+	"github.com/Nibir1/Aether/internal/errors"
+	"github.com/Nibir1/Aether/internal/httpclient"
+	"github.com/Nibir1/Aether/internal/log"
+)
+
+func TestGet_EndpointTimeoutFires(t *testing.T) {
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	cfg := config.Default()
+	cfg.OpenAPITimeouts = map[string]time.Duration{"weather": 50 * time.Millisecond}
+	lg := log.New(false)
+	c := New(cfg, lg, httpclient.New(cfg, lg, nil))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	_, _, err := c.getJSON(ctx, EndpointWeather, srv.URL+"/forecast")
+	if err == nil {
+		t.Fatal("expected timeout error")
+	}
+
+	var aerr *errors.Error
+	if !stderrors.As(err, &aerr) || aerr.Kind != errors.KindTimeout {
+		t.Fatalf("error: got %v, want KindTimeout", err)
+	}
+	if !stderrors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("error %v does not wrap context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Fatalf("endpoint timeout took %s", elapsed)
+	}
+	if ctx.Err() != nil {
+		t.Fatal("caller context should still be live")
+	}
+}
+
+func TestTimeout_DefaultsAndOverride(t *testing.T) {
+	c := New(config.Default(), log.New(false), nil)
+	if got := c.timeout(EndpointWikidata); got != 15*time.Second {
+		t.Fatalf("wikidata default: got %s, want 15s", got)
+	}
+
+	c.cfg.OpenAPITimeouts = map[string]time.Duration{"wikidata": 0}
+	if got := c.timeout(EndpointWikidata); got != 0 {
+		t.Fatalf("override: got %s, want 0", got)
+	}
+}
+
+func TestStampFreshness_FreshAndCached(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":1}`))
+	}))
+	defer srv.Close()
+
+	start := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	clk := clock.NewFake(start)
+
+	cfg := config.Default()
+	cfg.Clock = clk
+	cfg.EnableMemoryCache = true
+	cfg.CacheTTL = time.Minute
+	lg := log.New(false)
+	c := New(cfg, lg, httpclient.New(cfg, lg, cache.NewMemoryWithClock(16, cfg.CacheTTL, clk)))
+
+	stamp := func() map[string]string {
+		_, hdr, err := c.getJSON(context.Background(), EndpointHackerNews, srv.URL+"/item/1.json")
+		if err != nil {
+			t.Fatalf("getJSON error: %v", err)
+		}
+		meta := map[string]string{}
+		c.StampFreshness(meta, c.fetchedAt(hdr), httpclient.CacheTTL(hdr))
+		return meta
+	}
+
+	fresh := stamp()
+	clk.Advance(30 * time.Second)
+	cached := stamp()
+
+	for name, meta := range map[string]map[string]string{"fresh": fresh, "cached": cached} {
+		got, err := time.Parse(time.RFC3339, meta[MetaFetchedAt])
+		if err != nil {
+			t.Fatalf("%s: fetched_at %q is not RFC 3339: %v", name, meta[MetaFetchedAt], err)
+		}
+		if !got.Equal(start) {
+			t.Fatalf("%s: fetched_at: got %s, want %s", name, got, start)
+		}
+		if meta[MetaTTLSeconds] != "60" {
+			t.Fatalf("%s: ttl_seconds: got %q, want %q", name, meta[MetaTTLSeconds], "60")
+		}
+	}
+}
+
+func TestStampFreshness_FollowsCacheControl(t *testing.T) {
+	cases := map[string]string{
+		"no-store":   "0",
+		"max-age=30": "30",
+		"":           "60",
+	}
+	for cc, want := range cases {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/robots.txt" {
+				http.NotFound(w, r)
+				return
+			}
+			if cc != "" {
+				w.Header().Set("Cache-Control", cc)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":1}`))
+		}))
+
+		cfg := config.Default()
+		cfg.EnableMemoryCache = true
+		cfg.CacheTTL = time.Minute
+		lg := log.New(false)
+		c := New(cfg, lg, httpclient.New(cfg, lg, cache.NewMemory(16, cfg.CacheTTL)))
+
+		_, hdr, err := c.getJSON(context.Background(), EndpointHackerNews, srv.URL+"/item/1.json")
+		srv.Close()
+		if err != nil {
+			t.Fatalf("%q: getJSON error: %v", cc, err)
+		}
+		meta := map[string]string{}
+		c.StampFreshness(meta, c.fetchedAt(hdr), httpclient.CacheTTL(hdr))
+		if meta[MetaTTLSeconds] != want {
+			t.Fatalf("%q: ttl_seconds: got %q, want %q", cc, meta[MetaTTLSeconds], want)
+		}
+	}
+}
+
+func TestGet_FallsBackToMirror(t *testing.T) {
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"path":"` + r.URL.RequestURI() + `"}`))
+	}))
+	defer mirror.Close()
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer failing.Close()
+
+	down := httptest.NewServer(http.NotFoundHandler())
+	downURL := down.URL
+	down.Close()
+
+	for name, primary := range map[string]string{"5xx": failing.URL, "unreachable": downURL} {
+		cfg := config.Default()
+		cfg.OpenAPIMirrors = map[string][]string{"wikipedia": {downURL, mirror.URL}}
+		lg := log.New(false)
+		c := New(cfg, lg, httpclient.New(cfg, lg, nil))
+
+		body, _, err := c.getJSON(context.Background(), EndpointWikipedia, primary+"/api/rest_v1/page/summary/Go?x=1")
+		if err != nil {
+			t.Fatalf("%s: getJSON error: %v", name, err)
+		}
+		if got, want := string(body), `{"path":"/api/rest_v1/page/summary/Go?x=1"}`; got != want {
+			t.Fatalf("%s: body: got %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestGet_NoMirrorsReturnsPrimaryError(t *testing.T) {
+	down := httptest.NewServer(http.NotFoundHandler())
+	downURL := down.URL
+	down.Close()
+
+	cfg := config.Default()
+	cfg.OpenAPIMirrors = map[string][]string{"wikipedia": {}}
+	lg := log.New(false)
+	c := New(cfg, lg, httpclient.New(cfg, lg, nil))
+
+	if _, _, err := c.getJSON(context.Background(), EndpointWikipedia, downURL+"/x"); err == nil {
+		t.Fatal("expected error from unreachable primary")
+	}
+}
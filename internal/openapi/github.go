@@ -40,7 +40,7 @@ func (c *Client) GitHubReadme(ctx context.Context, owner, repo, ref string) (*Gi
 	rawURL := fmt.Sprintf("https://raw.githubusercontent.com/%s/%s/%s/README.md",
 		owner, repo, ref)
 
-	body, _, err := c.getText(ctx, rawURL)
+	body, _, err := c.getText(ctx, EndpointGitHub, rawURL)
 	if err != nil {
 		return nil, err
 	}
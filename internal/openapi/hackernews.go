@@ -18,6 +18,7 @@ import (
 	"time"
 
 	"github.com/Nibir1/Aether/internal/errors"
+	"github.com/Nibir1/Aether/internal/httpclient"
 	"github.com/Nibir1/Aether/internal/model"
 )
 
@@ -30,6 +31,12 @@ type HNStory struct {
 	Score        int
 	Time         time.Time
 	CommentCount int
+
+	// FetchedAt is when the item was fetched from the network.
+	FetchedAt time.Time
+
+	// CacheTTL is how long the item was cached for; 0 if it was not.
+	CacheTTL time.Duration
 }
 
 // hnItemResponse matches the HN item JSON structure (subset).
@@ -54,7 +61,7 @@ func (c *Client) HackerNewsTopStories(ctx context.Context, limit int) ([]HNStory
 		limit = 50
 	}
 
-	body, _, err := c.getJSON(ctx, "https://hacker-news.firebaseio.com/v0/topstories.json")
+	body, _, err := c.getJSON(ctx, EndpointHackerNews, "https://hacker-news.firebaseio.com/v0/topstories.json")
 	if err != nil {
 		return nil, errors.New(errors.KindHTTP, "failed to fetch topstories.json", err)
 	}
@@ -130,6 +137,7 @@ func (c *Client) HackerNewsTopStoriesDocuments(ctx context.Context, limit int) (
 				"hn.id":  fmt.Sprintf("%d", s.ID),
 			},
 		}
+		c.StampFreshness(d.Metadata, s.FetchedAt, s.CacheTTL)
 		docs = append(docs, d)
 	}
 
@@ -139,7 +147,7 @@ func (c *Client) HackerNewsTopStoriesDocuments(ctx context.Context, limit int) (
 // hnFetchItem fetches and normalizes a single Hacker News item.
 func (c *Client) hnFetchItem(ctx context.Context, id int64) (*HNStory, error) {
 	endpoint := fmt.Sprintf("https://hacker-news.firebaseio.com/v0/item/%d.json", id)
-	body, _, err := c.getJSON(ctx, endpoint)
+	body, hdr, err := c.getJSON(ctx, EndpointHackerNews, endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -161,6 +169,9 @@ func (c *Client) hnFetchItem(ctx context.Context, id int64) (*HNStory, error) {
 		Author: resp.By,
 		Score:  resp.Score,
 		Time:   time.Unix(resp.Time, 0),
+
+		FetchedAt: c.fetchedAt(hdr),
+		CacheTTL:  httpclient.CacheTTL(hdr),
 	}
 	if len(resp.Kids) > 0 {
 		story.CommentCount = len(resp.Kids)
@@ -0,0 +1,74 @@
+// internal/openapi/mirrors.go
+//
+// Fallback mirrors for OpenAPI endpoints.
+//
+// Some public services answer on more than one host; Wikipedia's REST
+// API, for instance, is served by the mobile host as well. When the
+// primary host is unreachable, times out or answers with a 5xx status,
+// get retries the same path and query against each mirror in order, so
+// a regional outage does not surface to the caller.
+
+package openapi
+
+import (
+	stderrors "errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Nibir1/Aether/internal/errors"
+	"github.com/Nibir1/Aether/internal/httpclient"
+)
+
+// defaultMirrors are the built-in fallback origins per endpoint.
+var defaultMirrors = map[Endpoint][]string{
+	EndpointWikipedia: {"https://en.m.wikipedia.org"},
+}
+
+// mirrors returns the fallback origins for ep: the configured list if
+// present (possibly empty), otherwise the built-in one.
+func (c *Client) mirrors(ep Endpoint) []string {
+	if c.cfg != nil {
+		if m, ok := c.cfg.OpenAPIMirrors[string(ep)]; ok {
+			return m
+		}
+	}
+	return defaultMirrors[ep]
+}
+
+// shouldFailOver reports whether the outcome of a request warrants
+// trying a mirror: a transport failure or timeout, or a 5xx response.
+// robots.txt refusals and offline-mode misses are final.
+func shouldFailOver(resp *httpclient.Response, err error) bool {
+	if err != nil {
+		var aerr *errors.Error
+		if !stderrors.As(err, &aerr) {
+			return true
+		}
+		return aerr.Kind == errors.KindHTTP || aerr.Kind == errors.KindTimeout
+	}
+	return resp != nil && resp.StatusCode >= http.StatusInternalServerError
+}
+
+// mirrorURL rewrites rawURL to the origin given by mirror, keeping the
+// path and query. mirror is either an origin ("https://host:port") or a
+// bare host, which keeps rawURL's scheme.
+func mirrorURL(rawURL, mirror string) (string, bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", false
+	}
+	mirror = strings.TrimRight(strings.TrimSpace(mirror), "/")
+	if mirror == "" {
+		return "", false
+	}
+	if !strings.Contains(mirror, "://") {
+		mirror = u.Scheme + "://" + mirror
+	}
+	m, err := url.Parse(mirror)
+	if err != nil || m.Host == "" {
+		return "", false
+	}
+	u.Scheme, u.Host = m.Scheme, m.Host
+	return u.String(), true
+}
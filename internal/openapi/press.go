@@ -55,7 +55,7 @@ func (c *Client) GovernmentPress(ctx context.Context, limit int) ([]PressRelease
 	out := []PressRelease{}
 
 	for _, feedURL := range GovernmentPressFeeds {
-		body, _, err := c.getText(ctx, feedURL)
+		body, _, err := c.getText(ctx, EndpointPress, feedURL)
 		if err != nil {
 			continue
 		}
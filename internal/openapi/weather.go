@@ -55,7 +55,7 @@ func (c *Client) WeatherAt(ctx context.Context, lat, lon float64, hours int) ([]
 		lat, lon,
 	)
 
-	body, _, err := c.getJSON(ctx, url)
+	body, _, err := c.getJSON(ctx, EndpointWeather, url)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +53,7 @@ func (c *Client) WhiteHouseRecentPosts(ctx context.Context, limit int) ([]WhiteH
 
 	endpoint := fmt.Sprintf("https://www.whitehouse.gov/wp-json/wp/v2/posts?per_page=%d", limit)
 
-	body, _, err := c.getJSON(ctx, endpoint)
+	body, _, err := c.getJSON(ctx, EndpointWhiteHouse, endpoint)
 	if err != nil {
 		return nil, err
 	}
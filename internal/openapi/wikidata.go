@@ -40,7 +40,7 @@ LIMIT 1
 
 	queryURL := "https://query.wikidata.org/sparql?format=json&query=" + url.QueryEscape(sparql)
 
-	body, _, err := c.getJSON(ctx, queryURL)
+	body, _, err := c.getJSON(ctx, EndpointWikidata, queryURL)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +73,7 @@ LIMIT 1
 	// Fetch full entity data
 	entityURL := "https://www.wikidata.org/wiki/Special:EntityData/" + id + ".json"
 
-	raw, _, err := c.getJSON(ctx, entityURL)
+	raw, _, err := c.getJSON(ctx, EndpointWikidata, entityURL)
 	if err != nil {
 		return nil, err
 	}
@@ -13,6 +13,9 @@ import (
 	"encoding/json"
 	"net/url"
 	"strings"
+	"time"
+
+	"github.com/Nibir1/Aether/internal/httpclient"
 )
 
 // WikiSummary is the internal Wikipedia summary representation.
@@ -22,6 +25,17 @@ type WikiSummary struct {
 	Extract     string
 	URL         string
 	Language    string
+
+	// FromCache and CacheAge describe whether the summary was served
+	// from Aether's cache and how old that entry is.
+	FromCache bool
+	CacheAge  time.Duration
+
+	// FetchedAt is when the summary was fetched from the network.
+	FetchedAt time.Time
+
+	// CacheTTL is how long the summary was cached for; 0 if it was not.
+	CacheTTL time.Duration
 }
 
 // wikipediaSummaryResponse models the subset of the Wikipedia REST
@@ -51,7 +65,7 @@ func (c *Client) WikipediaSummary(ctx context.Context, title string) (*WikiSumma
 	escaped := url.PathEscape(title)
 	endpoint := "https://en.wikipedia.org/api/rest_v1/page/summary/" + escaped
 
-	body, _, err := c.getJSON(ctx, endpoint)
+	body, hdr, err := c.getJSON(ctx, EndpointWikipedia, endpoint)
 	if err != nil {
 		return nil, err
 	}
@@ -68,5 +82,8 @@ func (c *Client) WikipediaSummary(ctx context.Context, title string) (*WikiSumma
 		URL:         resp.ContentURL.Desktop.Page,
 		Language:    resp.Lang,
 	}
+	out.FromCache, out.CacheAge = httpclient.CacheStatus(hdr)
+	out.FetchedAt = c.fetchedAt(hdr)
+	out.CacheTTL = httpclient.CacheTTL(hdr)
 	return out, nil
 }
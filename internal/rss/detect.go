@@ -11,7 +11,10 @@ package rss
 
 import (
 	"bytes"
+	"encoding/xml"
+	"io"
 	"strings"
+	"unicode/utf16"
 )
 
 type FeedType string
@@ -21,17 +24,40 @@ const (
 	FeedRSS2    FeedType = "rss2"
 	FeedRSS1    FeedType = "rss1" // RDF-based
 	FeedAtom    FeedType = "atom"
+	FeedJSON    FeedType = "json" // JSON Feed 1.0 / 1.1
 )
 
 // DetectFeedType performs a lightweight sniff-test on raw bytes to
-// determine the likely feed format (RSS 2.0, RSS 1.0, or Atom).
+// determine the likely feed format (RSS 2.0, RSS 1.0, Atom or JSON Feed).
 //
-// This does *not* perform any XML unmarshalling — it is strictly a
-// string-based prefix and tag-name check.
+// A JSON object whose opening bytes mention the jsonfeed.org version URL
+// is a JSON Feed; anything else is treated as XML.
+//
+// A leading byte-order mark is removed and the root element is read with
+// an XML tokenizer, so the declaration, processing instructions, comments
+// and DOCTYPE are skipped and namespaced roots such as <rss:rss> or
+// <atom:feed xmlns:atom="..."> are recognized by their local name. When
+// no root element can be identified, a substring heuristic over the
+// first 1024 bytes is used.
+//
+// This does *not* perform any XML unmarshalling.
 func DetectFeedType(data []byte) FeedType {
+	data = stripBOM(data)
 	if len(data) == 0 {
 		return FeedUnknown
 	}
+	if isJSONFeed(data) {
+		return FeedJSON
+	}
+
+	switch rootElement(data) {
+	case "feed":
+		return FeedAtom
+	case "rss":
+		return FeedRSS2
+	case "rdf":
+		return FeedRSS1
+	}
 
 	// Limit inspect region to avoid scanning large feeds.
 	inspect := strings.ToLower(string(bytes.TrimSpace(data[:min(len(data), 1024)])))
@@ -59,6 +85,58 @@ func DetectFeedType(data []byte) FeedType {
 	return FeedUnknown
 }
 
+// stripBOM removes a leading byte-order mark. UTF-16 input (detected by
+// its BOM) is transcoded to UTF-8 so the rest of the package only ever
+// deals with UTF-8 bytes.
+func stripBOM(data []byte) []byte {
+	switch {
+	case bytes.HasPrefix(data, []byte{0xEF, 0xBB, 0xBF}):
+		return data[3:]
+	case bytes.HasPrefix(data, []byte{0xFE, 0xFF}):
+		return utf16ToUTF8(data[2:], true)
+	case bytes.HasPrefix(data, []byte{0xFF, 0xFE}):
+		return utf16ToUTF8(data[2:], false)
+	}
+	return data
+}
+
+// utf16ToUTF8 decodes UTF-16 code units (big- or little-endian) to UTF-8.
+// A trailing odd byte is ignored.
+func utf16ToUTF8(b []byte, bigEndian bool) []byte {
+	units := make([]uint16, 0, len(b)/2)
+	for i := 0; i+1 < len(b); i += 2 {
+		if bigEndian {
+			units = append(units, uint16(b[i])<<8|uint16(b[i+1]))
+		} else {
+			units = append(units, uint16(b[i+1])<<8|uint16(b[i]))
+		}
+	}
+	return []byte(string(utf16.Decode(units)))
+}
+
+// rootElement returns the lowercased local name of the document's root
+// element. An xml.Decoder reads up to the first start tag, so the XML
+// declaration, processing instructions, comments and DOCTYPE are skipped
+// and a namespace prefix ("<rss:rss>", "<atom:feed>") is not part of the
+// name. It returns "" when no start tag can be read.
+func rootElement(data []byte) string {
+	d := xml.NewDecoder(bytes.NewReader(data))
+	d.Strict = false
+	// Only the root tag matters here; accept any declared encoding
+	// rather than failing on labels the decoder does not know.
+	d.CharsetReader = func(_ string, r io.Reader) (io.Reader, error) { return r, nil }
+
+	for {
+		tok, err := d.RawToken()
+		if err != nil {
+			return ""
+		}
+		if se, ok := tok.(xml.StartElement); ok {
+			return strings.ToLower(se.Name.Local)
+		}
+	}
+}
+
 // min returns the smaller of two ints.
 func min(a, b int) int {
 	if a < b {
@@ -0,0 +1,118 @@
+// internal/rss/detect_test.go
+package rss
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf16"
+)
+
+const rssBody = `<rss version="2.0"><channel><title>Example</title>` +
+	`<item><title>First</title><link>https://example.com/1</link></item>` +
+	`</channel></rss>`
+
+func TestParse_UTF8BOM(t *testing.T) {
+	data := append([]byte{0xEF, 0xBB, 0xBF}, []byte(`<?xml version="1.0"?>`+rssBody)...)
+
+	if got := DetectFeedType(data); got != FeedRSS2 {
+		t.Fatalf("DetectFeedType: got %q, want %q", got, FeedRSS2)
+	}
+	f, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	if f.Title != "Example" || len(f.Items) != 1 {
+		t.Fatalf("got title %q with %d items, want %q with 1", f.Title, len(f.Items), "Example")
+	}
+}
+
+func TestParse_UTF16BOM(t *testing.T) {
+	src := `<?xml version="1.0" encoding="UTF-16"?>` + rssBody
+	units := utf16.Encode([]rune(src))
+	data := []byte{0xFF, 0xFE}
+	for _, u := range units {
+		data = append(data, byte(u), byte(u>>8))
+	}
+
+	f, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	if f.Title != "Example" {
+		t.Fatalf("Title: got %q, want %q", f.Title, "Example")
+	}
+}
+
+func TestParse_LongDeclarationAndComment(t *testing.T) {
+	decl := `<?xml version="1.0" encoding="utf-8" standalone="yes"?>` +
+		`<?xml-stylesheet type="text/xsl" href="https://example.com/very/long/path/to/a/feed/stylesheet.xsl"?>`
+	comment := "<!-- generated by a static site generator; " + strings.Repeat("padding ", 40) + "-->"
+	atom := `<feed xmlns="http://www.w3.org/2005/Atom"><title>Atom Example</title>` +
+		`<entry><title>Entry</title><id>urn:1</id></entry></feed>`
+	data := []byte(decl + "\n" + comment + "\n" + atom)
+
+	if got := DetectFeedType(data); got != FeedAtom {
+		t.Fatalf("DetectFeedType: got %q, want %q", got, FeedAtom)
+	}
+	f, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	if f.Title != "Atom Example" || len(f.Items) != 1 {
+		t.Fatalf("got title %q with %d items", f.Title, len(f.Items))
+	}
+}
+
+func TestParse_ShortInputDoesNotPanic(t *testing.T) {
+	if _, err := Parse([]byte("<x/>")); err == nil {
+		t.Fatal("expected error for non-feed input")
+	}
+	if _, err := Parse(nil); err == nil {
+		t.Fatal("expected error for empty input")
+	}
+}
+
+func TestDetectFeedType_RDFRoot(t *testing.T) {
+	data := []byte(`<!-- c --><rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#"></rdf:RDF>`)
+	if got := DetectFeedType(data); got != FeedRSS1 {
+		t.Fatalf("got %q, want %q", got, FeedRSS1)
+	}
+}
+
+func TestDetectFeedType_NamespacedRSSRoot(t *testing.T) {
+	data := []byte(`<?xml version="1.0"?>` +
+		`<rss:rss xmlns:rss="http://backend.userland.com/rss2" version="2.0">` +
+		`<rss:channel><rss:title>Prefixed</rss:title>` +
+		`<rss:item><rss:title>First</rss:title><rss:link>https://example.com/1</rss:link></rss:item>` +
+		`</rss:channel></rss:rss>`)
+
+	if got := DetectFeedType(data); got != FeedRSS2 {
+		t.Fatalf("DetectFeedType: got %q, want %q", got, FeedRSS2)
+	}
+	f, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	if f.Title != "Prefixed" || len(f.Items) != 1 || f.Items[0].Link != "https://example.com/1" {
+		t.Fatalf("got title %q with items %+v", f.Title, f.Items)
+	}
+}
+
+func TestDetectFeedType_NamespacedAtomRoot(t *testing.T) {
+	data := []byte(`<?xml version="1.0"?>` +
+		`<!DOCTYPE feed>` +
+		`<a:feed xmlns:a="http://www.w3.org/2005/Atom">` +
+		`<a:title>Namespaced Atom</a:title>` +
+		`<a:entry><a:title>Entry</a:title><a:id>urn:1</a:id></a:entry></a:feed>`)
+
+	if got := DetectFeedType(data); got != FeedAtom {
+		t.Fatalf("DetectFeedType: got %q, want %q", got, FeedAtom)
+	}
+	f, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	if f.Title != "Namespaced Atom" || len(f.Items) != 1 {
+		t.Fatalf("got title %q with %d items", f.Title, len(f.Items))
+	}
+}
@@ -0,0 +1,137 @@
+// internal/rss/jsonfeed.go
+//
+// JSON Feed (https://jsonfeed.org) support. JSON Feed 1.0 and 1.1
+// documents are mapped onto the same Feed/Item structure as RSS and
+// Atom, so callers never need to know which syntax a feed used.
+//
+// Detection looks for the jsonfeed.org version URL near the start of a
+// JSON object; a generic JSON document is not treated as a feed.
+
+package rss
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// jsonFeedVersionURL prefixes the mandatory "version" value of every
+// JSON Feed ("https://jsonfeed.org/version/1.1").
+const jsonFeedVersionURL = "jsonfeed.org/version/"
+
+type jsonFeed struct {
+	Version     string           `json:"version"`
+	Title       string           `json:"title"`
+	HomePageURL string           `json:"home_page_url"`
+	Description string           `json:"description"`
+	Authors     []jsonFeedAuthor `json:"authors"`
+	Author      *jsonFeedAuthor  `json:"author"` // JSON Feed 1.0
+	Items       []jsonFeedItem   `json:"items"`
+}
+
+type jsonFeedItem struct {
+	ID            json.RawMessage  `json:"id"`
+	URL           string           `json:"url"`
+	ExternalURL   string           `json:"external_url"`
+	Title         string           `json:"title"`
+	ContentHTML   string           `json:"content_html"`
+	ContentText   string           `json:"content_text"`
+	Summary       string           `json:"summary"`
+	DatePublished string           `json:"date_published"`
+	DateModified  string           `json:"date_modified"`
+	Authors       []jsonFeedAuthor `json:"authors"`
+	Author        *jsonFeedAuthor  `json:"author"` // JSON Feed 1.0
+}
+
+type jsonFeedAuthor struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// isJSONFeed reports whether data, already stripped of any BOM, looks
+// like a JSON Feed document.
+func isJSONFeed(data []byte) bool {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] != '{' {
+		return false
+	}
+	inspect := strings.ToLower(string(data[:min(len(data), 1024)]))
+	return strings.Contains(inspect, jsonFeedVersionURL)
+}
+
+func parseJSONFeed(data []byte) (*Feed, error) {
+	var j jsonFeed
+	if err := json.Unmarshal(data, &j); err != nil {
+		return nil, err
+	}
+
+	f := &Feed{
+		Title:       j.Title,
+		Description: j.Description,
+		Link:        j.HomePageURL,
+	}
+
+	// Feed-level authors apply to items that name none of their own.
+	feedAuthors := jsonFeedAuthors(j.Authors, j.Author)
+	for _, it := range j.Items {
+		item := it.toItem()
+		if len(item.Authors) == 0 && len(feedAuthors) > 0 {
+			item.Authors = feedAuthors
+			item.Author = feedAuthors[0].Name
+		}
+		f.Items = append(f.Items, item)
+		if item.Updated.After(f.Updated) {
+			f.Updated = item.Updated
+		} else if item.Published.After(f.Updated) {
+			f.Updated = item.Published
+		}
+	}
+
+	return f, nil
+}
+
+func (it jsonFeedItem) toItem() Item {
+	authors := jsonFeedAuthors(it.Authors, it.Author)
+	author := ""
+	if len(authors) > 0 {
+		author = authors[0].Name
+	}
+	return Item{
+		Title:       it.Title,
+		Link:        firstNonEmpty(it.URL, it.ExternalURL),
+		Description: firstNonEmpty(it.Summary, it.ContentText),
+		Content:     firstNonEmpty(it.ContentHTML, it.ContentText, it.Summary),
+		Author:      author,
+		Authors:     authors,
+		Published:   parseTime(it.DatePublished),
+		Updated:     parseTime(it.DateModified),
+		GUID:        jsonFeedID(it.ID),
+	}
+}
+
+// jsonFeedAuthors merges the 1.1 "authors" array with the 1.0 "author"
+// object, skipping entries without a name or URL.
+func jsonFeedAuthors(list []jsonFeedAuthor, single *jsonFeedAuthor) []Author {
+	if single != nil {
+		list = append(list, *single)
+	}
+	var out []Author
+	for _, a := range list {
+		name, uri := strings.TrimSpace(a.Name), strings.TrimSpace(a.URL)
+		if name == "" && uri == "" {
+			continue
+		}
+		out = append(out, Author{Name: name, URI: uri})
+	}
+	return out
+}
+
+// jsonFeedID returns an item id as a string. The spec requires a string
+// but numeric ids are common in the wild.
+func jsonFeedID(raw json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	return strings.TrimSpace(string(raw))
+}
@@ -2,13 +2,15 @@
 //
 // Robust XML-based parsing for RSS 2.0, RSS 1.0, and Atom feeds.
 // Aether sniff-detects the feed type and normalizes them into a
-// unified Feed struct.
+// unified Feed struct. JSON Feed documents are handled in jsonfeed.go.
 
 package rss
 
 import (
 	"bytes"
 	"encoding/xml"
+	"fmt"
+	"io"
 	"strings"
 	"time"
 )
@@ -16,26 +18,32 @@ import (
 // --- Atom Structures ---
 
 type atomFeed struct {
-	XMLName xml.Name `xml:"feed"`
-	Title   string   `xml:"title"`
-	Updated string   `xml:"updated"`
-	Link    []struct {
-		Href string `xml:"href,attr"`
-	} `xml:"link"`
-	Entries []struct {
-		Title     string `xml:"title"`
-		Summary   string `xml:"summary"`
-		Content   string `xml:"content"`
-		ID        string `xml:"id"`
-		Updated   string `xml:"updated"`
-		Published string `xml:"published"`
-		Author    struct {
-			Name string `xml:"name"`
-		} `xml:"author"`
-		Links []struct {
-			Href string `xml:"href,attr"`
-		} `xml:"link"`
-	} `xml:"entry"`
+	XMLName xml.Name    `xml:"feed"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Link    []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+}
+
+type atomEntry struct {
+	Title     string       `xml:"title"`
+	Summary   string       `xml:"summary"`
+	Content   string       `xml:"content"`
+	ID        string       `xml:"id"`
+	Updated   string       `xml:"updated"`
+	Published string       `xml:"published"`
+	Authors   []atomPerson `xml:"author"`
+	Links     []atomLink   `xml:"link"`
+}
+
+type atomPerson struct {
+	Name  string `xml:"name"`
+	Email string `xml:"email"`
+	URI   string `xml:"uri"`
 }
 
 // --- RSS 2.0 Structures ---
@@ -43,23 +51,26 @@ type atomFeed struct {
 type rss2Feed struct {
 	XMLName xml.Name `xml:"rss"`
 	Channel struct {
-		Title       string `xml:"title"`
-		Description string `xml:"description"`
-		Link        string `xml:"link"`
-		PubDate     string `xml:"pubDate"`
-		LastBuild   string `xml:"lastBuildDate"`
-		Items       []struct {
-			Title       string `xml:"title"`
-			Link        string `xml:"link"`
-			Description string `xml:"description"`
-			Content     string `xml:"encoded"`
-			Author      string `xml:"author"`
-			PubDate     string `xml:"pubDate"`
-			GUID        string `xml:"guid"`
-		} `xml:"item"`
+		Title       string     `xml:"title"`
+		Description string     `xml:"description"`
+		Link        string     `xml:"link"`
+		PubDate     string     `xml:"pubDate"`
+		LastBuild   string     `xml:"lastBuildDate"`
+		Items       []rss2Item `xml:"item"`
 	} `xml:"channel"`
 }
 
+type rss2Item struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	Description string   `xml:"description"`
+	Content     string   `xml:"encoded"`
+	Author      string   `xml:"author"`
+	Creators    []string `xml:"creator"`
+	PubDate     string   `xml:"pubDate"`
+	GUID        string   `xml:"guid"`
+}
+
 // --- RSS 1.0 / RDF Structures ---
 
 type rss1Feed struct {
@@ -69,55 +80,98 @@ type rss1Feed struct {
 		Description string `xml:"description"`
 		Link        string `xml:"link"`
 	} `xml:"channel"`
-	Items []struct {
-		Title       string `xml:"title"`
-		Link        string `xml:"link"`
-		Description string `xml:"description"`
-	} `xml:"item"`
+	Items []rss1Item `xml:"item"`
+}
+
+type rss1Item struct {
+	Title       string   `xml:"title"`
+	Link        string   `xml:"link"`
+	Description string   `xml:"description"`
+	Creators    []string `xml:"creator"`
 }
 
-// Parse parses raw XML into a unified Feed structure.
+// Parse parses a raw RSS, Atom or JSON Feed document into a unified
+// Feed structure.
+//
+// Gzip-compressed input is decompressed first, a leading BOM is removed
+// (UTF-16 is transcoded to UTF-8) and the feed type is identified from
+// the root element via DetectFeedType.
 func Parse(data []byte) (*Feed, error) {
-	trim := bytes.TrimSpace(data)
-	lower := strings.ToLower(string(trim[:64]))
-
-	switch {
-	case strings.Contains(lower, "<feed"):
-		return parseAtom(data)
-	case strings.Contains(lower, "<rss"):
-		return parseRSS2(data)
-	case strings.Contains(lower, "<rdf"):
-		return parseRSS1(data)
-	default:
-		// Try RSS2 fallback
-		if f, err := parseRSS2(data); err == nil {
-			return f, nil
-		}
-		// Try Atom fallback
-		if f, err := parseAtom(data); err == nil {
+	data, err := Decompress(data)
+	if err != nil {
+		return nil, err
+	}
+	data = stripBOM(data)
+
+	switch ft := DetectFeedType(data); ft {
+	case FeedAtom:
+		return wrapParse(ft, parseAtom, data)
+	case FeedRSS2:
+		return wrapParse(ft, parseRSS2, data)
+	case FeedRSS1:
+		return wrapParse(ft, parseRSS1, data)
+	case FeedJSON:
+		return wrapParse(ft, parseJSONFeed, data)
+	}
+
+	// Unknown root element: try each format, remembering why the first
+	// attempt failed so the caller learns more than "unknown format".
+	var firstErr error
+	for _, parse := range []func([]byte) (*Feed, error){parseRSS2, parseAtom, parseRSS1} {
+		f, err := parse(data)
+		if err == nil {
 			return f, nil
 		}
-		// Try RSS1 fallback
-		if f, err := parseRSS1(data); err == nil {
-			return f, nil
+		if firstErr == nil {
+			firstErr = err
 		}
 	}
 
-	return nil, ErrUnknownFeed
+	return nil, &FeedError{Msg: ErrUnknownFeed.Msg, Err: firstErr}
 }
 
-var ErrUnknownFeed = &FeedError{"unknown or unsupported RSS/Atom format"}
+// wrapParse runs parse and reports a failure as a malformed feed of the
+// detected type, wrapping the underlying XML error.
+func wrapParse(ft FeedType, parse func([]byte) (*Feed, error), data []byte) (*Feed, error) {
+	f, err := parse(data)
+	if err != nil {
+		return nil, &FeedError{Msg: "malformed " + string(ft) + " feed", Err: err}
+	}
+	return f, nil
+}
 
-// FeedError is an implementation of error for feed parsing.
+// ErrUnknownFeed reports input that is not recognizable as RSS or Atom.
+// Errors returned by Parse for such input match it via errors.Is and
+// wrap the XML error from the first parse attempt, if any.
+var ErrUnknownFeed = &FeedError{Msg: "unknown or unsupported RSS/Atom format"}
+
+// FeedError is an implementation of error for feed parsing. Err, when
+// set, is the underlying cause (typically an *xml.SyntaxError).
 type FeedError struct {
 	Msg string
+	Err error
 }
 
-func (e *FeedError) Error() string { return e.Msg }
+func (e *FeedError) Error() string {
+	if e.Err != nil {
+		return e.Msg + ": " + e.Err.Error()
+	}
+	return e.Msg
+}
+
+// Unwrap returns the underlying cause.
+func (e *FeedError) Unwrap() error { return e.Err }
+
+// Is reports whether target is a FeedError with the same message, so
+// that wrapped variants of ErrUnknownFeed still match it.
+func (e *FeedError) Is(target error) bool {
+	t, ok := target.(*FeedError)
+	return ok && t.Msg == e.Msg
+}
 
 func parseAtom(data []byte) (*Feed, error) {
 	var a atomFeed
-	if err := xml.Unmarshal(data, &a); err != nil {
+	if err := unmarshalXML(data, &a); err != nil {
 		return nil, err
 	}
 
@@ -131,28 +185,50 @@ func parseAtom(data []byte) (*Feed, error) {
 	}
 
 	for _, e := range a.Entries {
-		link := ""
-		if len(e.Links) > 0 {
-			link = e.Links[0].Href
-		}
-		f.Items = append(f.Items, Item{
-			Title:       e.Title,
-			Link:        link,
-			Description: e.Summary,
-			Content:     e.Content,
-			Author:      e.Author.Name,
-			Published:   parseTime(e.Published),
-			Updated:     parseTime(e.Updated),
-			GUID:        e.ID,
-		})
+		f.Items = append(f.Items, e.toItem())
 	}
 
 	return f, nil
 }
 
+func (e atomEntry) toItem() Item {
+	link := ""
+	if len(e.Links) > 0 {
+		link = e.Links[0].Href
+	}
+
+	var authors []Author
+	for _, p := range e.Authors {
+		a := Author{
+			Name:  strings.TrimSpace(p.Name),
+			Email: strings.TrimSpace(p.Email),
+			URI:   strings.TrimSpace(p.URI),
+		}
+		if a != (Author{}) {
+			authors = append(authors, a)
+		}
+	}
+	first := ""
+	if len(authors) > 0 {
+		first = authors[0].Name
+	}
+
+	return Item{
+		Title:       e.Title,
+		Link:        link,
+		Description: e.Summary,
+		Content:     e.Content,
+		Author:      first,
+		Authors:     authors,
+		Published:   parseTime(e.Published),
+		Updated:     parseTime(e.Updated),
+		GUID:        e.ID,
+	}
+}
+
 func parseRSS2(data []byte) (*Feed, error) {
 	var r rss2Feed
-	if err := xml.Unmarshal(data, &r); err != nil {
+	if err := unmarshalXML(data, &r); err != nil {
 		return nil, err
 	}
 
@@ -165,27 +241,32 @@ func parseRSS2(data []byte) (*Feed, error) {
 	}
 
 	for _, it := range c.Items {
-		content := it.Content
-		if content == "" {
-			content = it.Description
-		}
-		f.Items = append(f.Items, Item{
-			Title:       it.Title,
-			Link:        it.Link,
-			Description: it.Description,
-			Content:     content,
-			Author:      it.Author,
-			Published:   parseTime(it.PubDate),
-			GUID:        it.GUID,
-		})
+		f.Items = append(f.Items, it.toItem())
 	}
 
 	return f, nil
 }
 
+func (it rss2Item) toItem() Item {
+	content := it.Content
+	if content == "" {
+		content = it.Description
+	}
+	return Item{
+		Title:       it.Title,
+		Link:        it.Link,
+		Description: it.Description,
+		Content:     content,
+		Author:      firstNonEmpty(it.Author, firstOf(it.Creators)),
+		Authors:     rssAuthors(it.Author, it.Creators),
+		Published:   parseTime(it.PubDate),
+		GUID:        it.GUID,
+	}
+}
+
 func parseRSS1(data []byte) (*Feed, error) {
 	var r rss1Feed
-	if err := xml.Unmarshal(data, &r); err != nil {
+	if err := unmarshalXML(data, &r); err != nil {
 		return nil, err
 	}
 
@@ -196,16 +277,90 @@ func parseRSS1(data []byte) (*Feed, error) {
 	}
 
 	for _, it := range r.Items {
-		f.Items = append(f.Items, Item{
-			Title:       it.Title,
-			Link:        it.Link,
-			Description: it.Description,
-		})
+		f.Items = append(f.Items, it.toItem())
 	}
 
 	return f, nil
 }
 
+func (it rss1Item) toItem() Item {
+	return Item{
+		Title:       it.Title,
+		Link:        it.Link,
+		Description: it.Description,
+		Author:      firstOf(it.Creators),
+		Authors:     rssAuthors("", it.Creators),
+	}
+}
+
+// rssAuthors builds the author list from an RSS <author> value, which
+// is conventionally "email (Name)", and any <dc:creator> names.
+func rssAuthors(author string, creators []string) []Author {
+	var out []Author
+	if author = strings.TrimSpace(author); author != "" {
+		out = append(out, parseRSSAuthor(author))
+	}
+	for _, c := range creators {
+		if c = strings.TrimSpace(c); c != "" {
+			out = append(out, Author{Name: c})
+		}
+	}
+	return out
+}
+
+// parseRSSAuthor splits "jane@example.com (Jane Doe)" into name and
+// email. Values without an email are treated as a plain name.
+func parseRSSAuthor(s string) Author {
+	if open := strings.Index(s, "("); open > 0 && strings.HasSuffix(s, ")") {
+		email := strings.TrimSpace(s[:open])
+		name := strings.TrimSpace(s[open+1 : len(s)-1])
+		if strings.Contains(email, "@") {
+			return Author{Name: name, Email: email}
+		}
+	}
+	if strings.Contains(s, "@") && !strings.Contains(s, " ") {
+		return Author{Email: s}
+	}
+	return Author{Name: s}
+}
+
+func firstOf(list []string) string {
+	for _, s := range list {
+		if s = strings.TrimSpace(s); s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// unmarshalXML decodes data into v. Input has already been normalized to
+// UTF-8 by stripBOM, so a declared UTF-16 encoding is accepted as-is.
+func unmarshalXML(data []byte, v any) error {
+	return newDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// newDecoder returns an xml.Decoder over UTF-8 input that tolerates a
+// declared UTF-16 encoding (the bytes were transcoded by stripBOM).
+func newDecoder(r io.Reader) *xml.Decoder {
+	dec := xml.NewDecoder(r)
+	dec.CharsetReader = func(charset string, input io.Reader) (io.Reader, error) {
+		if strings.HasPrefix(strings.ToLower(charset), "utf-16") {
+			return input, nil
+		}
+		return nil, fmt.Errorf("rss: unsupported charset %q", charset)
+	}
+	return dec
+}
+
 func parseTime(s string) time.Time {
 	t, _ := time.Parse(time.RFC1123Z, s)
 	if !t.IsZero() {
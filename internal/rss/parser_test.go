@@ -0,0 +1,150 @@
+// internal/rss/parser_test.go
+package rss
+
+import (
+	"encoding/xml"
+	"errors"
+	"testing"
+)
+
+func TestParse_AtomMultipleAuthors(t *testing.T) {
+	data := []byte(`<?xml version="1.0"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>Team blog</title>
+  <entry>
+    <title>Joint post</title>
+    <id>urn:1</id>
+    <author><name>Ada Lovelace</name><email>ada@example.com</email></author>
+    <author><name>Charles Babbage</name><uri>https://example.com/cb</uri></author>
+  </entry>
+</feed>`)
+
+	f, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	it := f.Items[0]
+
+	if it.Author != "Ada Lovelace" {
+		t.Fatalf("Author: got %q, want %q", it.Author, "Ada Lovelace")
+	}
+	want := []Author{
+		{Name: "Ada Lovelace", Email: "ada@example.com"},
+		{Name: "Charles Babbage", URI: "https://example.com/cb"},
+	}
+	if len(it.Authors) != len(want) {
+		t.Fatalf("Authors: got %+v, want %+v", it.Authors, want)
+	}
+	for i := range want {
+		if it.Authors[i] != want[i] {
+			t.Fatalf("Authors[%d]: got %+v, want %+v", i, it.Authors[i], want[i])
+		}
+	}
+}
+
+func TestParse_AtomFirstAuthorIsTrimmedAndNonEmpty(t *testing.T) {
+	data := []byte(`<?xml version="1.0"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>Team blog</title>
+  <entry>
+    <title>Joint post</title>
+    <id>urn:1</id>
+    <author><name>  </name></author>
+    <author><name>
+      Ada Lovelace
+    </name></author>
+  </entry>
+</feed>`)
+
+	f, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	if got := f.Items[0].Author; got != "Ada Lovelace" {
+		t.Fatalf("Author: got %q, want %q", got, "Ada Lovelace")
+	}
+}
+
+func TestParse_RSSAuthorAndCreators(t *testing.T) {
+	data := []byte(`<rss version="2.0" xmlns:dc="http://purl.org/dc/elements/1.1/"><channel>
+<item><title>A</title><author>jane@example.com (Jane Doe)</author><dc:creator>John Roe</dc:creator></item>
+</channel></rss>`)
+
+	f, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	it := f.Items[0]
+
+	if it.Author != "jane@example.com (Jane Doe)" {
+		t.Fatalf("Author: got %q", it.Author)
+	}
+	if len(it.Authors) != 2 ||
+		it.Authors[0] != (Author{Name: "Jane Doe", Email: "jane@example.com"}) ||
+		it.Authors[1] != (Author{Name: "John Roe"}) {
+		t.Fatalf("Authors: got %+v", it.Authors)
+	}
+}
+
+func TestParse_WrapsXMLErrors(t *testing.T) {
+	_, err := Parse([]byte(`<feed xmlns="http://www.w3.org/2005/Atom"><title>x</feed>`))
+	var syntax *xml.SyntaxError
+	if !errors.As(err, &syntax) {
+		t.Fatalf("malformed Atom: got %v, want wrapped *xml.SyntaxError", err)
+	}
+
+	_, err = Parse([]byte(`<html><body>not a feed`))
+	if !errors.Is(err, ErrUnknownFeed) {
+		t.Fatalf("unknown format: got %v, want ErrUnknownFeed", err)
+	}
+	if errors.Unwrap(err) == nil {
+		t.Fatalf("unknown format: underlying error not wrapped: %v", err)
+	}
+}
+
+func TestParse_JSONFeed(t *testing.T) {
+	data := []byte(`{
+  "version": "https://jsonfeed.org/version/1.1",
+  "title": "JSON blog",
+  "home_page_url": "https://example.com/",
+  "authors": [{"name": "Site Author"}],
+  "items": [
+    {"id": "1", "url": "https://example.com/1", "title": "First",
+     "content_html": "<p>Hello</p>", "date_published": "2024-05-01T10:00:00Z"},
+    {"id": 2, "external_url": "https://other.example/2", "title": "Second",
+     "content_text": "Plain", "authors": [{"name": "Guest", "url": "https://guest.example"}]}
+  ]
+}`)
+
+	if ft := DetectFeedType(data); ft != FeedJSON {
+		t.Fatalf("DetectFeedType: got %q, want %q", ft, FeedJSON)
+	}
+
+	f, err := Parse(data)
+	if err != nil {
+		t.Fatalf("Parse error: %v", err)
+	}
+	if f.Title != "JSON blog" || f.Link != "https://example.com/" || len(f.Items) != 2 {
+		t.Fatalf("feed: %+v", f)
+	}
+
+	first, second := f.Items[0], f.Items[1]
+	if first.Content != "<p>Hello</p>" || first.Author != "Site Author" || first.Published.IsZero() {
+		t.Fatalf("first item: %+v", first)
+	}
+	if second.Link != "https://other.example/2" || second.GUID != "2" || second.Description != "Plain" {
+		t.Fatalf("second item: %+v", second)
+	}
+	if len(second.Authors) != 1 || second.Authors[0] != (Author{Name: "Guest", URI: "https://guest.example"}) {
+		t.Fatalf("second authors: %+v", second.Authors)
+	}
+	if !f.Updated.Equal(first.Published) {
+		t.Fatalf("Updated: got %v, want %v", f.Updated, first.Published)
+	}
+}
+
+func TestDetectFeedType_PlainJSONIsNotAFeed(t *testing.T) {
+	if ft := DetectFeedType([]byte(`{"items": []}`)); ft != FeedUnknown {
+		t.Fatalf("got %q, want %q", ft, FeedUnknown)
+	}
+}
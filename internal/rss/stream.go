@@ -0,0 +1,206 @@
+// internal/rss/stream.go
+//
+// Streaming (SAX-style) feed parsing for very large feeds.
+//
+// Parse unmarshals an entire feed into memory, which is wasteful for
+// feeds carrying thousands of items. ParseStream instead walks the XML
+// token stream with encoding/xml.Decoder, decodes one item at a time and
+// hands it to a callback, so memory use stays proportional to a single
+// item rather than the whole document.
+//
+// RSS 2.0, RSS 1.0 (RDF) and Atom are supported. A UTF-8 BOM is skipped;
+// UTF-16 input is not supported in streaming mode (use Parse instead).
+
+package rss
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+// FeedHeader holds the channel-level fields of a streamed feed.
+//
+// Header fields that appear after the first item in the document are
+// still recorded, so the header is only complete once ParseStream returns.
+type FeedHeader struct {
+	Type        FeedType
+	Title       string
+	Description string
+	Link        string
+	Updated     time.Time
+}
+
+// ParseStream parses a feed from r, calling onItem for every item in
+// document order. Items are decoded one at a time; the document is never
+// buffered in full.
+//
+// If onItem returns an error, parsing stops immediately and that error is
+// returned together with the header fields collected so far.
+func ParseStream(r io.Reader, onItem func(Item) error) (*FeedHeader, error) {
+	br, err := decompressReader(bufio.NewReader(r))
+	if err != nil {
+		return nil, err
+	}
+	if bom, err := br.Peek(3); err == nil && bytes.Equal(bom, []byte{0xEF, 0xBB, 0xBF}) {
+		br.Discard(3)
+	}
+
+	dec := newDecoder(br)
+	hdr := &FeedHeader{Type: FeedUnknown}
+
+	// stack holds the local names of currently open container elements.
+	var stack []string
+
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			if hdr.Type == FeedUnknown {
+				return hdr, ErrUnknownFeed
+			}
+			return hdr, nil
+		}
+		if err != nil {
+			return hdr, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			name := t.Name.Local
+
+			// Root element decides the feed type.
+			if len(stack) == 0 {
+				switch name {
+				case "rss":
+					hdr.Type = FeedRSS2
+				case "feed":
+					hdr.Type = FeedAtom
+				case "RDF":
+					hdr.Type = FeedRSS1
+				default:
+					return hdr, ErrUnknownFeed
+				}
+				stack = append(stack, name)
+				continue
+			}
+
+			if isStreamItem(hdr.Type, name) {
+				item, err := decodeStreamItem(dec, &t, hdr.Type)
+				if err != nil {
+					return hdr, err
+				}
+				if err := onItem(item); err != nil {
+					return hdr, err
+				}
+				continue
+			}
+
+			if isHeaderContainer(hdr.Type, stack) {
+				handled, err := decodeHeaderField(dec, &t, hdr)
+				if err != nil {
+					return hdr, err
+				}
+				if handled {
+					continue
+				}
+			}
+
+			stack = append(stack, name)
+
+		case xml.EndElement:
+			if len(stack) > 0 {
+				stack = stack[:len(stack)-1]
+			}
+		}
+	}
+}
+
+// isStreamItem reports whether an element with the given local name is a
+// feed entry for the feed type.
+func isStreamItem(ft FeedType, name string) bool {
+	if ft == FeedAtom {
+		return name == "entry"
+	}
+	return name == "item"
+}
+
+// isHeaderContainer reports whether the open element stack points at the
+// element holding channel-level fields (<channel> or Atom's <feed>).
+func isHeaderContainer(ft FeedType, stack []string) bool {
+	switch ft {
+	case FeedAtom:
+		return len(stack) == 1
+	case FeedRSS2, FeedRSS1:
+		return len(stack) == 2 && stack[1] == "channel"
+	}
+	return false
+}
+
+// decodeStreamItem decodes a single item/entry element into an Item.
+func decodeStreamItem(dec *xml.Decoder, start *xml.StartElement, ft FeedType) (Item, error) {
+	switch ft {
+	case FeedAtom:
+		var e atomEntry
+		if err := dec.DecodeElement(&e, start); err != nil {
+			return Item{}, err
+		}
+		return e.toItem(), nil
+	case FeedRSS1:
+		var it rss1Item
+		if err := dec.DecodeElement(&it, start); err != nil {
+			return Item{}, err
+		}
+		return it.toItem(), nil
+	default:
+		var it rss2Item
+		if err := dec.DecodeElement(&it, start); err != nil {
+			return Item{}, err
+		}
+		return it.toItem(), nil
+	}
+}
+
+// decodeHeaderField decodes a channel-level field into hdr. It returns
+// false (without consuming anything) for elements it does not handle.
+func decodeHeaderField(dec *xml.Decoder, start *xml.StartElement, hdr *FeedHeader) (bool, error) {
+	var target *string
+	var timeField bool
+
+	switch start.Name.Local {
+	case "title":
+		target = &hdr.Title
+	case "description", "subtitle":
+		target = &hdr.Description
+	case "link":
+		if hdr.Type == FeedAtom {
+			var l atomLink
+			if err := dec.DecodeElement(&l, start); err != nil {
+				return true, err
+			}
+			if hdr.Link == "" {
+				hdr.Link = l.Href
+			}
+			return true, nil
+		}
+		target = &hdr.Link
+	case "lastBuildDate", "updated":
+		timeField = true
+	default:
+		return false, nil
+	}
+
+	var v string
+	if err := dec.DecodeElement(&v, start); err != nil {
+		return true, err
+	}
+	if timeField {
+		hdr.Updated = parseTime(v)
+		return true, nil
+	}
+	if *target == "" {
+		*target = v
+	}
+	return true, nil
+}
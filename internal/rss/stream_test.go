@@ -0,0 +1,87 @@
+// internal/rss/stream_test.go
+package rss
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+// syntheticRSS streams an RSS 2.0 feed with n items without building the
+// whole document in memory.
+func syntheticRSS(n int) io.Reader {
+	pr, pw := io.Pipe()
+	go func() {
+		fmt.Fprint(pw, `<?xml version="1.0"?><rss version="2.0"><channel>`)
+		fmt.Fprint(pw, `<title>Big Feed</title><link>https://example.com/</link>`)
+		for i := 0; i < n; i++ {
+			fmt.Fprintf(pw, `<item><title>Item %d</title><guid>id-%d</guid></item>`, i, i)
+		}
+		fmt.Fprint(pw, `</channel></rss>`)
+		pw.Close()
+	}()
+	return pr
+}
+
+func TestParseStream_LargeFeed(t *testing.T) {
+	const n = 20000
+	count := 0
+	hdr, err := ParseStream(syntheticRSS(n), func(it Item) error {
+		if want := fmt.Sprintf("Item %d", count); it.Title != want {
+			return fmt.Errorf("item %d: got %q, want %q", count, it.Title, want)
+		}
+		count++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ParseStream error: %v", err)
+	}
+	if count != n {
+		t.Fatalf("got %d items, want %d", count, n)
+	}
+	if hdr.Type != FeedRSS2 || hdr.Title != "Big Feed" || hdr.Link != "https://example.com/" {
+		t.Fatalf("unexpected header: %+v", hdr)
+	}
+}
+
+func TestParseStream_CallbackErrorStops(t *testing.T) {
+	stop := errors.New("stop")
+	count := 0
+	_, err := ParseStream(syntheticRSS(1000), func(it Item) error {
+		count++
+		if count == 3 {
+			return stop
+		}
+		return nil
+	})
+	if !errors.Is(err, stop) {
+		t.Fatalf("got error %v, want %v", err, stop)
+	}
+	if count != 3 {
+		t.Fatalf("callback called %d times, want 3", count)
+	}
+}
+
+func TestParseStream_Atom(t *testing.T) {
+	atom := `<feed xmlns="http://www.w3.org/2005/Atom"><title>Atom</title>` +
+		`<link href="https://example.com/"/>` +
+		`<entry><title>A</title><link href="https://example.com/a"/></entry>` +
+		`<entry><title>B</title></entry></feed>`
+
+	var titles []string
+	hdr, err := ParseStream(strings.NewReader(atom), func(it Item) error {
+		titles = append(titles, it.Title)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ParseStream error: %v", err)
+	}
+	if hdr.Type != FeedAtom || hdr.Link != "https://example.com/" {
+		t.Fatalf("unexpected header: %+v", hdr)
+	}
+	if strings.Join(titles, ",") != "A,B" {
+		t.Fatalf("got %v, want [A B]", titles)
+	}
+}
@@ -23,8 +23,17 @@ type Item struct {
 	Link        string
 	Description string
 	Content     string
-	Author      string
+	Author      string // first author, kept for compatibility
+	Authors     []Author
 	Published   time.Time
 	Updated     time.Time
 	GUID        string
 }
+
+// Author is a person credited on a feed entry (Atom <author>, RSS
+// <author> or Dublin Core <dc:creator>).
+type Author struct {
+	Name  string
+	Email string
+	URI   string
+}
@@ -1,6 +1,6 @@
 // internal/toon/bton.go
 //
-// BTON — Binary TOON v1
+// BTON — Binary TOON v2
 //
 // A compact binary encoding for TOON tokens, used for:
 //   • High-speed caching
@@ -9,7 +9,7 @@
 //
 // Encoding:
 //
-//   MAGIC: "BTON\x00"
+//   MAGIC: "BTON" + version byte (0x02)
 //   Document:
 //       [sourceURLLen][sourceURL]
 //       [kindLen][kind]
@@ -23,7 +23,9 @@
 //             textLen, text
 //             attrCount, [keyLen,key,valLen,val]...
 //           }
+//   CHECKSUM: CRC-32 (IEEE, little-endian uint32) of every preceding byte
 //
+// Version 2 added the trailing checksum; version 1 streams are rejected.
 // Token types are mapped to small bytes via encodeTokenType/decodeTokenType.
 
 package toon
@@ -32,15 +34,46 @@ import (
 	"bytes"
 	"encoding/binary"
 	"errors"
+	"fmt"
+	"hash/crc32"
 	"io"
 
 	"github.com/Nibir1/Aether/internal/model"
 )
 
-const btonMagic = "BTON\x00"
+const (
+	btonPrefix  = "BTON"
+	btonVersion = 2
+	btonMagic   = btonPrefix + "\x02"
+
+	btonChecksumSize = 4
+)
 
 var (
 	errInvalidBTON = errors.New("aether/toon: invalid BTON stream")
+
+	// ErrCorruptBTON is wrapped by every DecodeBTON error caused by a
+	// truncated stream or by lengths and counts that cannot fit in the
+	// remaining input.
+	ErrCorruptBTON = errors.New("aether/toon: corrupt stream")
+
+	// ErrChecksumBTON is wrapped by DecodeBTON errors for streams whose
+	// trailing checksum does not match their contents. It also matches
+	// ErrCorruptBTON.
+	ErrChecksumBTON error = checksumError{}
+)
+
+type checksumError struct{}
+
+func (checksumError) Error() string { return "aether/toon: corrupt checksum" }
+
+func (checksumError) Is(target error) bool { return target == ErrCorruptBTON }
+
+// Minimum encoded sizes, used to reject declared counts that cannot
+// possibly fit in the remaining input before allocating for them.
+const (
+	btonMinToken = 1 + 4 + 4 + 4 // type, role len, text len, attr count
+	btonMinAttr  = 4 + 4         // key len, value len
 )
 
 const (
@@ -53,6 +86,7 @@ const (
 	btonTypeDocInfo  = 6
 	btonTypeTitle    = 7
 	btonTypeExcerpt  = 8
+	btonTypeTrunc    = 9
 )
 
 func encodeTokenType(t TokenType) byte {
@@ -73,6 +107,8 @@ func encodeTokenType(t TokenType) byte {
 		return btonTypeTitle
 	case TokenExcerpt:
 		return btonTypeExcerpt
+	case TokenTruncated:
+		return btonTypeTrunc
 	default:
 		return btonTypeUnknown
 	}
@@ -96,6 +132,8 @@ func decodeTokenType(b byte) TokenType {
 		return TokenTitle
 	case btonTypeExcerpt:
 		return TokenExcerpt
+	case btonTypeTrunc:
+		return TokenTruncated
 	default:
 		return TokenText
 	}
@@ -118,21 +156,83 @@ func writeString(w io.Writer, s string) error {
 	return err
 }
 
-func readString(r io.Reader) (string, error) {
+// btonReader decodes BTON primitives, turning short reads and
+// impossible lengths into ErrCorruptBTON errors.
+type btonReader struct {
+	r *bytes.Reader
+}
+
+// corrupt wraps a formatted message in ErrCorruptBTON.
+func corrupt(format string, args ...any) error {
+	return fmt.Errorf("%w: "+format, append([]any{ErrCorruptBTON}, args...)...)
+}
+
+func (br *btonReader) uint32(what string) (uint32, error) {
 	var n uint32
-	if err := binary.Read(r, binary.LittleEndian, &n); err != nil {
+	if err := binary.Read(br.r, binary.LittleEndian, &n); err != nil {
+		return 0, corrupt("truncated input reading %s", what)
+	}
+	return n, nil
+}
+
+func (br *btonReader) byte(what string) (byte, error) {
+	b, err := br.r.ReadByte()
+	if err != nil {
+		return 0, corrupt("truncated input reading %s", what)
+	}
+	return b, nil
+}
+
+func (br *btonReader) string(what string) (string, error) {
+	n, err := br.uint32(what + " length")
+	if err != nil {
 		return "", err
 	}
 	if n == 0 {
 		return "", nil
 	}
-	b := make([]byte, n)
-	if _, err := io.ReadFull(r, b); err != nil {
-		return "", err
+	if int64(n) > int64(br.r.Len()) {
+		return "", corrupt("%s declares %d bytes but only %d bytes remain", what, n, br.r.Len())
 	}
+	b := make([]byte, n)
+	io.ReadFull(br.r, b)
 	return string(b), nil
 }
 
+// count reads an element count and checks that count elements of at
+// least minSize bytes each fit in the remaining input.
+func (br *btonReader) count(what string, minSize int) (int, error) {
+	n, err := br.uint32(what + " count")
+	if err != nil {
+		return 0, err
+	}
+	if int64(n)*int64(minSize) > int64(br.r.Len()) {
+		return 0, corrupt("declared %d %s but only %d bytes remain", n, what, br.r.Len())
+	}
+	return int(n), nil
+}
+
+// attrs reads a counted key/value map; zero entries yield nil.
+func (br *btonReader) attrs(what string) (map[string]string, error) {
+	n, err := br.count(what, btonMinAttr)
+	if err != nil || n == 0 {
+		return nil, err
+	}
+	m := make(map[string]string, n)
+	for i := 0; i < n; i++ {
+		k, err := br.string(what + " key")
+		if err != nil {
+			return nil, err
+		}
+		v, err := br.string(what + " value")
+		if err != nil {
+			return nil, err
+		}
+		m[k] = v
+	}
+	return m, nil
+}
+
 //
 // ─────────────────────────────────────────────
 //                 ENCODE BTON
@@ -212,6 +312,11 @@ func EncodeBTON(doc *Document) ([]byte, error) {
 		}
 	}
 
+	// Checksum over everything written so far
+	if err := binary.Write(buf, binary.LittleEndian, crc32.ChecksumIEEE(buf.Bytes())); err != nil {
+		return nil, err
+	}
+
 	return buf.Bytes(), nil
 }
 
@@ -222,98 +327,79 @@ func EncodeBTON(doc *Document) ([]byte, error) {
 //
 
 // DecodeBTON parses a BTON binary stream back into a TOON Document.
+//
+// Truncated or corrupt input yields an error wrapping ErrCorruptBTON.
+// Declared lengths and counts are checked against the remaining input
+// before anything is allocated, so a corrupt stream cannot trigger an
+// oversized allocation. The trailing checksum is verified before any
+// field is decoded; a mismatch yields an error wrapping ErrChecksumBTON.
 func DecodeBTON(b []byte) (*Document, error) {
-	r := bytes.NewReader(b)
-
-	// Validate magic header
-	magic := make([]byte, len(btonMagic))
-	if _, err := io.ReadFull(r, magic); err != nil {
-		return nil, err
-	}
-	if string(magic) != btonMagic {
+	if len(b) < len(btonMagic) || string(b[:len(btonPrefix)]) != btonPrefix {
 		return nil, errInvalidBTON
 	}
+	if v := b[len(btonPrefix)]; v != btonVersion {
+		return nil, fmt.Errorf("aether/toon: unsupported BTON version %d", v)
+	}
+	if len(b) < len(btonMagic)+btonChecksumSize {
+		return nil, corrupt("truncated input reading checksum")
+	}
+
+	end := len(b) - btonChecksumSize
+	stored := binary.LittleEndian.Uint32(b[end:])
+	if sum := crc32.ChecksumIEEE(b[:end]); sum != stored {
+		return nil, fmt.Errorf("%w: stored %08x, computed %08x", ErrChecksumBTON, stored, sum)
+	}
+	br := &btonReader{r: bytes.NewReader(b[len(btonMagic):end])}
 
 	doc := &Document{}
 
 	var err error
-	if doc.SourceURL, err = readString(r); err != nil {
+	if doc.SourceURL, err = br.string("source URL"); err != nil {
 		return nil, err
 	}
-	kindStr, err := readString(r)
+	kindStr, err := br.string("kind")
 	if err != nil {
 		return nil, err
 	}
 	doc.Kind = model.DocumentKind(kindStr)
 
-	if doc.Title, err = readString(r); err != nil {
+	if doc.Title, err = br.string("title"); err != nil {
 		return nil, err
 	}
-	if doc.Excerpt, err = readString(r); err != nil {
+	if doc.Excerpt, err = br.string("excerpt"); err != nil {
 		return nil, err
 	}
 
 	// Attributes
-	var attrCount uint32
-	if err := binary.Read(r, binary.LittleEndian, &attrCount); err != nil {
+	if doc.Attributes, err = br.attrs("attributes"); err != nil {
 		return nil, err
 	}
-	if attrCount > 0 {
-		doc.Attributes = make(map[string]string, attrCount)
-		for i := 0; i < int(attrCount); i++ {
-			k, err := readString(r)
-			if err != nil {
-				return nil, err
-			}
-			v, err := readString(r)
-			if err != nil {
-				return nil, err
-			}
-			doc.Attributes[k] = v
-		}
-	}
 
 	// Tokens
-	var tokenCount uint32
-	if err := binary.Read(r, binary.LittleEndian, &tokenCount); err != nil {
+	tokenCount, err := br.count("tokens", btonMinToken)
+	if err != nil {
 		return nil, err
 	}
 	doc.Tokens = make([]Token, tokenCount)
 
-	for i := 0; i < int(tokenCount); i++ {
+	for i := range doc.Tokens {
 		var t Token
 
-		typeByte, err := r.ReadByte()
+		typeByte, err := br.byte("token type")
 		if err != nil {
 			return nil, err
 		}
 		t.Type = decodeTokenType(typeByte)
 
-		if t.Role, err = readString(r); err != nil {
+		if t.Role, err = br.string("token role"); err != nil {
 			return nil, err
 		}
-		if t.Text, err = readString(r); err != nil {
+		if t.Text, err = br.string("token text"); err != nil {
 			return nil, err
 		}
-
-		var n uint32
-		if err := binary.Read(r, binary.LittleEndian, &n); err != nil {
+		if t.Attrs, err = br.attrs("token attributes"); err != nil {
 			return nil, err
 		}
-		if n > 0 {
-			t.Attrs = make(map[string]string, n)
-			for j := 0; j < int(n); j++ {
-				k, err := readString(r)
-				if err != nil {
-					return nil, err
-				}
-				v, err := readString(r)
-				if err != nil {
-					return nil, err
-				}
-				t.Attrs[k] = v
-			}
-		}
 
 		doc.Tokens[i] = t
 	}
@@ -2,6 +2,10 @@
 package toon
 
 import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"math/rand"
 	"testing"
 
 	"github.com/Nibir1/Aether/internal/model"
@@ -49,3 +53,92 @@ func TestBTON_RoundTrip(t *testing.T) {
 		t.Fatalf("Token count mismatch after round-trip: got %d, want %d", len(out.Tokens), len(tdoc.Tokens))
 	}
 }
+
+func TestDecodeBTON_CorruptInput(t *testing.T) {
+	m := &model.Document{
+		SourceURL: "https://example.com/a",
+		Kind:      model.DocumentKindArticle,
+		Title:     "Title",
+		Metadata:  map[string]string{"lang": "en"},
+		Sections: []model.Section{
+			{Role: model.SectionRoleBody, Heading: "H", Text: "Body", Meta: map[string]string{"k": "v"}},
+		},
+	}
+	b, err := EncodeBTON(FromModel(m))
+	if err != nil {
+		t.Fatalf("EncodeBTON error: %v", err)
+	}
+
+	// Every truncation of a valid stream must fail cleanly.
+	for n := len(btonMagic); n < len(b); n++ {
+		if _, err := DecodeBTON(b[:n]); !errors.Is(err, ErrCorruptBTON) {
+			t.Fatalf("truncated to %d bytes: got %v, want ErrCorruptBTON", n, err)
+		}
+	}
+
+	// Random byte corruption must never panic or over-allocate.
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 2000; i++ {
+		c := append([]byte(nil), b...)
+		for j := 0; j < 1+rng.Intn(4); j++ {
+			c[len(btonMagic)+rng.Intn(len(c)-len(btonMagic))] = byte(rng.Intn(256))
+		}
+		c = c[:len(btonMagic)+rng.Intn(len(c)-len(btonMagic)+1)]
+		DecodeBTON(c)
+	}
+}
+
+func TestDecodeBTON_HugeTokenCount(t *testing.T) {
+	b, err := EncodeBTON(&Document{})
+	if err != nil {
+		t.Fatalf("EncodeBTON error: %v", err)
+	}
+	// The token count is the last uint32 before the checksum of an
+	// empty document.
+	binary.LittleEndian.PutUint32(b[len(b)-8:], 4_000_000_000)
+	resealBTON(b)
+
+	_, err = DecodeBTON(b)
+	want := "aether/toon: corrupt stream: declared 4000000000 tokens but only 0 bytes remain"
+	if err == nil || err.Error() != want {
+		t.Fatalf("DecodeBTON error: got %v, want %q", err, want)
+	}
+}
+
+func TestDecodeBTON_ChecksumDetectsFlippedByte(t *testing.T) {
+	b, err := EncodeBTON(FromModel(&model.Document{
+		Title:    "Title",
+		Sections: []model.Section{{Role: model.SectionRoleBody, Text: "Body"}},
+	}))
+	if err != nil {
+		t.Fatalf("EncodeBTON error: %v", err)
+	}
+
+	for i := len(btonMagic); i < len(b); i++ {
+		c := append([]byte(nil), b...)
+		c[i] ^= 0x01
+		_, err := DecodeBTON(c)
+		if !errors.Is(err, ErrChecksumBTON) {
+			t.Fatalf("byte %d flipped: got %v, want ErrChecksumBTON", i, err)
+		}
+		if !errors.Is(err, ErrCorruptBTON) {
+			t.Fatalf("byte %d flipped: checksum error should match ErrCorruptBTON", i)
+		}
+	}
+}
+
+// resealBTON recomputes the trailing checksum of b after a test has
+// patched its contents.
+func resealBTON(b []byte) {
+	end := len(b) - btonChecksumSize
+	binary.LittleEndian.PutUint32(b[end:], crc32.ChecksumIEEE(b[:end]))
+}
+
+func FuzzDecodeBTON(f *testing.F) {
+	b, _ := EncodeBTON(FromModel(&model.Document{Title: "t", Sections: []model.Section{{Role: model.SectionRoleBody, Text: "x"}}}))
+	f.Add(b)
+	f.Add([]byte(btonMagic))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		DecodeBTON(data)
+	})
+}
@@ -8,6 +8,8 @@
 
 package toon
 
+import "strconv"
+
 // Builder accumulates TOON tokens in order.
 type Builder struct {
 	tokens []Token
@@ -129,3 +131,15 @@ func (b *Builder) MetaKV(role, key, value string) {
 		},
 	})
 }
+
+// Truncated emits a TRUNCATED marker recording how many sections were
+// left out of the stream.
+func (b *Builder) Truncated(omittedSections int) {
+	b.tokens = append(b.tokens, Token{
+		Type: TokenTruncated,
+		Role: "truncated",
+		Attrs: map[string]string{
+			"omitted_sections": strconv.Itoa(omittedSections),
+		},
+	})
+}
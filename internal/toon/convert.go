@@ -2,6 +2,11 @@
 //
 // Conversion from model.Document → TOON Document.
 // This is the primary entry point used by Aether when marshaling TOON.
+//
+// FromModelWithOptions bounds the conversion itself: sections past
+// MaxSections are never visited, and once the next piece would exceed
+// MaxTokens conversion stops, so a huge feed costs no more memory than
+// the tokens actually kept. A cut-short stream ends in a TRUNCATED token.
 
 package toon
 
@@ -11,6 +16,19 @@ import (
 	"github.com/Nibir1/Aether/internal/model"
 )
 
+// FromModelOptions limits FromModelWithOptions. Zero values mean no
+// limit.
+type FromModelOptions struct {
+	// MaxSections is the maximum number of sections converted.
+	MaxSections int
+
+	// MaxTokens is the maximum length of the token stream, including
+	// the DOCINFO token and the truncation marker, both of which are
+	// always emitted when needed. Sections are kept or dropped whole,
+	// so the stream never ends inside a section.
+	MaxTokens int
+}
+
 // FromModel converts a normalized model.Document into a TOON document.
 //
 // It encodes:
@@ -19,6 +37,14 @@ import (
 //   - core content into TEXT tokens
 //   - sections into SECTION_START / SECTION_END + HEADING + TEXT + META tokens.
 func FromModel(m *model.Document) *Document {
+	return FromModelWithOptions(m, FromModelOptions{})
+}
+
+// FromModelWithOptions converts m like FromModel, stopping early when
+// opts.MaxSections or opts.MaxTokens is reached. When anything was left
+// out, the stream ends in a TRUNCATED token recording the number of
+// sections omitted.
+func FromModelWithOptions(m *model.Document, opts FromModelOptions) *Document {
 	if m == nil {
 		return &Document{
 			Kind:       model.DocumentKindUnknown,
@@ -38,55 +64,159 @@ func FromModel(m *model.Document) *Document {
 		Excerpt:    excerpt,
 		Attributes: cloneMap(m.Metadata),
 		Tokens:     nil,
+
+		TypedAttributes: cloneAnyMap(m.TypedMetadata),
+	}
+
+	sections := m.Sections
+	omitted := 0
+	if opts.MaxSections > 0 && len(sections) > opts.MaxSections {
+		omitted = len(sections) - opts.MaxSections
+		sections = sections[:opts.MaxSections]
+	}
+
+	// Content token only when there are no sections (or as a fallback
+	// summary).
+	if len(m.Sections) > 0 {
+		content = ""
 	}
 
 	b := NewBuilder()
+	budget := tokenBudget{max: opts.MaxTokens, b: b}
+	budget.pending = nonEmpty(title, excerpt, content) + len(sections)
+	if omitted > 0 {
+		budget.pending++ // the marker always follows
+	}
 
 	// DocumentInfo token with kind and any high-level metadata of interest.
 	b.DocumentInfo(string(m.Kind), nil)
 
-	// Optional title / excerpt tokens.
-	if title != "" {
-		b.Title(title)
+	// Optional title / excerpt / content tokens.
+	for _, top := range []struct {
+		text string
+		emit func(string)
+	}{
+		{title, b.Title},
+		{excerpt, b.Excerpt},
+		{content, func(s string) { b.TextBlock("content", s) }},
+	} {
+		if top.text == "" {
+			continue
+		}
+		if !budget.take(1) {
+			b.Truncated(omitted + len(sections))
+			out.Tokens = b.Tokens()
+			return out
+		}
+		top.emit(top.text)
 	}
-	if excerpt != "" {
-		b.Excerpt(excerpt)
+
+	// Section-based structure.
+	for i := range sections {
+		sec := &sections[i]
+		heading, body := sectionParts(sec)
+
+		if !budget.take(sectionTokenCount(sec, heading, body)) {
+			omitted += len(sections) - i
+			break
+		}
+		writeSection(b, sec, heading, body)
 	}
 
-	// Optional content token (only when there are no sections, or as
-	// a fallback summary).
-	if content != "" && len(m.Sections) == 0 {
-		b.TextBlock("content", content)
+	if omitted > 0 {
+		b.Truncated(omitted)
 	}
 
-	// Section-based structure.
-	for _, sec := range m.Sections {
-		role := string(sec.Role)
-		heading := strings.TrimSpace(sec.Heading)
-		body := strings.TrimSpace(sec.Text)
+	out.Tokens = b.Tokens()
+	return out
+}
 
-		// SECTION_START
-		b.SectionStart(role, heading)
+// sectionParts returns the trimmed heading and body FromModel emits for
+// sec.
+func sectionParts(sec *model.Section) (heading, body string) {
+	heading = strings.TrimSpace(sec.Heading)
+	body = strings.TrimSpace(sec.Text)
+	if sec.Role == model.SectionRoleCode {
+		// Code keeps the indentation of its first line.
+		body = model.TrimBlankLines(sec.Text)
+	}
+	return heading, body
+}
 
-		// Optional heading token
-		if heading != "" {
-			b.Heading(role, heading)
-		}
+// writeSection emits the tokens for one section, from SECTION_START to
+// SECTION_END.
+func writeSection(b *Builder, sec *model.Section, heading, body string) {
+	role := string(sec.Role)
 
-		// Body text
-		if body != "" {
-			b.TextBlock(role, body)
-		}
+	// SECTION_START
+	b.SectionStart(role, heading)
 
-		// Section metadata → META tokens
-		for k, v := range sec.Meta {
-			b.MetaKV(role, k, v)
-		}
+	// Optional heading token
+	if heading != "" {
+		b.Heading(role, heading)
+	}
 
-		// SECTION_END
-		b.SectionEnd(role)
+	// Body text
+	if body != "" {
+		b.TextBlock(role, body)
 	}
 
-	out.Tokens = b.Tokens()
-	return out
+	// Section metadata → META tokens
+	for k, v := range sec.Meta {
+		b.MetaKV(role, k, v)
+	}
+
+	// SECTION_END
+	b.SectionEnd(role)
+}
+
+// tokenBudget tracks MaxTokens during conversion. pending counts the
+// pieces (top-level tokens, sections, a known marker) still to come.
+type tokenBudget struct {
+	max     int
+	pending int
+	b       *Builder
+}
+
+// take reports whether a piece of n tokens fits, keeping one token in
+// reserve for the truncation marker while more pieces follow.
+func (t *tokenBudget) take(n int) bool {
+	t.pending--
+	if t.max <= 0 {
+		return true
+	}
+	reserve := 0
+	if t.pending > 0 {
+		reserve = 1
+	}
+	return len(t.b.tokens)+n+reserve <= t.max
+}
+
+// sectionTokenCount returns the number of tokens FromModel emits for
+// sec, given its trimmed heading and body.
+func sectionTokenCount(sec *model.Section, heading, body string) int {
+	n := 2 // SECTION_START + SECTION_END
+	if heading != "" {
+		n++
+	}
+	if body != "" {
+		n++
+	}
+	for k, v := range sec.Meta {
+		if k != "" && v != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// nonEmpty counts the non-empty strings in values.
+func nonEmpty(values ...string) int {
+	n := 0
+	for _, v := range values {
+		if v != "" {
+			n++
+		}
+	}
+	return n
 }
@@ -2,6 +2,8 @@
 package toon
 
 import (
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/Nibir1/Aether/internal/model"
@@ -53,3 +55,127 @@ func TestFromModel_BasicFields(t *testing.T) {
 		t.Fatalf("ApproxTokenCount mismatch: got %d, want %d", tdoc.ApproxTokenCount(), len(tdoc.Tokens))
 	}
 }
+
+func TestFromModelWithOptions_RespectsMaxTokens(t *testing.T) {
+	m := &model.Document{
+		Kind:  model.DocumentKindFeed,
+		Title: "Big feed",
+	}
+	for i := 0; i < 10000; i++ {
+		m.Sections = append(m.Sections, model.Section{
+			Role:    model.SectionRoleFeedItem,
+			Heading: "Item",
+			Text:    "Body",
+			Meta:    map[string]string{"link": "https://example.com/item"},
+		})
+	}
+
+	for _, max := range []int{3, 10, 50, 51, 97} {
+		doc := FromModelWithOptions(m, FromModelOptions{MaxTokens: max})
+		if got := doc.ApproxTokenCount(); got > max {
+			t.Fatalf("MaxTokens %d: got %d tokens", max, got)
+		}
+
+		last := doc.Tokens[len(doc.Tokens)-1]
+		if last.Type != TokenTruncated {
+			t.Fatalf("MaxTokens %d: last token %q, want %q", max, last.Type, TokenTruncated)
+		}
+
+		starts, ends := 0, 0
+		for _, tok := range doc.Tokens {
+			switch tok.Type {
+			case TokenSectionStart:
+				starts++
+			case TokenSectionEnd:
+				ends++
+			}
+		}
+		if starts != ends {
+			t.Fatalf("MaxTokens %d: %d section starts, %d ends", max, starts, ends)
+		}
+		if want := strconv.Itoa(len(m.Sections) - starts); last.Attrs["omitted_sections"] != want {
+			t.Fatalf("MaxTokens %d: got %q, want %q", max, last.Attrs["omitted_sections"], want)
+		}
+	}
+}
+
+func TestFromModelWithOptions_MaxSectionsAndNoLimit(t *testing.T) {
+	m := &model.Document{Kind: model.DocumentKindFeed}
+	for i := 0; i < 5; i++ {
+		m.Sections = append(m.Sections, model.Section{Role: model.SectionRoleFeedItem, Text: "Body"})
+	}
+
+	doc := FromModelWithOptions(m, FromModelOptions{MaxSections: 2})
+	last := doc.Tokens[len(doc.Tokens)-1]
+	if last.Type != TokenTruncated || last.Attrs["omitted_sections"] != "3" {
+		t.Fatalf("got last token %+v", last)
+	}
+
+	// A limit the document fits in exactly adds no marker.
+	full := FromModel(m)
+	exact := FromModelWithOptions(m, FromModelOptions{MaxTokens: len(full.Tokens)})
+	if len(exact.Tokens) != len(full.Tokens) || exact.Tokens[len(exact.Tokens)-1].Type == TokenTruncated {
+		t.Fatalf("got %d tokens, want %d without marker", len(exact.Tokens), len(full.Tokens))
+	}
+}
+
+func TestFromModelSummary_HeadingsWithoutContent(t *testing.T) {
+	m := &model.Document{
+		Kind:     model.DocumentKindArticle,
+		Title:    "Guide",
+		Excerpt:  "A short guide.",
+		Metadata: map[string]string{"author": "ada"},
+	}
+	for i := 0; i < 10; i++ {
+		m.Sections = append(m.Sections, model.Section{
+			Role:    model.SectionRoleBody,
+			Heading: "Part " + strconv.Itoa(i),
+			Text:    strings.Repeat("Body text that a summary should never carry. ", 40),
+			Meta:    map[string]string{"index": strconv.Itoa(i)},
+		})
+	}
+	m.Sections = append(m.Sections, model.Section{Role: model.SectionRoleBody, Text: "untitled"})
+
+	sum := FromModelSummary(m)
+
+	counts := map[TokenType]int{}
+	depth := 0
+	for _, tok := range sum.Tokens {
+		counts[tok.Type]++
+		switch tok.Type {
+		case TokenSectionStart:
+			depth++
+		case TokenSectionEnd:
+			depth--
+		case TokenHeading:
+			if depth != 1 {
+				t.Fatalf("heading %q outside a section", tok.Text)
+			}
+		}
+	}
+	if counts[TokenText] != 0 || counts[TokenMeta] != 0 {
+		t.Fatalf("content tokens present: %d text, %d meta", counts[TokenText], counts[TokenMeta])
+	}
+	if counts[TokenHeading] != 10 || counts[TokenSectionStart] != 10 || depth != 0 {
+		t.Fatalf("sections: got %d headings, %d starts, depth %d; want 10, 10, 0",
+			counts[TokenHeading], counts[TokenSectionStart], depth)
+	}
+	if counts[TokenDocumentInfo] != 1 || counts[TokenTitle] != 1 || counts[TokenExcerpt] != 1 {
+		t.Fatalf("header tokens: got %v", counts)
+	}
+	if len(sum.Attributes) != 0 {
+		t.Fatalf("Attributes: got %v, want none", sum.Attributes)
+	}
+
+	full, err := FromModel(m).MarshalJSONCompact()
+	if err != nil {
+		t.Fatalf("MarshalJSONCompact error: %v", err)
+	}
+	small, err := sum.MarshalJSONCompact()
+	if err != nil {
+		t.Fatalf("MarshalJSONCompact error: %v", err)
+	}
+	if len(small)*10 > len(full) {
+		t.Fatalf("summary is %d bytes, full is %d; want under a tenth", len(small), len(full))
+	}
+}
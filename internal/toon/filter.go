@@ -0,0 +1,102 @@
+// internal/toon/filter.go
+//
+// Token filtering / projection for TOON documents.
+//
+// Filter lets callers drop tokens they do not need before serialization,
+// e.g. keeping only human-readable content when sending a document to an
+// LLM with a tight budget. The filtered stream stays structurally valid:
+// SECTION_START / SECTION_END tokens are always kept or dropped as a pair.
+//
+// Note that dropping section boundaries flattens the stream: content from
+// every section is emitted back to back with only its Role to tell the
+// sections apart. Use KeepContentAndBoundaries to retain the grouping.
+
+package toon
+
+// Filter returns a copy of doc whose token stream contains only the tokens
+// for which keep returns true. Document-level fields (SourceURL, Kind,
+// Title, Excerpt, Attributes, TypedAttributes) are copied unchanged.
+//
+// Section boundaries are balanced automatically: a SECTION_END is kept if
+// and only if its matching SECTION_START was kept, regardless of what keep
+// returns for the end token itself.
+func Filter(doc *Document, keep func(Token) bool) *Document {
+	if doc == nil {
+		return nil
+	}
+
+	out := &Document{
+		SourceURL:  doc.SourceURL,
+		Kind:       doc.Kind,
+		Title:      doc.Title,
+		Excerpt:    doc.Excerpt,
+		Attributes: cloneMap(doc.Attributes),
+
+		TypedAttributes: cloneAnyMap(doc.TypedAttributes),
+	}
+	if keep == nil {
+		out.Tokens = append([]Token(nil), doc.Tokens...)
+		return out
+	}
+
+	tokens := make([]Token, 0, len(doc.Tokens))
+	var open []bool // stack: whether each open section's start was kept
+
+	for _, t := range doc.Tokens {
+		switch t.Type {
+		case TokenSectionStart:
+			kept := keep(t)
+			open = append(open, kept)
+			if kept {
+				tokens = append(tokens, t)
+			}
+		case TokenSectionEnd:
+			if len(open) == 0 {
+				// Unbalanced input; nothing to pair with.
+				continue
+			}
+			kept := open[len(open)-1]
+			open = open[:len(open)-1]
+			if kept {
+				tokens = append(tokens, t)
+			}
+		default:
+			if keep(t) {
+				tokens = append(tokens, t)
+			}
+		}
+	}
+
+	// Close any sections left open by malformed input.
+	for i := len(open) - 1; i >= 0; i-- {
+		if open[i] {
+			tokens = append(tokens, Token{Type: TokenSectionEnd})
+		}
+	}
+
+	out.Tokens = tokens
+	return out
+}
+
+//
+// ───────────────────────────────────────────────────────────────
+//                       CONVENIENCE PREDICATES
+// ───────────────────────────────────────────────────────────────
+//
+
+// KeepContent keeps only human-readable content tokens (text, heading,
+// title, excerpt). Section boundaries are dropped, producing a flat stream.
+func KeepContent(t Token) bool {
+	return t.IsContentToken()
+}
+
+// KeepContentAndBoundaries keeps content tokens plus section boundaries,
+// so section grouping survives while metadata is removed.
+func KeepContentAndBoundaries(t Token) bool {
+	return t.IsContentToken() || t.IsSectionBoundary()
+}
+
+// DropMetadata keeps everything except META and DOCINFO tokens.
+func DropMetadata(t Token) bool {
+	return !t.IsMetadata()
+}
@@ -0,0 +1,73 @@
+// internal/toon/filter_test.go
+package toon
+
+import (
+	"testing"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+func filterFixture() *Document {
+	return FromModel(&model.Document{
+		SourceURL: "https://example.com/article",
+		Kind:      model.DocumentKindArticle,
+		Title:     "Hello",
+		Excerpt:   "Short excerpt.",
+		Metadata:  map[string]string{"lang": "en"},
+		Sections: []model.Section{
+			{
+				Role:    model.SectionRoleBody,
+				Heading: "Intro",
+				Text:    "Intro text.",
+				Meta:    map[string]string{"author": "alice"},
+			},
+		},
+	})
+}
+
+func TestFilter_ContentOnly(t *testing.T) {
+	doc := filterFixture()
+	out := Filter(doc, KeepContent)
+
+	if len(out.Tokens) == 0 {
+		t.Fatal("expected content tokens to survive filtering")
+	}
+	for _, tok := range out.Tokens {
+		if tok.IsMetadata() {
+			t.Fatalf("metadata token survived: %+v", tok)
+		}
+		if !tok.IsContentToken() {
+			t.Fatalf("non-content token survived: %+v", tok)
+		}
+	}
+
+	// The source document must be untouched.
+	if len(doc.Tokens) <= len(out.Tokens) {
+		t.Fatalf("source tokens: got %d, want more than %d", len(doc.Tokens), len(out.Tokens))
+	}
+}
+
+func TestFilter_BoundariesStayBalanced(t *testing.T) {
+	doc := filterFixture()
+
+	// Keep starts but not ends: Filter must still close the section.
+	out := Filter(doc, func(tok Token) bool {
+		return tok.Type == TokenSectionStart || tok.IsContentToken()
+	})
+
+	depth := 0
+	for _, tok := range out.Tokens {
+		switch tok.Type {
+		case TokenSectionStart:
+			depth++
+		case TokenSectionEnd:
+			depth--
+		}
+		if tok.IsMetadata() {
+			t.Fatalf("metadata token survived: %+v", tok)
+		}
+	}
+	if depth != 0 {
+		t.Fatalf("unbalanced sections: depth %d", depth)
+	}
+}
@@ -22,6 +22,8 @@
 //
 //   Additional tokens:
 //     - META tokens may appear at document level or inside sections.
+//     - a TRUNCATED token may end a stream cut short by a conversion
+//       limit; it never appears inside a section.
 
 package toon
 
@@ -0,0 +1,77 @@
+// internal/toon/section.go
+//
+// Single-section TOON fragments.
+//
+// FromSection encodes one section on its own: a Document whose token
+// stream is exactly the SECTION_START … SECTION_END run FromModel would
+// emit for it, with no DOCINFO, title or excerpt. ToSection reads such a
+// fragment back, so a section can be stored or sent separately and
+// re-ingested later.
+
+package toon
+
+import (
+	"fmt"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+// FromSection converts sec into a standalone TOON fragment. A nil
+// section yields a fragment with no tokens.
+func FromSection(sec *model.Section) *Document {
+	out := &Document{
+		Kind:       model.DocumentKindUnknown,
+		Attributes: map[string]string{},
+	}
+	if sec == nil {
+		return out
+	}
+
+	b := NewBuilder()
+	heading, body := sectionParts(sec)
+	writeSection(b, sec, heading, body)
+	out.Tokens = b.Tokens()
+	return out
+}
+
+// ToSection rebuilds the section encoded by a fragment from FromSection.
+// The token stream must hold exactly one balanced section.
+func ToSection(d *Document) (*model.Section, error) {
+	if d == nil || len(d.Tokens) == 0 {
+		return nil, fmt.Errorf("aether/toon: empty section fragment")
+	}
+
+	tokens := d.Tokens
+	first, last := tokens[0], tokens[len(tokens)-1]
+	if first.Type != TokenSectionStart || last.Type != TokenSectionEnd {
+		return nil, fmt.Errorf("aether/toon: fragment is not a single section")
+	}
+
+	sec := &model.Section{
+		Role:    model.SectionRole(first.Role),
+		Heading: first.Attrs["heading"],
+	}
+	for _, t := range tokens[1 : len(tokens)-1] {
+		switch t.Type {
+		case TokenHeading:
+			sec.Heading = t.Text
+		case TokenText:
+			if sec.Text != "" {
+				sec.Text += "\n\n"
+			}
+			sec.Text += t.Text
+		case TokenMeta:
+			for k, v := range t.Attrs {
+				if sec.Meta == nil {
+					sec.Meta = make(map[string]string)
+				}
+				sec.Meta[k] = v
+			}
+		case TokenSectionStart, TokenSectionEnd:
+			return nil, fmt.Errorf("aether/toon: fragment holds more than one section")
+		default:
+			return nil, fmt.Errorf("aether/toon: unexpected %s token in section fragment", t.Type)
+		}
+	}
+	return sec, nil
+}
@@ -9,7 +9,9 @@
 package toon
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
 )
 
 // MarshalJSONCompact serializes the TOON document into compact JSON.
@@ -29,3 +31,34 @@ func (d *Document) MarshalJSONPretty() ([]byte, error) {
 	}
 	return json.MarshalIndent(d, "", "  ")
 }
+
+// MarshalJSONL serializes the TOON document as one JSON Lines record:
+// compact JSON followed by a single trailing newline. encoding/json
+// escapes newlines (and U+2028/U+2029) inside strings, so the record
+// never spans more than one line.
+func (d *Document) MarshalJSONL() ([]byte, error) {
+	b, err := d.MarshalJSONCompact()
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+// UnmarshalJSONL parses a single JSON Lines record produced by
+// MarshalJSONL. A trailing newline (or CRLF) is accepted; input holding
+// more than one record is rejected.
+func UnmarshalJSONL(line []byte) (*Document, error) {
+	line = bytes.TrimRight(line, "\r\n")
+	if len(bytes.TrimSpace(line)) == 0 {
+		return nil, fmt.Errorf("aether/toon: empty JSONL record")
+	}
+	if bytes.ContainsAny(line, "\r\n") {
+		return nil, fmt.Errorf("aether/toon: JSONL input holds more than one line")
+	}
+
+	var doc Document
+	if err := json.Unmarshal(line, &doc); err != nil {
+		return nil, fmt.Errorf("aether/toon: invalid JSONL record: %w", err)
+	}
+	return &doc, nil
+}
@@ -0,0 +1,63 @@
+// internal/toon/summary.go
+//
+// Summary-only conversion for cheap LLM prompts.
+//
+// FromModelSummary keeps the outline of a document and nothing else:
+// the DOCINFO token, the title and excerpt, and each section reduced to
+// its heading. Body text, section metadata and document attributes are
+// left out, so the stream is a small fraction of FromModel's while
+// still following the TOON grammar.
+
+package toon
+
+import (
+	"strings"
+
+	"github.com/Nibir1/Aether/internal/model"
+)
+
+// FromModelSummary converts m into a summary-only TOON document. Each
+// section with a heading becomes SECTION_START, HEADING, SECTION_END;
+// sections without a heading are skipped. No TEXT or META tokens are
+// emitted.
+func FromModelSummary(m *model.Document) *Document {
+	if m == nil {
+		return &Document{
+			Kind:       model.DocumentKindUnknown,
+			Attributes: map[string]string{},
+			Tokens:     nil,
+		}
+	}
+
+	title := strings.TrimSpace(m.Title)
+	excerpt := strings.TrimSpace(m.Excerpt)
+
+	b := NewBuilder()
+	b.DocumentInfo(string(m.Kind), nil)
+	if title != "" {
+		b.Title(title)
+	}
+	if excerpt != "" {
+		b.Excerpt(excerpt)
+	}
+
+	for i := range m.Sections {
+		heading := strings.TrimSpace(m.Sections[i].Heading)
+		if heading == "" {
+			continue
+		}
+		role := string(m.Sections[i].Role)
+		b.SectionStart(role, heading)
+		b.Heading(role, heading)
+		b.SectionEnd(role)
+	}
+
+	return &Document{
+		SourceURL:  m.SourceURL,
+		Kind:       m.Kind,
+		Title:      title,
+		Excerpt:    excerpt,
+		Attributes: map[string]string{},
+		Tokens:     b.Tokens(),
+	}
+}
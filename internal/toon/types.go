@@ -58,6 +58,9 @@ const (
 	// Dedicated top-level title + excerpt tokens
 	TokenTitle   TokenType = "title"
 	TokenExcerpt TokenType = "excerpt"
+
+	// Marks the end of a stream cut short by FromModelWithOptions
+	TokenTruncated TokenType = "truncated"
 )
 
 //
@@ -114,4 +117,9 @@ type Document struct {
 
 	// Extra flattened metadata (model.Document.Metadata)
 	Attributes map[string]string `json:"attributes,omitempty"`
+
+	// TypedAttributes mirrors model.Document.TypedMetadata: numeric and
+	// boolean attributes as JSON-native values. It is carried by the JSON
+	// encodings only; BTON keeps just the string Attributes.
+	TypedAttributes map[string]any `json:"typed_attributes,omitempty"`
 }
@@ -38,3 +38,15 @@ func cloneMap(in map[string]string) map[string]string {
 	}
 	return out
 }
+
+// cloneAnyMap safely clones a map[string]any (values are scalars).
+func cloneAnyMap(in map[string]any) map[string]any {
+	if in == nil {
+		return nil
+	}
+	out := make(map[string]any, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
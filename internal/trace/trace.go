@@ -0,0 +1,48 @@
+// internal/trace/trace.go
+//
+// Package trace defines the minimal span hook Aether's pipeline steps
+// call when a tracer is configured. It deliberately stops short of a
+// tracing API: a Tracer starts a span and returns the function that
+// ends it, which is enough to bridge to OpenTelemetry or any other
+// backend without Aether depending on one.
+//
+// With no tracer configured, Start returns the caller's context and a
+// shared no-op end function, so disabled tracing costs a nil check.
+
+package trace
+
+import "context"
+
+// Stable span names. Callers may key dashboards and tests on them, so
+// they must not change.
+const (
+	SpanFetch     = "aether.fetch"
+	SpanRobots    = "aether.robots"
+	SpanExtract   = "aether.extract"
+	SpanNormalize = "aether.normalize"
+)
+
+// Tracer starts spans. StartSpan returns the context to use inside the
+// span and a function that ends it; the end function is called exactly
+// once. Implementations must be safe for concurrent use.
+type Tracer interface {
+	StartSpan(ctx context.Context, name string) (context.Context, func())
+}
+
+func noop() {}
+
+// Start starts a span named name on t. A nil tracer returns ctx
+// unchanged and a no-op end function.
+func Start(t Tracer, ctx context.Context, name string) (context.Context, func()) {
+	if t == nil {
+		return ctx, noop
+	}
+	spanCtx, end := t.StartSpan(ctx, name)
+	if spanCtx == nil {
+		spanCtx = ctx
+	}
+	if end == nil {
+		end = noop
+	}
+	return spanCtx, end
+}
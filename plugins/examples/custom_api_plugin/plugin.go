@@ -44,9 +44,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
-	"time"
 
 	"github.com/Nibir1/Aether/aether"
 	"github.com/Nibir1/Aether/plugins"
@@ -117,10 +117,13 @@ func (p *CustomAPIPlugin) Fetch(ctx context.Context, query string) (*plugins.Doc
 	u.RawQuery = q.Encode()
 
 	// Fetch via Aether's HTTP client (robots + caching + retries)
-	body, _, err := p.client.FetchRaw(ctx, u.String())
+	body, status, _, err := p.client.FetchRawWithStatus(ctx, u.String())
 	if err != nil {
 		return nil, fmt.Errorf("custom_api: HTTP error: %w", err)
 	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("custom_api: unexpected HTTP status %d", status)
+	}
 
 	// Decode JSON
 	var api apiResponse
@@ -165,7 +168,7 @@ func (p *CustomAPIPlugin) Fetch(ctx context.Context, query string) (*plugins.Doc
 			api.Metadata,
 			map[string]string{
 				"query":        query,
-				"fetched_unix": fmt.Sprintf("%d", time.Now().Unix()),
+				"fetched_unix": fmt.Sprintf("%d", p.client.Now().Unix()),
 				"plugin":       "custom_api",
 			},
 		),
@@ -27,9 +27,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/Nibir1/Aether/aether"
 	"github.com/Nibir1/Aether/plugins"
@@ -89,10 +89,13 @@ func (p *HNPlugin) Capabilities() []string {
 func (p *HNPlugin) Fetch(ctx context.Context, query string) (*plugins.Document, error) {
 	// 1. Fetch top story IDs
 	topURL := hnAPIBase + "/topstories.json"
-	body, _, err := p.client.FetchRaw(ctx, topURL) // we will add FetchRaw publicly later
+	body, status, _, err := p.client.FetchRawWithStatus(ctx, topURL)
 	if err != nil {
 		return nil, fmt.Errorf("hn: failed to fetch topstories: %w", err)
 	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("hn: topstories returned HTTP %d", status)
+	}
 
 	var ids []int64
 	if err := json.Unmarshal(body, &ids); err != nil {
@@ -143,7 +146,7 @@ func (p *HNPlugin) Fetch(ctx context.Context, query string) (*plugins.Document,
 		Excerpt: "Top stories from Hacker News, powered by the public Firebase API.",
 		Content: "",
 		Metadata: map[string]string{
-			"fetch_time_unix": strconv.FormatInt(time.Now().Unix(), 10),
+			"fetch_time_unix": strconv.FormatInt(p.client.Now().Unix(), 10),
 		},
 		Sections: sections,
 	}
@@ -155,10 +158,13 @@ func (p *HNPlugin) Fetch(ctx context.Context, query string) (*plugins.Document,
 func (p *HNPlugin) fetchStory(ctx context.Context, id int64) (*hnStory, error) {
 	url := fmt.Sprintf("%s/item/%d.json", hnAPIBase, id)
 
-	body, _, err := p.client.FetchRaw(ctx, url)
+	body, status, _, err := p.client.FetchRawWithStatus(ctx, url)
 	if err != nil {
 		return nil, err
 	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("hn: item %d returned HTTP %d", id, status)
+	}
 
 	var story hnStory
 	if err := json.Unmarshal(body, &story); err != nil {
@@ -144,6 +144,49 @@ type SourcePlugin interface {
 	Fetch(ctx context.Context, query string) (*Document, error)
 }
 
+// PagedSourcePlugin is an optional extension of SourcePlugin for sources
+// backed by paginated APIs. When a plugin implements it, Aether's
+// SearchPaged passes the requested window through to FetchPage;
+// otherwise the plain Fetch method is used and paging is ignored.
+type PagedSourcePlugin interface {
+	SourcePlugin
+
+	// FetchPage behaves like Fetch but returns the window of results
+	// starting at offset (zero-based) containing at most limit entries.
+	// A limit of 0 means "plugin default".
+	FetchPage(ctx context.Context, query string, offset, limit int) (*Document, error)
+}
+
+// SourcePluginWithPlan is an optional extension of SourcePlugin for
+// sources that want to describe how they answered, e.g. which
+// sub-endpoint they used or how confident they are. When the plugin's
+// document is the one Search returns, the annotations are merged into
+// the result's SearchPlan.Metadata. Plugins that do not implement it are
+// called through Fetch as before.
+//
+// SearchPaged calls the paged method of a plugin that also implements
+// PagedSourcePlugin; such a plugin should implement
+// PagedSourcePluginWithPlan to annotate paged searches as well.
+type SourcePluginWithPlan interface {
+	SourcePlugin
+
+	// FetchWithPlan behaves like Fetch and additionally returns plan
+	// annotations. A nil map adds nothing.
+	FetchWithPlan(ctx context.Context, query string) (*Document, map[string]string, error)
+}
+
+// PagedSourcePluginWithPlan combines PagedSourcePlugin and
+// SourcePluginWithPlan: SearchPaged calls FetchPageWithPlan instead of
+// FetchPage and merges its annotations into the SearchPlan.
+type PagedSourcePluginWithPlan interface {
+	PagedSourcePlugin
+	SourcePluginWithPlan
+
+	// FetchPageWithPlan behaves like FetchPage and additionally returns
+	// plan annotations. A nil map adds nothing.
+	FetchPageWithPlan(ctx context.Context, query string, offset, limit int) (*Document, map[string]string, error)
+}
+
 //
 // ────────────────────────────────────────────────
 //              TRANSFORM PLUGINS
@@ -206,3 +249,16 @@ type DisplayPlugin interface {
 	// document data, etc., depending on Format().
 	Render(ctx context.Context, doc *Document) ([]byte, error)
 }
+
+// DisplayFileMetadata is an optional extension of DisplayPlugin that
+// describes the files the plugin's output belongs in. When a plugin
+// implements it, Aether's RenderToFile rejects destination paths whose
+// extension is not listed.
+type DisplayFileMetadata interface {
+	DisplayPlugin
+
+	// FileExtensions returns the accepted file extensions including the
+	// leading dot, e.g. []string{".html", ".htm"}. Matching is
+	// case-insensitive.
+	FileExtensions() []string
+}
@@ -14,8 +14,9 @@
 //   • Plugin names must be unique (strict mode).
 //   • Registration is thread-safe.
 //   • Lookups are stable and deterministic.
-//   • No plugin may override another unless Aether explicitly
-//     enables override mode in a future extension.
+//   • No plugin may override another unless override mode is enabled
+//     with SetOverride; the Replace* methods then swap a plugin under
+//     the same lock and return the one they replaced.
 //
 // This file contains no imports from the aether package to avoid
 // circular dependencies.
@@ -36,6 +37,10 @@ import (
 type Registry struct {
 	mu sync.RWMutex
 
+	// override lets registration replace a plugin of the same name
+	// instead of failing.
+	override bool
+
 	sources    map[string]SourcePlugin
 	transforms map[string]TransformPlugin
 	displays   map[string]DisplayPlugin
@@ -54,31 +59,57 @@ func NewRegistry() *Registry {
 // errAlreadyRegistered is returned when a plugin name is already registered.
 var errAlreadyRegistered = errors.New("plugin with this name already registered")
 
+// SetOverride switches override mode on or off. With override on,
+// registering a name that is already taken replaces the existing plugin
+// instead of returning an error. The registry starts in strict mode.
+func (r *Registry) SetOverride(enabled bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.override = enabled
+}
+
+// Override reports whether override mode is enabled.
+func (r *Registry) Override() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.override
+}
+
 //
 // ─────────────────────────────────────────────
 //          SOURCE PLUGIN REGISTRATION
 // ─────────────────────────────────────────────
 //
 
-// RegisterSource registers a SourcePlugin. Names must be unique.
+// RegisterSource registers a SourcePlugin. Names must be unique
+// unless override mode is enabled.
 func (r *Registry) RegisterSource(p SourcePlugin) error {
+	_, err := r.ReplaceSource(p)
+	return err
+}
+
+// ReplaceSource registers p like RegisterSource and returns the plugin
+// of the same name it replaced, or nil. In strict mode a taken name is
+// an error and the registry is left unchanged.
+func (r *Registry) ReplaceSource(p SourcePlugin) (SourcePlugin, error) {
 	if p == nil {
-		return fmt.Errorf("cannot register nil SourcePlugin")
+		return nil, fmt.Errorf("cannot register nil SourcePlugin")
 	}
 	name := p.Name()
 	if name == "" {
-		return fmt.Errorf("SourcePlugin name cannot be empty")
+		return nil, fmt.Errorf("SourcePlugin name cannot be empty")
 	}
 
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if _, exists := r.sources[name]; exists {
-		return fmt.Errorf("%w: %s", errAlreadyRegistered, name)
+	old, exists := r.sources[name]
+	if exists && !r.override {
+		return nil, fmt.Errorf("%w: %s", errAlreadyRegistered, name)
 	}
 
 	r.sources[name] = p
-	return nil
+	return old, nil
 }
 
 // GetSource returns a SourcePlugin by name, or nil if not found.
@@ -107,25 +138,35 @@ func (r *Registry) ListSources() []string {
 // ─────────────────────────────────────────────
 //
 
-// RegisterTransform registers a TransformPlugin. Names must be unique.
+// RegisterTransform registers a TransformPlugin. Names must be unique
+// unless override mode is enabled.
 func (r *Registry) RegisterTransform(p TransformPlugin) error {
+	_, err := r.ReplaceTransform(p)
+	return err
+}
+
+// ReplaceTransform registers p like RegisterTransform and returns the plugin
+// of the same name it replaced, or nil. In strict mode a taken name is
+// an error and the registry is left unchanged.
+func (r *Registry) ReplaceTransform(p TransformPlugin) (TransformPlugin, error) {
 	if p == nil {
-		return fmt.Errorf("cannot register nil TransformPlugin")
+		return nil, fmt.Errorf("cannot register nil TransformPlugin")
 	}
 	name := p.Name()
 	if name == "" {
-		return fmt.Errorf("TransformPlugin name cannot be empty")
+		return nil, fmt.Errorf("TransformPlugin name cannot be empty")
 	}
 
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if _, exists := r.transforms[name]; exists {
-		return fmt.Errorf("%w: %s", errAlreadyRegistered, name)
+	old, exists := r.transforms[name]
+	if exists && !r.override {
+		return nil, fmt.Errorf("%w: %s", errAlreadyRegistered, name)
 	}
 
 	r.transforms[name] = p
-	return nil
+	return old, nil
 }
 
 // GetTransform returns a TransformPlugin by name.
@@ -154,25 +195,35 @@ func (r *Registry) ListTransforms() []string {
 // ─────────────────────────────────────────────
 //
 
-// RegisterDisplay registers a DisplayPlugin. Names must be unique.
+// RegisterDisplay registers a DisplayPlugin. Names must be unique
+// unless override mode is enabled.
 func (r *Registry) RegisterDisplay(p DisplayPlugin) error {
+	_, err := r.ReplaceDisplay(p)
+	return err
+}
+
+// ReplaceDisplay registers p like RegisterDisplay and returns the plugin
+// of the same name it replaced, or nil. In strict mode a taken name is
+// an error and the registry is left unchanged.
+func (r *Registry) ReplaceDisplay(p DisplayPlugin) (DisplayPlugin, error) {
 	if p == nil {
-		return fmt.Errorf("cannot register nil DisplayPlugin")
+		return nil, fmt.Errorf("cannot register nil DisplayPlugin")
 	}
 	name := p.Name()
 	if name == "" {
-		return fmt.Errorf("DisplayPlugin name cannot be empty")
+		return nil, fmt.Errorf("DisplayPlugin name cannot be empty")
 	}
 
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if _, exists := r.displays[name]; exists {
-		return fmt.Errorf("%w: %s", errAlreadyRegistered, name)
+	old, exists := r.displays[name]
+	if exists && !r.override {
+		return nil, fmt.Errorf("%w: %s", errAlreadyRegistered, name)
 	}
 
 	r.displays[name] = p
-	return nil
+	return old, nil
 }
 
 // GetDisplay returns a DisplayPlugin by name.
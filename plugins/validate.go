@@ -0,0 +1,75 @@
+// plugins/validate.go
+//
+// Structural validation for plugin-produced Documents. Aether only runs
+// these checks when the client opts in (aether.WithPluginValidation), so
+// lenient plugins keep working by default; plugin authors can also call
+// ValidateDocument directly in their own tests.
+
+package plugins
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidationError reports every problem found in a plugin Document.
+type ValidationError struct {
+	// Plugin is the name of the plugin that produced the document, if known.
+	Plugin string
+
+	// Problems lists each violation in a human-readable form.
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	problems := strings.Join(e.Problems, "; ")
+	if e.Plugin != "" {
+		return fmt.Sprintf("plugin %q returned an invalid document: %s", e.Plugin, problems)
+	}
+	return "invalid plugin document: " + problems
+}
+
+// ValidateDocument checks that doc is well-formed:
+//
+//   - doc is non-nil and has a non-empty Kind
+//   - Metadata keys (document and section level) are non-blank
+//   - every Section has a Role
+//
+// It returns a *ValidationError listing all problems, or nil.
+func ValidateDocument(doc *Document) error {
+	if doc == nil {
+		return &ValidationError{Problems: []string{"document is nil"}}
+	}
+
+	var problems []string
+
+	if strings.TrimSpace(string(doc.Kind)) == "" {
+		problems = append(problems, "kind is empty")
+	}
+	if blankKey(doc.Metadata) {
+		problems = append(problems, "metadata has an empty key")
+	}
+
+	for i, s := range doc.Sections {
+		if strings.TrimSpace(string(s.Role)) == "" {
+			problems = append(problems, fmt.Sprintf("section %d has no role", i))
+		}
+		if blankKey(s.Meta) {
+			problems = append(problems, fmt.Sprintf("section %d meta has an empty key", i))
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return &ValidationError{Problems: problems}
+}
+
+func blankKey(m map[string]string) bool {
+	for k := range m {
+		if strings.TrimSpace(k) == "" {
+			return true
+		}
+	}
+	return false
+}